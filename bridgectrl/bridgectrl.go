@@ -3,6 +3,8 @@ package bridgectrl
 import (
 	"context"
 	"math"
+	"sort"
+	"sync"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
@@ -17,9 +19,12 @@ const (
 
 // BridgeController struct
 type BridgeController struct {
+	lock        sync.RWMutex
 	exitTrees   []*MerkleTree
 	rollupsTree *MerkleTree
 	networkIDs  map[uint]uint8
+	height      uint8
+	mtStore     merkleTreeStore
 }
 
 // NewBridgeController creates new BridgeController.
@@ -47,10 +52,30 @@ func NewBridgeController(ctx context.Context, cfg Config, networks []uint, mtSto
 		exitTrees:   exitTrees,
 		rollupsTree: rollupsTree,
 		networkIDs:  networkIDs,
+		height:      cfg.Height,
+		mtStore:     mtStore.(merkleTreeStore),
 	}, nil
 }
 
+// AddNetwork creates the merkle tree of a network registered at runtime, when its rollup is added to the rollupManager.
+func (bt *BridgeController) AddNetwork(ctx context.Context, networkID uint) error {
+	bt.lock.Lock()
+	defer bt.lock.Unlock()
+	if _, found := bt.networkIDs[networkID]; found {
+		return nil
+	}
+	mt, err := NewMerkleTree(ctx, bt.mtStore, bt.height, networkID)
+	if err != nil {
+		return err
+	}
+	bt.networkIDs[networkID] = uint8(len(bt.exitTrees))
+	bt.exitTrees = append(bt.exitTrees, mt)
+	return nil
+}
+
 func (bt *BridgeController) GetNetworkID(networkID uint) (uint8, error) {
+	bt.lock.RLock()
+	defer bt.lock.RUnlock()
 	tID, found := bt.networkIDs[networkID]
 	if !found {
 		return 0, gerror.ErrNetworkNotRegister
@@ -58,29 +83,42 @@ func (bt *BridgeController) GetNetworkID(networkID uint) (uint8, error) {
 	return tID, nil
 }
 
+func (bt *BridgeController) getExitTree(networkID uint) (*MerkleTree, error) {
+	bt.lock.RLock()
+	defer bt.lock.RUnlock()
+	tID, found := bt.networkIDs[networkID]
+	if !found {
+		return nil, gerror.ErrNetworkNotRegister
+	}
+	return bt.exitTrees[tID], nil
+}
+
 // AddDeposit adds deposit information to the bridge tree.
 func (bt *BridgeController) AddDeposit(ctx context.Context, deposit *etherman.Deposit, depositID uint64, dbTx pgx.Tx) error {
 	leaf := hashDeposit(deposit)
-	tID, err := bt.GetNetworkID(deposit.NetworkID)
+	mt, err := bt.getExitTree(deposit.NetworkID)
 	if err != nil {
 		return err
 	}
-	return bt.exitTrees[tID].addLeaf(ctx, depositID, leaf, deposit.DepositCount, dbTx)
+	return mt.addLeaf(ctx, depositID, leaf, deposit.DepositCount, dbTx)
 }
 
 // ReorgMT reorg the specific merkle tree.
 func (bt *BridgeController) ReorgMT(ctx context.Context, depositCount uint, networkID uint, dbTx pgx.Tx) error {
-	tID, err := bt.GetNetworkID(networkID)
+	mt, err := bt.getExitTree(networkID)
 	if err != nil {
 		return err
 	}
-	return bt.exitTrees[tID].resetLeaf(ctx, depositCount, dbTx)
+	return mt.resetLeaf(ctx, depositCount, dbTx)
 }
 
 // GetExitRoot returns the dedicated merkle tree's root.
 // only use for the test purpose
 func (bt *BridgeController) GetExitRoot(ctx context.Context, networkID int, dbTx pgx.Tx) ([]byte, error) {
-	return bt.exitTrees[networkID].getRoot(ctx, dbTx)
+	bt.lock.RLock()
+	mt := bt.exitTrees[networkID]
+	bt.lock.RUnlock()
+	return mt.getRoot(ctx, dbTx)
 }
 
 func (bt *BridgeController) AddRollupExitLeaf(ctx context.Context, rollupLeaf etherman.RollupExitLeaf, dbTx pgx.Tx) error {
@@ -91,3 +129,15 @@ func (bt *BridgeController) AddRollupExitLeaf(ctx context.Context, rollupLeaf et
 	}
 	return nil
 }
+
+// networks returns the networks of the exit trees
+func (bt *BridgeController) networks() []uint {
+	bt.lock.RLock()
+	defer bt.lock.RUnlock()
+	networks := make([]uint, 0, len(bt.networkIDs))
+	for network := range bt.networkIDs {
+		networks = append(networks, network)
+	}
+	sort.Slice(networks, func(i, j int) bool { return networks[i] < networks[j] })
+	return networks
+}
@@ -1,9 +1,35 @@
 package bridgectrl
 
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+)
+
 // Config is state config
 type Config struct {
-	// Store is the kind of storage in the bridge tree
+	// Store is the kind of storage of the nodes of the bridge tree: "postgres" or "leveldb".
+	// The rest of data of the bridge tree is always stored in postgres
 	Store string
+	// StorePath is the directory of the embedded store of the nodes when Store is "leveldb"
+	StorePath string
 	// Height is the depth of the merkle tree
 	Height uint8
+	// Pruning is the config of the pruning of the historical nodes and roots of the merkle trees
+	Pruning PruningConfig
+}
+
+// PruningConfig is the config of the pruning of the merkle trees. The roots older than the ones that may still be
+// proven against are removed with the nodes that only they need. The frontier of the trees is always kept, so the
+// new deposits and the proofs against the kept roots are not affected.
+// With the "leveldb" store only the roots are pruned, because its nodes are shared by the deposits that store them.
+type PruningConfig struct {
+	// Enabled whether to prune the merkle trees
+	Enabled bool
+	// KeepGERs is the number of latest global exit roots synced from L1 whose trees are kept. At least the latest one is kept
+	KeepGERs uint
+	// KeepAge keeps the trees of the deposits received within this age, 0 disables the age limit
+	KeepAge types.Duration
+	// Interval is the delay interval between the pruning runs
+	Interval types.Duration
+	// BatchSize is the max number of deposits pruned in each db transaction
+	BatchSize uint
 }
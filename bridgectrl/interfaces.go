@@ -2,6 +2,7 @@ package bridgectrl
 
 import (
 	"context"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/ethereum/go-ethereum/common"
@@ -20,3 +21,39 @@ type merkleTreeStore interface {
 	GetLatestRollupExitLeaves(ctx context.Context, dbTx pgx.Tx) ([]etherman.RollupExitLeaf, error)
 	IsRollupExitRoot(ctx context.Context, root common.Hash, dbTx pgx.Tx) (bool, error)
 }
+
+// prunerStorage interface for the pruning of the Merkle Trees
+type prunerStorage interface {
+	Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error)
+	GetRoot(ctx context.Context, depositCount uint, network uint, dbTx pgx.Tx) ([]byte, error)
+	GetLastDepositCount(ctx context.Context, network uint, dbTx pgx.Tx) (uint, error)
+	GetExitRootDepositCount(ctx context.Context, network uint, offset uint, dbTx pgx.Tx) (uint, error)
+	GetLatestTrustedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	GetDepositCountByRoot(ctx context.Context, root []byte, network uint8, dbTx pgx.Tx) (uint, error)
+	GetFirstDepositCountSince(ctx context.Context, network uint, since time.Time, dbTx pgx.Tx) (uint, error)
+	GetPrunedDepositCount(ctx context.Context, network uint, dbTx pgx.Tx) (uint, error)
+	PruneMerkleTree(ctx context.Context, network uint, depositCount uint, keys [][]byte, dbTx pgx.Tx) (int64, error)
+	BeginDBTransaction(ctx context.Context) (pgx.Tx, error)
+	Commit(ctx context.Context, dbTx pgx.Tx) error
+	Rollback(ctx context.Context, dbTx pgx.Tx) error
+}
+
+// exitTreeStorage interface for the verification of the exit trees
+type exitTreeStorage interface {
+	Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error)
+	GetPrunedDepositCount(ctx context.Context, network uint, dbTx pgx.Tx) (uint, error)
+	IterateDepositRoots(ctx context.Context, networkID uint, fn func(depositID uint64, deposit *etherman.Deposit, root []byte) error, dbTx pgx.Tx) error
+}
+
+// exitTreeRepairStorage interface for the repair of the exit trees
+type exitTreeRepairStorage interface {
+	exitTreeStorage
+	BulkSet(ctx context.Context, rows [][]interface{}, dbTx pgx.Tx) error
+	SetRoot(ctx context.Context, root []byte, depositID uint64, network uint, dbTx pgx.Tx) error
+	DeleteMerkleTreeFrom(ctx context.Context, networkID uint, depositCount uint, dbTx pgx.Tx) error
+}
+
+// rollupExitTreeStorage interface for the verification of the rollup exit tree
+type rollupExitTreeStorage interface {
+	IterateRollupExitRoots(ctx context.Context, fn func(root common.Hash, leaves []etherman.RollupExitLeaf) error, dbTx pgx.Tx) error
+}
@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/kvstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/test/vectors"
@@ -456,3 +457,78 @@ func TestPerformanceComputeRoot(t *testing.T) {
 	require.NoError(t, err)
 	log.Debug("End creating leaves: ", time.Now().Unix()-initTime)
 }
+
+// getProof walks the tree from the root to the leaf, like the bridge service does to build the claim proofs
+func getProof(ctx context.Context, store merkleTreeStore, height uint8, index uint, root []byte) ([][]byte, error) {
+	siblings := make([][]byte, height)
+	cur := root
+	for h := int(height - 1); h >= 0; h-- {
+		value, err := store.Get(ctx, cur, nil)
+		if err != nil {
+			return nil, fmt.Errorf("height: %d, cur: %v, error: %v", h, cur, err)
+		}
+		if index&(1<<h) > 0 {
+			siblings[h] = value[0]
+			cur = value[1]
+		} else {
+			siblings[h] = value[1]
+			cur = value[0]
+		}
+	}
+	return siblings, nil
+}
+
+func BenchmarkMerkleTreeStore(b *testing.B) {
+	b.Run("postgres", func(b *testing.B) {
+		benchmarkMerkleTreeStore(b, func(pg *pgstorage.PostgresStorage) merkleTreeStore {
+			return pg
+		})
+	})
+	b.Run("leveldb", func(b *testing.B) {
+		nodes, err := kvstorage.NewNodeStore(b.TempDir())
+		require.NoError(b, err)
+		defer nodes.Close()
+		benchmarkMerkleTreeStore(b, func(pg *pgstorage.PostgresStorage) merkleTreeStore {
+			return kvstorage.NewStorage(pg, nodes)
+		})
+	})
+}
+
+func benchmarkMerkleTreeStore(b *testing.B, newStore func(pg *pgstorage.PostgresStorage) merkleTreeStore) {
+	ctx := context.Background()
+	dbCfg := pgstorage.NewConfigFromEnv()
+	err := pgstorage.InitOrReset(dbCfg)
+	require.NoError(b, err)
+	pg, err := pgstorage.NewPostgresStorage(dbCfg)
+	require.NoError(b, err)
+	defer pg.Close()
+	store := newStore(pg)
+	mt, err := NewMerkleTree(ctx, store, uint8(32), 0)
+	require.NoError(b, err)
+
+	b.Run("addLeaf", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			deposit := &etherman.Deposit{
+				OriginalAddress: common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+				Amount:          big.NewInt(int64(i)),
+				DepositCount:    mt.count,
+				Metadata:        []byte{},
+			}
+			depositID, err := pg.AddDeposit(ctx, deposit, nil)
+			require.NoError(b, err)
+			b.StartTimer()
+			err = mt.addLeaf(ctx, depositID, hashDeposit(deposit), deposit.DepositCount, nil)
+			require.NoError(b, err)
+		}
+	})
+
+	root, err := mt.getRoot(ctx, nil)
+	require.NoError(b, err)
+	b.Run("proof", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, err := getProof(ctx, store, mt.height, uint(i)%mt.count, root)
+			require.NoError(b, err)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/0xPolygonHermez/zkevm-node/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Prefix for the metrics of the bridgectrl package.
+	Prefix = "bridge_merkletree_"
+
+	// NetworkLabelName is the name of the label that identifies the network of the merkle tree.
+	NetworkLabelName = "network_id"
+
+	// PrunedDepositsName is the name of the metric that counts the deposits whose historical nodes and root are pruned.
+	PrunedDepositsName = Prefix + "pruned_deposits"
+
+	// PrunedNodesName is the name of the metric that counts the pruned historical nodes.
+	PrunedNodesName = Prefix + "pruned_nodes"
+
+	// PruneBacklogName is the name of the metric that shows the number of deposits pending to be pruned.
+	PruneBacklogName = Prefix + "prune_backlog"
+)
+
+// Register the metrics for the bridgectrl package.
+func Register() {
+	counterVecs := []metrics.CounterVecOpts{
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: PrunedDepositsName,
+				Help: "[MERKLETREE] number of deposits whose historical nodes and root are pruned",
+			},
+			Labels: []string{NetworkLabelName},
+		},
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: PrunedNodesName,
+				Help: "[MERKLETREE] number of pruned historical nodes",
+			},
+			Labels: []string{NetworkLabelName},
+		},
+	}
+	gauges := []prometheus.GaugeOpts{
+		{
+			Name: PruneBacklogName,
+			Help: "[MERKLETREE] number of deposits pending to be pruned",
+		},
+	}
+
+	metrics.RegisterCounterVecs(counterVecs...)
+	metrics.RegisterGauges(gauges...)
+}
+
+// Pruned counts the deposits and nodes pruned from the merkle tree of a network.
+func Pruned(network uint, deposits int, nodes int64) {
+	label := strconv.FormatUint(uint64(network), 10) //nolint:gomnd
+	metrics.CounterVecAdd(PrunedDepositsName, label, float64(deposits))
+	metrics.CounterVecAdd(PrunedNodesName, label, float64(nodes))
+}
+
+// PruneBacklog sets the number of deposits pending to be pruned.
+func PruneBacklog(deposits uint) {
+	metrics.GaugeSet(PruneBacklogName, float64(deposits))
+}
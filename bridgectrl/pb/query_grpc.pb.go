@@ -22,12 +22,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BridgeService_CheckAPI_FullMethodName        = "/bridge.v1.BridgeService/CheckAPI"
-	BridgeService_GetBridges_FullMethodName      = "/bridge.v1.BridgeService/GetBridges"
-	BridgeService_GetProof_FullMethodName        = "/bridge.v1.BridgeService/GetProof"
-	BridgeService_GetBridge_FullMethodName       = "/bridge.v1.BridgeService/GetBridge"
-	BridgeService_GetClaims_FullMethodName       = "/bridge.v1.BridgeService/GetClaims"
-	BridgeService_GetTokenWrapped_FullMethodName = "/bridge.v1.BridgeService/GetTokenWrapped"
+	BridgeService_CheckAPI_FullMethodName          = "/bridge.v1.BridgeService/CheckAPI"
+	BridgeService_GetBridges_FullMethodName        = "/bridge.v1.BridgeService/GetBridges"
+	BridgeService_GetProof_FullMethodName          = "/bridge.v1.BridgeService/GetProof"
+	BridgeService_GetBridge_FullMethodName         = "/bridge.v1.BridgeService/GetBridge"
+	BridgeService_GetClaims_FullMethodName         = "/bridge.v1.BridgeService/GetClaims"
+	BridgeService_GetTokenWrapped_FullMethodName   = "/bridge.v1.BridgeService/GetTokenWrapped"
+	BridgeService_GetReorgs_FullMethodName         = "/bridge.v1.BridgeService/GetReorgs"
+	BridgeService_GetEmergencyState_FullMethodName = "/bridge.v1.BridgeService/GetEmergencyState"
+	BridgeService_ListTokenWrapped_FullMethodName  = "/bridge.v1.BridgeService/ListTokenWrapped"
+	BridgeService_GetTokenOrigin_FullMethodName    = "/bridge.v1.BridgeService/GetTokenOrigin"
+	BridgeService_GetNetworks_FullMethodName       = "/bridge.v1.BridgeService/GetNetworks"
+	BridgeService_GetSyncStatus_FullMethodName     = "/bridge.v1.BridgeService/GetSyncStatus"
+	BridgeService_GetBridgeStats_FullMethodName    = "/bridge.v1.BridgeService/GetBridgeStats"
+	BridgeService_GetSolvency_FullMethodName       = "/bridge.v1.BridgeService/GetSolvency"
+	BridgeService_GetClaimFindings_FullMethodName  = "/bridge.v1.BridgeService/GetClaimFindings"
+	BridgeService_GetPendingClaims_FullMethodName  = "/bridge.v1.BridgeService/GetPendingClaims"
 )
 
 // BridgeServiceClient is the client API for BridgeService service.
@@ -47,6 +57,26 @@ type BridgeServiceClient interface {
 	GetClaims(ctx context.Context, in *GetClaimsRequest, opts ...grpc.CallOption) (*GetClaimsResponse, error)
 	// / Get token wrapped for the specific smart contract address both in L1 and L2
 	GetTokenWrapped(ctx context.Context, in *GetTokenWrappedRequest, opts ...grpc.CallOption) (*GetTokenWrappedResponse, error)
+	// / Get the reorgs detected in the specific network, including the removed deposits, claims and global exit roots
+	GetReorgs(ctx context.Context, in *GetReorgsRequest, opts ...grpc.CallOption) (*GetReorgsResponse, error)
+	// / Get the emergency state of the specific network. The bridge is paused while the emergency state is active
+	GetEmergencyState(ctx context.Context, in *GetEmergencyStateRequest, opts ...grpc.CallOption) (*GetEmergencyStateResponse, error)
+	// / Get the tokens wrapped in all the networks or in the specific network
+	ListTokenWrapped(ctx context.Context, in *ListTokenWrappedRequest, opts ...grpc.CallOption) (*ListTokenWrappedResponse, error)
+	// / Get the original token of the specific token. The native tokens that were never wrapped are returned as they are
+	GetTokenOrigin(ctx context.Context, in *GetTokenOriginRequest, opts ...grpc.CallOption) (*GetTokenOriginResponse, error)
+	// / Get the networks synced by the service and the rollups registered in the rollup manager
+	GetNetworks(ctx context.Context, in *GetNetworksRequest, opts ...grpc.CallOption) (*GetNetworksResponse, error)
+	// / Get the synchronization progress of the specific network
+	GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error)
+	// / Get the bridge volume of each origin token and direction grouped by hour or day, and the value that is not claimed yet
+	GetBridgeStats(ctx context.Context, in *GetBridgeStatsRequest, opts ...grpc.CallOption) (*GetBridgeStatsResponse, error)
+	// / Get the latest solvency check of each network, comparing the amounts locked in and minted by the bridge according to the synced deposits and claims with the ones on chain
+	GetSolvency(ctx context.Context, in *GetSolvencyRequest, opts ...grpc.CallOption) (*GetSolvencyResponse, error)
+	// / Get the claims that don't match the synced deposits: the claims of unknown deposits, the claims whose fields differ from the deposit ones and the duplicated claims
+	GetClaimFindings(ctx context.Context, in *GetClaimFindingsRequest, opts ...grpc.CallOption) (*GetClaimFindingsResponse, error)
+	// / Get the deposits to the destination address that are ready for claim but not claimed yet, with the pending amount of each token
+	GetPendingClaims(ctx context.Context, in *GetPendingClaimsRequest, opts ...grpc.CallOption) (*GetPendingClaimsResponse, error)
 }
 
 type bridgeServiceClient struct {
@@ -111,6 +141,96 @@ func (c *bridgeServiceClient) GetTokenWrapped(ctx context.Context, in *GetTokenW
 	return out, nil
 }
 
+func (c *bridgeServiceClient) GetReorgs(ctx context.Context, in *GetReorgsRequest, opts ...grpc.CallOption) (*GetReorgsResponse, error) {
+	out := new(GetReorgsResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetReorgs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetEmergencyState(ctx context.Context, in *GetEmergencyStateRequest, opts ...grpc.CallOption) (*GetEmergencyStateResponse, error) {
+	out := new(GetEmergencyStateResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetEmergencyState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) ListTokenWrapped(ctx context.Context, in *ListTokenWrappedRequest, opts ...grpc.CallOption) (*ListTokenWrappedResponse, error) {
+	out := new(ListTokenWrappedResponse)
+	err := c.cc.Invoke(ctx, BridgeService_ListTokenWrapped_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetTokenOrigin(ctx context.Context, in *GetTokenOriginRequest, opts ...grpc.CallOption) (*GetTokenOriginResponse, error) {
+	out := new(GetTokenOriginResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetTokenOrigin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetNetworks(ctx context.Context, in *GetNetworksRequest, opts ...grpc.CallOption) (*GetNetworksResponse, error) {
+	out := new(GetNetworksResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetNetworks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetSyncStatus(ctx context.Context, in *GetSyncStatusRequest, opts ...grpc.CallOption) (*GetSyncStatusResponse, error) {
+	out := new(GetSyncStatusResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetSyncStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetBridgeStats(ctx context.Context, in *GetBridgeStatsRequest, opts ...grpc.CallOption) (*GetBridgeStatsResponse, error) {
+	out := new(GetBridgeStatsResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetBridgeStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetSolvency(ctx context.Context, in *GetSolvencyRequest, opts ...grpc.CallOption) (*GetSolvencyResponse, error) {
+	out := new(GetSolvencyResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetSolvency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetClaimFindings(ctx context.Context, in *GetClaimFindingsRequest, opts ...grpc.CallOption) (*GetClaimFindingsResponse, error) {
+	out := new(GetClaimFindingsResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetClaimFindings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetPendingClaims(ctx context.Context, in *GetPendingClaimsRequest, opts ...grpc.CallOption) (*GetPendingClaimsResponse, error) {
+	out := new(GetPendingClaimsResponse)
+	err := c.cc.Invoke(ctx, BridgeService_GetPendingClaims_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BridgeServiceServer is the server API for BridgeService service.
 // All implementations must embed UnimplementedBridgeServiceServer
 // for forward compatibility
@@ -128,6 +248,26 @@ type BridgeServiceServer interface {
 	GetClaims(context.Context, *GetClaimsRequest) (*GetClaimsResponse, error)
 	// / Get token wrapped for the specific smart contract address both in L1 and L2
 	GetTokenWrapped(context.Context, *GetTokenWrappedRequest) (*GetTokenWrappedResponse, error)
+	// / Get the reorgs detected in the specific network, including the removed deposits, claims and global exit roots
+	GetReorgs(context.Context, *GetReorgsRequest) (*GetReorgsResponse, error)
+	// / Get the emergency state of the specific network. The bridge is paused while the emergency state is active
+	GetEmergencyState(context.Context, *GetEmergencyStateRequest) (*GetEmergencyStateResponse, error)
+	// / Get the tokens wrapped in all the networks or in the specific network
+	ListTokenWrapped(context.Context, *ListTokenWrappedRequest) (*ListTokenWrappedResponse, error)
+	// / Get the original token of the specific token. The native tokens that were never wrapped are returned as they are
+	GetTokenOrigin(context.Context, *GetTokenOriginRequest) (*GetTokenOriginResponse, error)
+	// / Get the networks synced by the service and the rollups registered in the rollup manager
+	GetNetworks(context.Context, *GetNetworksRequest) (*GetNetworksResponse, error)
+	// / Get the synchronization progress of the specific network
+	GetSyncStatus(context.Context, *GetSyncStatusRequest) (*GetSyncStatusResponse, error)
+	// / Get the bridge volume of each origin token and direction grouped by hour or day, and the value that is not claimed yet
+	GetBridgeStats(context.Context, *GetBridgeStatsRequest) (*GetBridgeStatsResponse, error)
+	// / Get the latest solvency check of each network, comparing the amounts locked in and minted by the bridge according to the synced deposits and claims with the ones on chain
+	GetSolvency(context.Context, *GetSolvencyRequest) (*GetSolvencyResponse, error)
+	// / Get the claims that don't match the synced deposits: the claims of unknown deposits, the claims whose fields differ from the deposit ones and the duplicated claims
+	GetClaimFindings(context.Context, *GetClaimFindingsRequest) (*GetClaimFindingsResponse, error)
+	// / Get the deposits to the destination address that are ready for claim but not claimed yet, with the pending amount of each token
+	GetPendingClaims(context.Context, *GetPendingClaimsRequest) (*GetPendingClaimsResponse, error)
 	mustEmbedUnimplementedBridgeServiceServer()
 }
 
@@ -153,6 +293,36 @@ func (UnimplementedBridgeServiceServer) GetClaims(context.Context, *GetClaimsReq
 func (UnimplementedBridgeServiceServer) GetTokenWrapped(context.Context, *GetTokenWrappedRequest) (*GetTokenWrappedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTokenWrapped not implemented")
 }
+func (UnimplementedBridgeServiceServer) GetReorgs(context.Context, *GetReorgsRequest) (*GetReorgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReorgs not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetEmergencyState(context.Context, *GetEmergencyStateRequest) (*GetEmergencyStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmergencyState not implemented")
+}
+func (UnimplementedBridgeServiceServer) ListTokenWrapped(context.Context, *ListTokenWrappedRequest) (*ListTokenWrappedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokenWrapped not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetTokenOrigin(context.Context, *GetTokenOriginRequest) (*GetTokenOriginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenOrigin not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetNetworks(context.Context, *GetNetworksRequest) (*GetNetworksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworks not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetSyncStatus(context.Context, *GetSyncStatusRequest) (*GetSyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncStatus not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetBridgeStats(context.Context, *GetBridgeStatsRequest) (*GetBridgeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBridgeStats not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetSolvency(context.Context, *GetSolvencyRequest) (*GetSolvencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSolvency not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetClaimFindings(context.Context, *GetClaimFindingsRequest) (*GetClaimFindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimFindings not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetPendingClaims(context.Context, *GetPendingClaimsRequest) (*GetPendingClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingClaims not implemented")
+}
 func (UnimplementedBridgeServiceServer) mustEmbedUnimplementedBridgeServiceServer() {}
 
 // UnsafeBridgeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -274,6 +444,186 @@ func _BridgeService_GetTokenWrapped_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_GetReorgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReorgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetReorgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetReorgs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetReorgs(ctx, req.(*GetReorgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetEmergencyState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmergencyStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetEmergencyState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetEmergencyState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetEmergencyState(ctx, req.(*GetEmergencyStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_ListTokenWrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokenWrappedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).ListTokenWrapped(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_ListTokenWrapped_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).ListTokenWrapped(ctx, req.(*ListTokenWrappedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetTokenOrigin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenOriginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetTokenOrigin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetTokenOrigin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetTokenOrigin(ctx, req.(*GetTokenOriginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetNetworks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetNetworks(ctx, req.(*GetNetworksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetSyncStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetSyncStatus(ctx, req.(*GetSyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetBridgeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBridgeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetBridgeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetBridgeStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetBridgeStats(ctx, req.(*GetBridgeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetSolvency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSolvencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetSolvency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetSolvency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetSolvency(ctx, req.(*GetSolvencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetClaimFindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimFindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetClaimFindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetClaimFindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetClaimFindings(ctx, req.(*GetClaimFindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetPendingClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetPendingClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BridgeService_GetPendingClaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetPendingClaims(ctx, req.(*GetPendingClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BridgeService_ServiceDesc is the grpc.ServiceDesc for BridgeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +655,46 @@ var BridgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTokenWrapped",
 			Handler:    _BridgeService_GetTokenWrapped_Handler,
 		},
+		{
+			MethodName: "GetReorgs",
+			Handler:    _BridgeService_GetReorgs_Handler,
+		},
+		{
+			MethodName: "GetEmergencyState",
+			Handler:    _BridgeService_GetEmergencyState_Handler,
+		},
+		{
+			MethodName: "ListTokenWrapped",
+			Handler:    _BridgeService_ListTokenWrapped_Handler,
+		},
+		{
+			MethodName: "GetTokenOrigin",
+			Handler:    _BridgeService_GetTokenOrigin_Handler,
+		},
+		{
+			MethodName: "GetNetworks",
+			Handler:    _BridgeService_GetNetworks_Handler,
+		},
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _BridgeService_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "GetBridgeStats",
+			Handler:    _BridgeService_GetBridgeStats_Handler,
+		},
+		{
+			MethodName: "GetSolvency",
+			Handler:    _BridgeService_GetSolvency_Handler,
+		},
+		{
+			MethodName: "GetClaimFindings",
+			Handler:    _BridgeService_GetClaimFindings_Handler,
+		},
+		{
+			MethodName: "GetPendingClaims",
+			Handler:    _BridgeService_GetPendingClaims_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query.proto",
@@ -0,0 +1,208 @@
+package bridgectrl
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/jackc/pgx/v4"
+)
+
+const defaultPruningBatchSize = 1000
+
+// Pruner periodically removes the roots of the exit trees that can't be proven against anymore, with the nodes
+// stored by their deposits that no kept root needs.
+//
+// A node stored by a deposit is final when the subtree below it is complete, so the next deposits don't replace it
+// and every later root goes through it. The rest of nodes of a deposit are historical: only its own root needs them.
+// A proof against a kept root only needs final nodes and the nodes of the deposit of the root, so the historical
+// nodes of the pruned deposits can be removed without affecting the proofs nor the frontier of the trees.
+type Pruner struct {
+	cfg     PruningConfig
+	bt      *BridgeController
+	storage prunerStorage
+}
+
+type pruneRange struct {
+	network uint
+	from    uint
+	to      uint
+}
+
+// NewPruner creates a new merkle tree pruner
+func NewPruner(cfg PruningConfig, bt *BridgeController, storage interface{}) *Pruner {
+	if cfg.BatchSize == 0 {
+		cfg.BatchSize = defaultPruningBatchSize
+	}
+	return &Pruner{
+		cfg:     cfg,
+		bt:      bt,
+		storage: storage.(prunerStorage),
+	}
+}
+
+// Start prunes the merkle trees every interval. It returns once the context is done.
+func (p *Pruner) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.cfg.Interval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			p.prune(ctx)
+		}
+	}
+}
+
+func (p *Pruner) prune(ctx context.Context) {
+	var (
+		ranges  []pruneRange
+		backlog uint
+	)
+	for _, network := range p.bt.networks() {
+		r, err := p.pruneRange(ctx, network)
+		if err == gerror.ErrStorageNotFound {
+			log.Debugf("networkID: %d, no global exit root includes the tree yet, skipping the pruning", network)
+			continue
+		} else if err != nil {
+			log.Errorf("networkID: %d, error getting the deposits to prune. Error: %v", network, err)
+			continue
+		}
+		if r.from < r.to {
+			ranges = append(ranges, r)
+			backlog += r.to - r.from
+		}
+	}
+	metrics.PruneBacklog(backlog)
+	for _, r := range ranges {
+		for from := r.from; from < r.to; from += p.cfg.BatchSize {
+			to := min(from+p.cfg.BatchSize, r.to)
+			nodes, err := p.pruneBatch(ctx, r.network, from, to)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Errorf("networkID: %d, error pruning the deposits %d to %d. Error: %v", r.network, from, to-1, err)
+				}
+				break
+			}
+			log.Debugf("networkID: %d, pruned the deposits %d to %d, nodes: %d", r.network, from, to-1, nodes)
+			metrics.Pruned(r.network, int(to-from), nodes)
+			backlog -= to - from
+			metrics.PruneBacklog(backlog)
+		}
+	}
+	metrics.PruneBacklog(backlog)
+}
+
+// pruneRange gets the deposits of the network that can be pruned: the ones since the last pruned deposit until the
+// deposit of the oldest kept root, which is never the last deposit. The mainnet root of the latest trusted global
+// exit root is kept too, because the claims of the mainnet deposits in the rollups are proven against it.
+func (p *Pruner) pruneRange(ctx context.Context, network uint) (pruneRange, error) {
+	var offset uint
+	if p.cfg.KeepGERs > 0 {
+		offset = p.cfg.KeepGERs - 1
+	}
+	keep, err := p.storage.GetExitRootDepositCount(ctx, network, offset, nil)
+	if err != nil {
+		return pruneRange{}, err
+	}
+	if network == 0 {
+		trusted, err := p.trustedDepositCount(ctx)
+		if err == nil {
+			keep = min(keep, trusted)
+		} else if err != gerror.ErrStorageNotFound {
+			return pruneRange{}, err
+		}
+	}
+	if p.cfg.KeepAge.Duration > 0 {
+		since, err := p.storage.GetFirstDepositCountSince(ctx, network, time.Now().Add(-p.cfg.KeepAge.Duration), nil)
+		if err == nil {
+			keep = min(keep, since)
+		} else if err != gerror.ErrStorageNotFound {
+			return pruneRange{}, err
+		}
+	}
+	last, err := p.storage.GetLastDepositCount(ctx, network, nil)
+	if err != nil {
+		return pruneRange{}, err
+	}
+	keep = min(keep, last)
+	from, err := p.storage.GetPrunedDepositCount(ctx, network, nil)
+	if err != nil {
+		return pruneRange{}, err
+	}
+	return pruneRange{network: network, from: from, to: keep}, nil
+}
+
+// trustedDepositCount gets the deposit count of the mainnet root of the latest trusted global exit root
+func (p *Pruner) trustedDepositCount(ctx context.Context) (uint, error) {
+	ger, err := p.storage.GetLatestTrustedExitRoot(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	return p.storage.GetDepositCountByRoot(ctx, ger.ExitRoots[0][:], 0, nil)
+}
+
+// pruneBatch prunes the deposits of the network from the given deposit count until the one before to in a db transaction
+func (p *Pruner) pruneBatch(ctx context.Context, network uint, from, to uint) (int64, error) {
+	dbTx, err := p.storage.BeginDBTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var nodes int64
+	for depositCount := from; depositCount < to; depositCount++ {
+		n, err := p.pruneDeposit(ctx, network, depositCount, dbTx)
+		if err != nil {
+			if errRollback := p.storage.Rollback(ctx, dbTx); errRollback != nil {
+				log.Errorf("networkID: %d, error rolling back the pruning. Error: %v", network, errRollback)
+			}
+			return 0, err
+		}
+		nodes += n
+	}
+	return nodes, p.storage.Commit(ctx, dbTx)
+}
+
+func (p *Pruner) pruneDeposit(ctx context.Context, network uint, depositCount uint, dbTx pgx.Tx) (int64, error) {
+	var keys [][]byte
+	root, err := p.storage.GetRoot(ctx, depositCount, network, dbTx)
+	if err == nil {
+		keys, err = p.historicalNodes(ctx, root, depositCount, dbTx)
+		if err != nil {
+			return 0, err
+		}
+	} else if err != gerror.ErrStorageNotFound {
+		return 0, err
+	}
+	return p.storage.PruneMerkleTree(ctx, network, depositCount, keys, dbTx)
+}
+
+// historicalNodes gets the keys of the nodes stored by the deposit of the given index that are not final, from its
+// root down to the level of the first incomplete subtree of the leaf.
+func (p *Pruner) historicalNodes(ctx context.Context, root []byte, index uint, dbTx pgx.Tx) ([][]byte, error) {
+	// The subtrees of the leaf up to this level are complete, so their nodes are final
+	complete := finalNodes(index)
+	if complete >= int(p.bt.height) {
+		return nil, nil
+	}
+	var keys [][]byte
+	cur := root
+	for h := int(p.bt.height) - 1; h >= complete; h-- {
+		keys = append(keys, cur)
+		if h == complete {
+			break
+		}
+		value, err := p.storage.Get(ctx, cur, dbTx)
+		if err != nil {
+			return nil, err
+		}
+		if index&(1<<h) > 0 {
+			cur = value[1]
+		} else {
+			cur = value[0]
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,184 @@
+package bridgectrl
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/memstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// pruningStore hides the nodes pruned from the memory storage, which keeps them because they are shared
+type pruningStore struct {
+	*memstorage.MemoryStorage
+	pruned map[string]struct{}
+}
+
+func (s *pruningStore) Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error) {
+	if _, ok := s.pruned[string(key)]; ok {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return s.MemoryStorage.Get(ctx, key, dbTx)
+}
+
+func (s *pruningStore) PruneMerkleTree(ctx context.Context, network uint, depositCount uint, keys [][]byte, dbTx pgx.Tx) (int64, error) {
+	for _, key := range keys {
+		s.pruned[string(key)] = struct{}{}
+	}
+	if _, err := s.MemoryStorage.PruneMerkleTree(ctx, network, depositCount, keys, dbTx); err != nil {
+		return 0, err
+	}
+	return int64(len(keys)), nil
+}
+
+func addPrunerDeposits(t *testing.T, store *memstorage.MemoryStorage, bt *BridgeController, from, to uint) {
+	ctx := context.Background()
+	for i := from; i < to; i++ {
+		blockID, err := store.AddBlock(ctx, &etherman.Block{
+			BlockNumber: uint64(i + 1),
+			BlockHash:   common.BigToHash(big.NewInt(int64(i + 1))),
+			ReceivedAt:  time.Now(),
+		}, nil)
+		require.NoError(t, err)
+		deposit := &etherman.Deposit{
+			OriginalAddress:    common.BigToAddress(big.NewInt(1)),
+			Amount:             big.NewInt(int64(i + 1)),
+			DestinationNetwork: 1,
+			DestinationAddress: common.BigToAddress(big.NewInt(int64(i))),
+			BlockID:            blockID,
+			DepositCount:       i,
+		}
+		depositID, err := store.AddDeposit(ctx, deposit, nil)
+		require.NoError(t, err)
+		require.NoError(t, bt.AddDeposit(ctx, deposit, depositID, nil))
+	}
+}
+
+func TestPruner(t *testing.T) {
+	const (
+		height   = 32
+		deposits = 20
+		gerIndex = 15
+	)
+	ctx := context.Background()
+	cfg := Config{Height: height}
+	store := &pruningStore{MemoryStorage: memstorage.NewMemoryStorage(), pruned: make(map[string]struct{})}
+	bt, err := NewBridgeController(ctx, cfg, []uint{0}, store)
+	require.NoError(t, err)
+	reference := memstorage.NewMemoryStorage()
+	referenceBt, err := NewBridgeController(ctx, cfg, []uint{0}, reference)
+	require.NoError(t, err)
+	addPrunerDeposits(t, store.MemoryStorage, bt, 0, deposits)
+	addPrunerDeposits(t, reference, referenceBt, 0, deposits)
+
+	// Nothing is pruned until a global exit root includes the tree
+	pruner := NewPruner(PruningConfig{KeepGERs: 1, BatchSize: 4}, bt, store)
+	pruner.prune(ctx)
+	pruned, err := store.GetPrunedDepositCount(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint(0), pruned)
+
+	gerRoot, err := store.GetRoot(ctx, gerIndex, 0, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.AddGlobalExitRoot(ctx, &etherman.GlobalExitRoot{
+		BlockID:   1,
+		ExitRoots: []common.Hash{common.BytesToHash(gerRoot), {}},
+	}, nil))
+	pruner.prune(ctx)
+	pruned, err = store.GetPrunedDepositCount(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint(gerIndex), pruned)
+	require.NotEmpty(t, store.pruned)
+
+	for j := uint(0); j < deposits; j++ {
+		root, err := store.GetRoot(ctx, j, 0, nil)
+		if j < gerIndex {
+			require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+			continue
+		}
+		require.NoError(t, err)
+		// Every deposit can still be proven against the kept roots
+		for i := uint(0); i <= j; i++ {
+			deposit, err := store.GetDeposit(ctx, i, 0, nil)
+			require.NoError(t, err)
+			proof, err := getProof(ctx, store, height, i, root)
+			require.NoError(t, err)
+			var smtProof [][KeyLen]byte
+			for _, sibling := range proof {
+				smtProof = append(smtProof, common.BytesToHash(sibling))
+			}
+			require.Equal(t, common.BytesToHash(root), calculateRoot(hashDeposit(deposit), smtProof, i, height), "deposit %d, root %d", i, j)
+		}
+	}
+
+	// The frontier is kept, so the tree is rebuilt from the storage and keeps growing like an unpruned one
+	bt, err = NewBridgeController(ctx, cfg, []uint{0}, store)
+	require.NoError(t, err)
+	addPrunerDeposits(t, store.MemoryStorage, bt, deposits, deposits+1)
+	addPrunerDeposits(t, reference, referenceBt, deposits, deposits+1)
+	root, err := store.GetRoot(ctx, deposits, 0, nil)
+	require.NoError(t, err)
+	referenceRoot, err := reference.GetRoot(ctx, deposits, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, referenceRoot, root)
+
+	// The next run only prunes the new deposits
+	pruner.prune(ctx)
+	pruned, err = store.GetPrunedDepositCount(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint(gerIndex), pruned)
+}
+
+func TestPrunerKeepsTrustedExitRoot(t *testing.T) {
+	const (
+		deposits     = 20
+		gerIndex     = 15
+		trustedIndex = 10
+	)
+	ctx := context.Background()
+	store := &pruningStore{MemoryStorage: memstorage.NewMemoryStorage(), pruned: make(map[string]struct{})}
+	bt, err := NewBridgeController(ctx, Config{Height: 32}, []uint{0}, store)
+	require.NoError(t, err)
+	addPrunerDeposits(t, store.MemoryStorage, bt, 0, deposits)
+
+	gerRoot, err := store.GetRoot(ctx, gerIndex, 0, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.AddGlobalExitRoot(ctx, &etherman.GlobalExitRoot{
+		BlockID:        1,
+		GlobalExitRoot: common.HexToHash("0x1"),
+		ExitRoots:      []common.Hash{common.BytesToHash(gerRoot), {}},
+	}, nil))
+	// The rollups haven't received the latest global exit root yet
+	trustedRoot, err := store.GetRoot(ctx, trustedIndex, 0, nil)
+	require.NoError(t, err)
+	_, err = store.AddTrustedGlobalExitRoot(ctx, &etherman.GlobalExitRoot{
+		GlobalExitRoot: common.HexToHash("0x2"),
+		ExitRoots:      []common.Hash{common.BytesToHash(trustedRoot), {}},
+	}, nil)
+	require.NoError(t, err)
+
+	pruner := NewPruner(PruningConfig{KeepGERs: 1, BatchSize: 4}, bt, store)
+	pruner.prune(ctx)
+	pruned, err := store.GetPrunedDepositCount(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint(trustedIndex), pruned)
+	_, err = store.GetRoot(ctx, trustedIndex, 0, nil)
+	require.NoError(t, err)
+
+	// Once the trusted global exit root catches up, the pruning reaches the L1 one
+	_, err = store.AddTrustedGlobalExitRoot(ctx, &etherman.GlobalExitRoot{
+		GlobalExitRoot: common.HexToHash("0x1"),
+		ExitRoots:      []common.Hash{common.BytesToHash(gerRoot), {}},
+	}, nil)
+	require.NoError(t, err)
+	pruner.prune(ctx)
+	pruned, err = store.GetPrunedDepositCount(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint(gerIndex), pruned)
+}
@@ -0,0 +1,265 @@
+package bridgectrl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+// RollupsTreeNetworkID is the network of the rollup exit tree in the reports of the verifications
+const RollupsTreeNetworkID = math.MaxInt32
+
+// errDiverged stops the iteration of the deposits at the first divergence
+var errDiverged = errors.New("diverged")
+
+// DivergenceKind identifies how a merkle tree differs from the one rebuilt from the synced data
+type DivergenceKind string
+
+const (
+	// MissingDepositDivergence is a gap in the deposit counts of the network
+	MissingDepositDivergence DivergenceKind = "missing_deposit"
+	// MissingRootDivergence is a deposit that is not pruned and has no stored root
+	MissingRootDivergence DivergenceKind = "missing_root"
+	// RootDivergence is a stored root that doesn't match the rebuilt one
+	RootDivergence DivergenceKind = "root"
+	// NodeDivergence is a node of a deposit that is missing or whose children don't match the rebuilt ones
+	NodeDivergence DivergenceKind = "node"
+	// LeavesDivergence is a root of the rollup exit tree with different leaves for the same rollup or without the leaf of a rollup
+	LeavesDivergence DivergenceKind = "leaves"
+)
+
+// Divergence is the first difference between a merkle tree and the one rebuilt from the synced data. For the rollup
+// exit tree, DepositCount is the position of the root in the order the roots were stored.
+type Divergence struct {
+	Kind         DivergenceKind
+	NetworkID    uint
+	DepositCount uint
+	// Key is the key of the node or the root of the rollup exit tree that diverges
+	Key     []byte
+	Stored  [][]byte
+	Rebuilt [][]byte
+}
+
+func (d Divergence) String() string {
+	return fmt.Sprintf("networkID: %d, deposit: %d, kind: %s, key: %x, stored: %x, rebuilt: %x", d.NetworkID, d.DepositCount, d.Kind, d.Key, d.Stored, d.Rebuilt)
+}
+
+// TreeReport is the result of the verification of a merkle tree. Divergence is nil if the tree matches the synced data.
+type TreeReport struct {
+	NetworkID  uint
+	Leaves     uint
+	Roots      uint
+	Nodes      uint
+	Divergence *Divergence
+}
+
+// treeNode is a node stored by a deposit: the parent and its children, like the rows of mt.rht
+type treeNode struct {
+	key   [KeyLen]byte
+	left  [KeyLen]byte
+	right [KeyLen]byte
+}
+
+// frontier is the in-memory exit tree of the deposit contract: the left siblings of the next leaf are enough
+// to compute the root and the nodes of each deposit without reading the stored ones.
+type frontier struct {
+	height uint8
+	count  uint
+	branch [][KeyLen]byte
+}
+
+func newFrontier(height uint8) *frontier {
+	return &frontier{height: height, branch: make([][KeyLen]byte, height)}
+}
+
+// add adds the leaf and returns the nodes the deposit stores, from the leaf level up, like addLeaf does, and the new root
+func (f *frontier) add(leaf [KeyLen]byte) ([]treeNode, [KeyLen]byte) {
+	nodes := make([]treeNode, 0, f.height)
+	index := f.count
+	cur := leaf
+	for h := uint8(0); h < f.height; h++ {
+		var node treeNode
+		if index&(1<<h) > 0 {
+			node = treeNode{key: Hash(f.branch[h], cur), left: f.branch[h], right: cur}
+		} else {
+			node = treeNode{key: Hash(cur, zeroHashes[h]), left: cur, right: zeroHashes[h]}
+		}
+		nodes = append(nodes, node)
+		cur = node.key
+	}
+	f.count++
+	node := leaf
+	for h := uint8(0); h < f.height; h++ {
+		if (f.count>>h)&1 == 1 {
+			f.branch[h] = node
+			break
+		}
+		node = Hash(f.branch[h], node)
+	}
+	return nodes, cur
+}
+
+// finalNodes gets the number of nodes of the deposit, from the leaf level up, whose subtrees are complete.
+// They are kept when the deposit is pruned.
+func finalNodes(index uint) int {
+	return bits.TrailingZeros(^index)
+}
+
+// VerifyExitTree rebuilds the exit tree of the network from its deposits and compares the roots and, if checkNodes is
+// set, the nodes stored for each deposit with the rebuilt ones. The roots and historical nodes of the pruned deposits
+// are not checked. It stops at the first divergence.
+func VerifyExitTree(ctx context.Context, storage interface{}, height uint8, networkID uint, checkNodes bool, dbTx pgx.Tx) (*TreeReport, error) {
+	s := storage.(exitTreeStorage)
+	pruned, err := s.GetPrunedDepositCount(ctx, networkID, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	report := &TreeReport{NetworkID: networkID}
+	tree := newFrontier(height)
+	err = s.IterateDepositRoots(ctx, networkID, func(_ uint64, deposit *etherman.Deposit, root []byte) error {
+		if deposit.DepositCount != tree.count {
+			report.Divergence = &Divergence{Kind: MissingDepositDivergence, NetworkID: networkID, DepositCount: tree.count}
+			return errDiverged
+		}
+		nodes, rebuilt := tree.add(hashDeposit(deposit))
+		report.Leaves++
+		if root == nil && deposit.DepositCount >= pruned {
+			report.Divergence = &Divergence{Kind: MissingRootDivergence, NetworkID: networkID, DepositCount: deposit.DepositCount, Rebuilt: [][]byte{rebuilt[:]}}
+			return errDiverged
+		} else if root != nil {
+			if !bytes.Equal(root, rebuilt[:]) {
+				report.Divergence = &Divergence{Kind: RootDivergence, NetworkID: networkID, DepositCount: deposit.DepositCount, Stored: [][]byte{root}, Rebuilt: [][]byte{rebuilt[:]}}
+				return errDiverged
+			}
+			report.Roots++
+		}
+		if !checkNodes {
+			return nil
+		}
+		if root == nil {
+			nodes = nodes[:min(finalNodes(deposit.DepositCount), len(nodes))]
+		}
+		for _, node := range nodes {
+			value, err := s.Get(ctx, node.key[:], dbTx)
+			if err != nil && err != gerror.ErrStorageNotFound {
+				return err
+			}
+			if err != nil || len(value) != 2 || !bytes.Equal(value[0], node.left[:]) || !bytes.Equal(value[1], node.right[:]) { //nolint:gomnd
+				report.Divergence = &Divergence{Kind: NodeDivergence, NetworkID: networkID, DepositCount: deposit.DepositCount, Key: node.key[:], Stored: value, Rebuilt: [][]byte{node.left[:], node.right[:]}}
+				return errDiverged
+			}
+			report.Nodes++
+		}
+		return nil
+	}, dbTx)
+	if err != nil && err != errDiverged {
+		return nil, err
+	}
+	return report, nil
+}
+
+// VerifyRoots rebuilds the exit tree of the network from its deposits and checks that the roots stored for them
+// match the rebuilt ones. It returns the number of checked roots.
+func VerifyRoots(ctx context.Context, storage interface{}, height uint8, networkID uint, dbTx pgx.Tx) (uint, error) {
+	report, err := VerifyExitTree(ctx, storage, height, networkID, false, dbTx)
+	if err != nil {
+		return 0, err
+	}
+	if report.Divergence != nil {
+		return report.Roots, fmt.Errorf("%w: %s", gerror.ErrRootMismatch, report.Divergence)
+	}
+	return report.Roots, nil
+}
+
+// RepairExitTree replaces the nodes and roots stored by the deposits of the network since the given deposit count
+// with the ones rebuilt from the deposits. The deposits before it must match the stored tree, like the ones before
+// the first divergence. The pruned deposits only get their final nodes back. It returns the number of repaired deposits.
+func RepairExitTree(ctx context.Context, storage interface{}, height uint8, networkID uint, from uint, dbTx pgx.Tx) (uint, error) {
+	s := storage.(exitTreeRepairStorage)
+	pruned, err := s.GetPrunedDepositCount(ctx, networkID, dbTx)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.DeleteMerkleTreeFrom(ctx, networkID, from, dbTx); err != nil {
+		return 0, err
+	}
+	var repaired uint
+	tree := newFrontier(height)
+	err = s.IterateDepositRoots(ctx, networkID, func(depositID uint64, deposit *etherman.Deposit, _ []byte) error {
+		if deposit.DepositCount != tree.count {
+			return fmt.Errorf("networkID: %d, missing deposit %d, next deposit: %d. The missing deposits must be synced again", networkID, tree.count, deposit.DepositCount)
+		}
+		nodes, root := tree.add(hashDeposit(deposit))
+		if deposit.DepositCount < from {
+			return nil
+		}
+		if deposit.DepositCount < pruned {
+			nodes = nodes[:min(finalNodes(deposit.DepositCount), len(nodes))]
+		}
+		rows := make([][]interface{}, 0, len(nodes))
+		for i := range nodes {
+			rows = append(rows, []interface{}{nodes[i].key[:], [][]byte{nodes[i].left[:], nodes[i].right[:]}, depositID})
+		}
+		if len(rows) > 0 {
+			if err := s.BulkSet(ctx, rows, dbTx); err != nil {
+				return err
+			}
+		}
+		if deposit.DepositCount >= pruned {
+			if err := s.SetRoot(ctx, root[:], depositID, networkID, dbTx); err != nil {
+				return err
+			}
+		}
+		repaired++
+		return nil
+	}, dbTx)
+	return repaired, err
+}
+
+// VerifyRollupExitTree rebuilds each root of the rollup exit tree from its leaves and compares it with the stored
+// one. It stops at the first divergence.
+func VerifyRollupExitTree(ctx context.Context, storage interface{}, height uint8, dbTx pgx.Tx) (*TreeReport, error) {
+	report := &TreeReport{NetworkID: RollupsTreeNetworkID}
+	mt := &MerkleTree{height: height, network: RollupsTreeNetworkID}
+	err := storage.(rollupExitTreeStorage).IterateRollupExitRoots(ctx, func(root common.Hash, stored []etherman.RollupExitLeaf) error {
+		divergence := &Divergence{Kind: LeavesDivergence, NetworkID: RollupsTreeNetworkID, DepositCount: report.Roots, Key: root.Bytes()}
+		// A root may be stored again after its leaves are invalidated, with the same leaves
+		var leaves [][KeyLen]byte
+		for _, leaf := range stored {
+			switch {
+			case leaf.RollupId == uint(len(leaves))+1:
+				leaves = append(leaves, leaf.Leaf)
+			case leaf.RollupId == 0 || leaf.RollupId > uint(len(leaves)) || leaves[leaf.RollupId-1] != leaf.Leaf:
+				divergence.Stored = [][]byte{leaf.Leaf.Bytes()}
+				report.Divergence = divergence
+				return errDiverged
+			}
+		}
+		rebuilt, err := mt.buildMTRoot(leaves)
+		if err != nil {
+			return err
+		}
+		report.Leaves += uint(len(leaves))
+		if rebuilt != root {
+			divergence.Kind = RootDivergence
+			divergence.Stored = [][]byte{root.Bytes()}
+			divergence.Rebuilt = [][]byte{rebuilt.Bytes()}
+			report.Divergence = divergence
+			return errDiverged
+		}
+		report.Roots++
+		return nil
+	}, dbTx)
+	if err != nil && err != errDiverged {
+		return nil, err
+	}
+	return report, nil
+}
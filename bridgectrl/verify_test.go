@@ -0,0 +1,108 @@
+package bridgectrl
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/memstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// corruptedStore returns wrong children for a node
+type corruptedStore struct {
+	*memstorage.MemoryStorage
+	key []byte
+}
+
+func (s *corruptedStore) Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error) {
+	if bytes.Equal(key, s.key) {
+		return [][]byte{zeroHashes[0][:], zeroHashes[0][:]}, nil
+	}
+	return s.MemoryStorage.Get(ctx, key, dbTx)
+}
+
+func TestVerifyExitTree(t *testing.T) {
+	const (
+		height   = 32
+		deposits = 10
+	)
+	ctx := context.Background()
+	store := memstorage.NewMemoryStorage()
+	bt, err := NewBridgeController(ctx, Config{Height: height}, []uint{0}, store)
+	require.NoError(t, err)
+	addPrunerDeposits(t, store, bt, 0, deposits)
+
+	report, err := VerifyExitTree(ctx, store, height, 0, true, nil)
+	require.NoError(t, err)
+	require.Nil(t, report.Divergence)
+	require.Equal(t, &TreeReport{NetworkID: 0, Leaves: deposits, Roots: deposits, Nodes: deposits * height}, report)
+	roots, err := VerifyRoots(ctx, store, height, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint(deposits), roots)
+
+	// A wrong node is reported at the first deposit that stores it
+	root, err := store.GetRoot(ctx, 6, 0, nil)
+	require.NoError(t, err)
+	value, err := store.Get(ctx, root, nil)
+	require.NoError(t, err)
+	report, err = VerifyExitTree(ctx, &corruptedStore{MemoryStorage: store, key: value[0]}, height, 0, true, nil)
+	require.NoError(t, err)
+	require.NotNil(t, report.Divergence)
+	require.Equal(t, NodeDivergence, report.Divergence.Kind)
+	require.Equal(t, value[0], report.Divergence.Key)
+
+	// The roots of the last deposits are lost, like when the deposits are stored without their roots
+	require.NoError(t, store.DeleteMerkleTreeFrom(ctx, 0, 7, nil))
+	report, err = VerifyExitTree(ctx, store, height, 0, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, &Divergence{Kind: MissingRootDivergence, NetworkID: 0, DepositCount: 7, Rebuilt: report.Divergence.Rebuilt}, report.Divergence)
+	require.Equal(t, uint(7), report.Roots)
+
+	repaired, err := RepairExitTree(ctx, store, height, 0, report.Divergence.DepositCount, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint(deposits-7), repaired)
+	report, err = VerifyExitTree(ctx, store, height, 0, true, nil)
+	require.NoError(t, err)
+	require.Nil(t, report.Divergence)
+	require.Equal(t, uint(deposits), report.Roots)
+
+	// The repaired tree keeps growing
+	bt, err = NewBridgeController(ctx, Config{Height: height}, []uint{0}, store)
+	require.NoError(t, err)
+	addPrunerDeposits(t, store, bt, deposits, deposits+1)
+	_, err = VerifyRoots(ctx, store, height, 0, nil)
+	require.NoError(t, err)
+}
+
+func TestVerifyRollupExitTree(t *testing.T) {
+	const height = 32
+	ctx := context.Background()
+	store := memstorage.NewMemoryStorage()
+	bt, err := NewBridgeController(ctx, Config{Height: height}, []uint{0}, store)
+	require.NoError(t, err)
+	blockID, err := store.AddBlock(ctx, &etherman.Block{BlockNumber: 1, BlockHash: common.HexToHash("0x1")}, nil)
+	require.NoError(t, err)
+	for i := uint(1); i <= 3; i++ {
+		leaf := etherman.RollupExitLeaf{Leaf: common.BigToHash(big.NewInt(int64(i))), RollupId: i, BlockID: blockID}
+		require.NoError(t, bt.AddRollupExitLeaf(ctx, leaf, nil))
+	}
+	report, err := VerifyRollupExitTree(ctx, store, height, nil)
+	require.NoError(t, err)
+	require.Nil(t, report.Divergence)
+	require.Equal(t, uint(3), report.Roots)
+
+	// Leaves stored with a root they don't build
+	wrongRoot := common.HexToHash("0x2")
+	require.NoError(t, store.AddRollupExitLeaves(ctx, [][]interface{}{{common.HexToHash("0x3").Bytes(), 1, wrongRoot.Bytes(), blockID}}, nil))
+	report, err = VerifyRollupExitTree(ctx, store, height, nil)
+	require.NoError(t, err)
+	require.NotNil(t, report.Divergence)
+	require.Equal(t, RootDivergence, report.Divergence.Kind)
+	require.Equal(t, uint(3), report.Divergence.DepositCount)
+	require.Equal(t, wrongRoot.Bytes(), report.Divergence.Key)
+}
@@ -6,11 +6,16 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/0xPolygonHermez/zkevm-node/state/runtime"
 	"github.com/ethereum/go-ethereum"
@@ -27,74 +32,98 @@ const (
 	mtHeight        = 32
 	cacheSize       = 1000
 	LeafTypeMessage = uint8(1)
+
+	mainnetNetworkID = uint(0)
 )
 
 // ClaimTxManager is the claim transaction manager for L2.
 type ClaimTxManager struct {
-	ctx    context.Context
-	cancel context.CancelFunc
+	ctx     context.Context
+	stopCtx context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
 
 	// client is the ethereum client
-	l2Node          *utils.Client
-	l2NetworkID     uint
-	bridgeService   bridgeServiceInterface
-	cfg             Config
-	chExitRootEvent chan *etherman.GlobalExitRoot
-	chSynced        chan uint
-	storage         storageInterface
-	auth            *bind.TransactOpts
-	rollupID        uint
-	nonceCache      *lru.Cache[string, uint64]
-	synced          bool
+	l2Node         *utils.Client
+	l2NetworkID    uint
+	bridgeService  bridgeServiceInterface
+	cfg            Config
+	exitRootEvents *eventbus.Bus[*etherman.GlobalExitRoot]
+	syncedEvents   *eventbus.Bus[uint]
+	commitEvents   *eventbus.Bus[uint]
+	storage        storageInterface
+	outbox         *webhook.Outbox
+	auth           *bind.TransactOpts
+	nonceCache     *lru.Cache[string, uint64]
+	synced         bool
 }
 
-// NewClaimTxManager creates a new claim transaction manager.
-func NewClaimTxManager(cfg Config, chExitRootEvent chan *etherman.GlobalExitRoot, chSynced chan uint, l2NodeURL string, l2NetworkID uint, l2BridgeAddr common.Address, bridgeService bridgeServiceInterface, storage interface{}, rollupID uint) (*ClaimTxManager, error) {
-	ctx := context.Background()
+// NewClaimTxManager creates a new claim transaction manager. The webhook events are recorded in the outbox, if any.
+// The L2 network ID is published in commitEvents every time the status of the deposits is updated.
+func NewClaimTxManager(ctx context.Context, cfg Config, exitRootEvents *eventbus.Bus[*etherman.GlobalExitRoot], syncedEvents *eventbus.Bus[uint], commitEvents *eventbus.Bus[uint], l2NodeURL string, l2NetworkID uint, l2BridgeAddr common.Address, bridgeService bridgeServiceInterface, storage interface{}, outbox *webhook.Outbox) (*ClaimTxManager, error) {
+	// The manager stops when stopCtx is done, but the work in progress is done with a context
+	// that is not cancelled, so the current monitoring cycle and the claims being sent are finished.
+	// The claims are built from the primary database, because a read replica may not have synced the deposits yet.
+	stopCtx, cancel := context.WithCancel(ctx)
+	ctx = pgstorage.WithConsistentRead(context.WithoutCancel(ctx))
 	client, err := utils.NewClient(ctx, l2NodeURL, l2BridgeAddr)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 	cache, err := lru.New[string, uint64](int(cacheSize))
 	if err != nil {
+		cancel()
 		return nil, err
 	}
-	ctx, cancel := context.WithCancel(ctx)
 	auth, err := client.GetSignerFromKeystore(ctx, cfg.PrivateKey)
 	return &ClaimTxManager{
-		ctx:             ctx,
-		cancel:          cancel,
-		l2Node:          client,
-		l2NetworkID:     l2NetworkID,
-		bridgeService:   bridgeService,
-		cfg:             cfg,
-		chExitRootEvent: chExitRootEvent,
-		chSynced:        chSynced,
-		storage:         storage.(storageInterface),
-		auth:            auth,
-		rollupID:        rollupID,
-		nonceCache:      cache,
+		ctx:            ctx,
+		stopCtx:        stopCtx,
+		cancel:         cancel,
+		l2Node:         client,
+		l2NetworkID:    l2NetworkID,
+		bridgeService:  bridgeService,
+		cfg:            cfg,
+		exitRootEvents: exitRootEvents,
+		syncedEvents:   syncedEvents,
+		commitEvents:   commitEvents,
+		storage:        storage.(storageInterface),
+		outbox:         outbox,
+		auth:           auth,
+		nonceCache:     cache,
 	}, err
 }
 
 // Start will start the tx management, reading txs from storage,
 // send then to the blockchain and keep monitoring them until they
-// get mined
+// get mined. It returns once the manager is stopped and the work
+// in progress is finished.
 func (tm *ClaimTxManager) Start() {
 	ticker := time.NewTicker(tm.cfg.FrequencyToMonitorTxs.Duration)
+	defer ticker.Stop()
+	// The GERs are synced by the L1 synchronizer. The subscriptions replay the latest
+	// event, so nothing is missed if the synchronizers started before the manager.
+	exitRootSub := tm.exitRootEvents.Subscribe(mainnetNetworkID)
+	defer exitRootSub.Unsubscribe()
+	syncedSub := tm.syncedEvents.Subscribe(tm.l2NetworkID)
+	defer syncedSub.Unsubscribe()
 	for {
 		select {
-		case <-tm.ctx.Done():
+		case <-tm.stopCtx.Done():
+			tm.wg.Wait()
 			return
-		case netID := <-tm.chSynced:
+		case netID := <-syncedSub.Events():
 			if netID == tm.l2NetworkID && !tm.synced {
 				log.Info("NetworkID synced: ", netID)
 				tm.synced = true
 			}
-		case ger := <-tm.chExitRootEvent:
+		case ger := <-exitRootSub.Events():
 			if tm.synced {
 				log.Debug("UpdateDepositsStatus for ger: ", ger.GlobalExitRoot)
+				tm.wg.Add(1)
 				go func() {
+					defer tm.wg.Done()
 					err := tm.updateDepositsStatus(ger)
 					if err != nil {
 						log.Errorf("failed to update deposits status: %v", err)
@@ -112,6 +141,11 @@ func (tm *ClaimTxManager) Start() {
 	}
 }
 
+// Stop stops the tx management
+func (tm *ClaimTxManager) Stop() {
+	tm.cancel()
+}
+
 func (tm *ClaimTxManager) updateDepositsStatus(ger *etherman.GlobalExitRoot) error {
 	dbTx, err := tm.storage.BeginDBTransaction(tm.ctx)
 	if err != nil {
@@ -136,25 +170,36 @@ func (tm *ClaimTxManager) updateDepositsStatus(ger *etherman.GlobalExitRoot) err
 		}
 		log.Fatalf("AddClaimTx committing dbTx, err: %s", err.Error())
 	}
+	tm.commitEvents.Publish(tm.l2NetworkID, tm.l2NetworkID)
 	return nil
 }
 
 func (tm *ClaimTxManager) processDepositStatus(ger *etherman.GlobalExitRoot, dbTx pgx.Tx) error {
 	if ger.BlockID != 0 { // L2 exit root is updated
 		log.Infof("Rollup exitroot %v is updated", ger.ExitRoots[1])
-		if err := tm.storage.UpdateL2DepositsStatus(tm.ctx, ger.ExitRoots[1][:], tm.rollupID, tm.l2NetworkID, dbTx); err != nil {
+		// The rollupManager assigns to each rollup a network ID equal to its rollup ID
+		deposits, err := tm.storage.UpdateL2DepositsStatus(tm.ctx, ger.ExitRoots[1][:], tm.l2NetworkID, tm.l2NetworkID, dbTx)
+		if err != nil {
 			log.Errorf("error updating L2DepositsStatus. Error: %v", err)
 			return err
 		}
+		if err = tm.addDepositEvents(deposits, dbTx); err != nil {
+			log.Errorf("error adding the webhook events of the L2 deposits ready for claim. Error: %v", err)
+			return err
+		}
 	} else { // L1 exit root is updated in the trusted state
 		log.Infof("Mainnet exitroot %v is updated", ger.ExitRoots[0])
-		deposits, err := tm.storage.UpdateL1DepositsStatus(tm.ctx, ger.ExitRoots[0][:], dbTx)
+		deposits, err := tm.storage.UpdateL1DepositsStatus(tm.ctx, ger.ExitRoots[0][:], tm.l2NetworkID, dbTx)
 		if err != nil {
 			log.Errorf("error getting and updating L1DepositsStatus. Error: %v", err)
 			return err
 		}
+		if err = tm.addDepositEvents(deposits, dbTx); err != nil {
+			log.Errorf("error adding the webhook events of the L1 deposits ready for claim. Error: %v", err)
+			return err
+		}
 		for _, deposit := range deposits {
-			claimHash, err := tm.bridgeService.GetDepositStatus(tm.ctx, deposit.DepositCount, deposit.DestinationNetwork)
+			claimHash, err := tm.bridgeService.GetDepositStatus(tm.ctx, deposit.DepositCount, deposit.NetworkID, deposit.DestinationNetwork)
 			if err != nil {
 				log.Errorf("error getting deposit status for deposit %d. Error: %v", deposit.DepositCount, err)
 				return err
@@ -182,7 +227,7 @@ func (tm *ClaimTxManager) processDepositStatus(ger *etherman.GlobalExitRoot, dbT
 					ExitRoots: []common.Hash{
 						ger.ExitRoots[0],
 						ger.ExitRoots[1],
-					}}, 1, 1, 1, tm.rollupID,
+					}}, 1, 1, 1, deposit.NetworkID,
 				tm.auth)
 			if err != nil {
 				log.Errorf("error BuildSendClaim tx for deposit %d. Error: %v", deposit.DepositCount, err)
@@ -197,6 +242,34 @@ func (tm *ClaimTxManager) processDepositStatus(ger *etherman.GlobalExitRoot, dbT
 	return nil
 }
 
+// addDepositEvents records the webhook events of the deposits ready for claim
+func (tm *ClaimTxManager) addDepositEvents(deposits []*etherman.Deposit, dbTx pgx.Tx) error {
+	if tm.outbox == nil {
+		return nil
+	}
+	events := make([]*etherman.WebhookEvent, 0, len(deposits))
+	for _, deposit := range deposits {
+		event, err := webhook.NewDepositEvent(webhook.DepositReadyForClaimEvent, deposit)
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+	return tm.outbox.Add(tm.ctx, events, dbTx)
+}
+
+// addClaimTxEvent records a webhook event of the monitored tx
+func (tm *ClaimTxManager) addClaimTxEvent(ctx context.Context, eventType etherman.WebhookEventType, mTx ctmtypes.MonitoredTx, txHash *common.Hash, dbTx pgx.Tx) error {
+	if tm.outbox == nil {
+		return nil
+	}
+	event, err := webhook.NewClaimTxEvent(eventType, tm.l2NetworkID, mTx, txHash)
+	if err != nil {
+		return err
+	}
+	return tm.outbox.Add(ctx, []*etherman.WebhookEvent{event}, dbTx)
+}
+
 func (tm *ClaimTxManager) isDepositMessageAllowed(deposit *etherman.Deposit) bool {
 	for _, addr := range tm.cfg.AuthorizedClaimMessageAddresses {
 		if deposit.OriginalAddress == addr {
@@ -266,8 +339,33 @@ func (tm *ClaimTxManager) addClaimTx(depositCount uint, from common.Address, to
 	return nil
 }
 
+// isPaused checks if the emergency state is active in L1 or in the L2 network. The claims are not sent meanwhile.
+func (tm *ClaimTxManager) isPaused(ctx context.Context) (bool, error) {
+	for _, networkID := range []uint{mainnetNetworkID, tm.l2NetworkID} {
+		emergencyState, err := tm.storage.GetEmergencyState(ctx, networkID, nil)
+		if errors.Is(err, gerror.ErrStorageNotFound) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+		if emergencyState.Activated {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // monitorTxs process all pending monitored tx
 func (tm *ClaimTxManager) monitorTxs(ctx context.Context) error {
+	paused, err := tm.isPaused(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check the emergency state: %v", err)
+	}
+	if paused {
+		log.Warn("emergency state is active, the claim txs are not sent until it is deactivated")
+		return nil
+	}
+
 	dbTx, err := tm.storage.BeginDBTransaction(tm.ctx)
 	if err != nil {
 		return err
@@ -344,6 +442,17 @@ func (tm *ClaimTxManager) monitorTxs(ctx context.Context) error {
 				if err != nil {
 					mTxLog.Errorf("failed to update monitored tx when confirmed: %v", err)
 				}
+				minedTxHash := txHash
+				err = tm.addClaimTxEvent(ctx, webhook.ClaimTxConfirmedEvent, mTx, &minedTxHash, dbTx)
+				if err != nil {
+					mTxLog.Errorf("failed to add the webhook event of the confirmed tx: %v", err)
+					rollbackErr := tm.storage.Rollback(tm.ctx, dbTx)
+					if rollbackErr != nil {
+						log.Errorf("claimtxman error rolling back state. RollbackErr: %s, err: %v", rollbackErr.Error(), err)
+						return rollbackErr
+					}
+					return fmt.Errorf("failed to add the webhook event of the confirmed tx: %v", err)
+				}
 				break
 			}
 
@@ -369,6 +478,16 @@ func (tm *ClaimTxManager) monitorTxs(ctx context.Context) error {
 			if err != nil {
 				mTxLog.Errorf("failed to update monitored tx when max history size limit reached: %v", err)
 			}
+			err = tm.addClaimTxEvent(ctx, webhook.ClaimTxFailedEvent, mTx, nil, dbTx)
+			if err != nil {
+				mTxLog.Errorf("failed to add the webhook event of the failed tx: %v", err)
+				rollbackErr := tm.storage.Rollback(tm.ctx, dbTx)
+				if rollbackErr != nil {
+					log.Errorf("claimtxman error rolling back state. RollbackErr: %s, err: %v", rollbackErr.Error(), err)
+					return rollbackErr
+				}
+				return fmt.Errorf("failed to add the webhook event of the failed tx: %v", err)
+			}
 			continue
 		}
 
@@ -442,6 +561,18 @@ func (tm *ClaimTxManager) monitorTxs(ctx context.Context) error {
 					if err != nil {
 						mTxLog.Errorf("failed to review monitored tx: %v", err)
 					}
+				} else {
+					sentTxHash := signedTx.Hash()
+					err = tm.addClaimTxEvent(ctx, webhook.ClaimTxSentEvent, mTx, &sentTxHash, dbTx)
+					if err != nil {
+						mTxLog.Errorf("failed to add the webhook event of the sent tx: %v", err)
+						rollbackErr := tm.storage.Rollback(tm.ctx, dbTx)
+						if rollbackErr != nil {
+							log.Errorf("claimtxman error rolling back state. RollbackErr: %s, err: %v", rollbackErr.Error(), err)
+							return rollbackErr
+						}
+						return fmt.Errorf("failed to add the webhook event of the sent tx: %v", err)
+					}
 				}
 			} else if err != nil && !errors.Is(err, ethereum.NotFound) {
 				mTxLog.Error("unexpected error getting TransactionByHash. Error: ", err)
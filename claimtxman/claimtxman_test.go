@@ -174,14 +174,18 @@ func TestUpdateDepositStatus(t *testing.T) {
 	l2Root1 := common.FromHex("0xda7bce9f4e8618b6bd2f4132ce798cdc7a60e7e1460a7299e3c6342a579626d2")
 	require.NoError(t, pg.SetRoot(ctx, l2Root1, depositID, deposit.NetworkID, nil))
 
-	deposits, err := pg.UpdateL1DepositsStatus(ctx, l1Root, nil)
+	deposits, err := pg.UpdateL1DepositsStatus(ctx, l1Root, 1, nil)
 	require.NoError(t, err)
 	require.Len(t, deposits, 1)
 	require.True(t, deposits[0].ReadyForClaim)
 	require.Equal(t, uint(1), deposits[0].DepositCount)
 	require.Equal(t, uint(0), deposits[0].NetworkID)
 
-	require.NoError(t, pg.UpdateL2DepositsStatus(ctx, l2Root, 1, 1, nil))
+	deposits, err = pg.UpdateL2DepositsStatus(ctx, l2Root, 1, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	require.Equal(t, uint(1), deposits[0].NetworkID)
+	require.Equal(t, uint64(1), deposits[0].BlockNumber)
 	deposits, err = pg.GetDeposits(ctx, destAdr, 10, 0, nil)
 	require.NoError(t, err)
 	require.Len(t, deposits, 2)
@@ -258,7 +262,8 @@ func TestUpdateL2DepositStatusMultipleRollups(t *testing.T) {
 	require.NoError(t, err)
 
 	// This root is for network 1, this won't upgrade anything
-	require.NoError(t, pg.UpdateL2DepositsStatus(ctx, l2Root1, 1, 2, nil))
+	_, err = pg.UpdateL2DepositsStatus(ctx, l2Root1, 1, 2, nil)
+	require.NoError(t, err)
 	deposits, err := pg.GetDeposits(ctx, destAdr, 10, 0, nil)
 	require.NoError(t, err)
 	require.Len(t, deposits, 2)
@@ -266,21 +271,24 @@ func TestUpdateL2DepositStatusMultipleRollups(t *testing.T) {
 	require.False(t, deposits[0].ReadyForClaim)
 
 	// This root is for network 2, this won't upgrade anything
-	require.NoError(t, pg.UpdateL2DepositsStatus(ctx, l2Root2, 1, 1, nil))
+	_, err = pg.UpdateL2DepositsStatus(ctx, l2Root2, 1, 1, nil)
+	require.NoError(t, err)
 	deposits, err = pg.GetDeposits(ctx, destAdr, 10, 0, nil)
 	require.NoError(t, err)
 	require.Len(t, deposits, 2)
 	require.False(t, deposits[1].ReadyForClaim)
 	require.False(t, deposits[0].ReadyForClaim)
 
-	require.NoError(t, pg.UpdateL2DepositsStatus(ctx, l2Root1, 1, 1, nil))
+	_, err = pg.UpdateL2DepositsStatus(ctx, l2Root1, 1, 1, nil)
+	require.NoError(t, err)
 	deposits, err = pg.GetDeposits(ctx, destAdr, 10, 0, nil)
 	require.NoError(t, err)
 	require.Len(t, deposits, 2)
 	require.True(t, deposits[1].ReadyForClaim)
 	require.False(t, deposits[0].ReadyForClaim)
 
-	require.NoError(t, pg.UpdateL2DepositsStatus(ctx, l2Root2, 1, 2, nil))
+	_, err = pg.UpdateL2DepositsStatus(ctx, l2Root2, 1, 2, nil)
+	require.NoError(t, err)
 	deposits, err = pg.GetDeposits(ctx, destAdr, 10, 0, nil)
 	require.NoError(t, err)
 	require.Len(t, deposits, 2)
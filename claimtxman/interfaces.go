@@ -11,11 +11,12 @@ import (
 
 type storageInterface interface {
 	AddBlock(ctx context.Context, block *etherman.Block, dbTx pgx.Tx) (uint64, error)
-	UpdateL1DepositsStatus(ctx context.Context, exitRoot []byte, dbTx pgx.Tx) ([]*etherman.Deposit, error)
-	UpdateL2DepositsStatus(ctx context.Context, exitRoot []byte, rollupID, networkID uint, dbTx pgx.Tx) error
+	UpdateL1DepositsStatus(ctx context.Context, exitRoot []byte, destNetworkID uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	UpdateL2DepositsStatus(ctx context.Context, exitRoot []byte, rollupID, networkID uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
 	AddClaimTx(ctx context.Context, mTx types.MonitoredTx, dbTx pgx.Tx) error
 	UpdateClaimTx(ctx context.Context, mTx types.MonitoredTx, dbTx pgx.Tx) error
 	GetClaimTxsByStatus(ctx context.Context, statuses []types.MonitoredTxStatus, dbTx pgx.Tx) ([]types.MonitoredTx, error)
+	GetEmergencyState(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.EmergencyState, error)
 	// atomic
 	Rollback(ctx context.Context, dbTx pgx.Tx) error
 	BeginDBTransaction(ctx context.Context) (pgx.Tx, error)
@@ -24,5 +25,5 @@ type storageInterface interface {
 
 type bridgeServiceInterface interface {
 	GetClaimProof(depositCnt, networkID uint, dbTx pgx.Tx) (*etherman.GlobalExitRoot, [][bridgectrl.KeyLen]byte, [][bridgectrl.KeyLen]byte, error)
-	GetDepositStatus(ctx context.Context, depositCount uint, destNetworkID uint) (string, error)
+	GetDepositStatus(ctx context.Context, depositCount uint, origNetworkID uint, destNetworkID uint) (string, error)
 }
@@ -51,6 +51,59 @@ func main() {
 			Action:  start,
 			Flags:   flags,
 		},
+		{
+			Name:    "merkletree",
+			Aliases: []string{},
+			Usage:   "Verify and repair the merkle trees",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "verify",
+					Usage:  "Rebuild the merkle trees from the synced deposits and rollup exit leaves and compare them with the stored ones",
+					Action: merkleTreeVerify,
+					Flags: append(flags,
+						&cli.UintSliceFlag{
+							Name:     flagNetworkID,
+							Usage:    "Network `ID` of the exit tree to verify. By default it verifies the exit trees of all the synced networks and the rollup exit tree",
+							Required: false,
+						},
+						&cli.BoolFlag{
+							Name:     flagRepair,
+							Usage:    "Repair the exit trees in place since their first divergence. The bridge must be stopped",
+							Required: false,
+						},
+					),
+				},
+			},
+		},
+		{
+			Name:    "snapshot",
+			Aliases: []string{},
+			Usage:   "Export and import snapshots of the synced data to bootstrap new instances",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "export",
+					Usage:  "Export a snapshot of the sync database",
+					Action: snapshotExport,
+					Flags: append(flags, &cli.StringFlag{
+						Name:     flagFile,
+						Aliases:  []string{"f"},
+						Usage:    "Snapshot `FILE` to write",
+						Required: true,
+					}),
+				},
+				{
+					Name:   "import",
+					Usage:  "Import a snapshot into an empty sync database",
+					Action: snapshotImport,
+					Flags: append(flags, &cli.StringFlag{
+						Name:     flagFile,
+						Aliases:  []string{"f"},
+						Usage:    "Snapshot `FILE` to read",
+						Required: true,
+					}),
+				},
+			},
+		},
 	}
 
 	err := app.Run(os.Args)
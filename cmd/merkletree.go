@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/kvstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/jackc/pgx/v4"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	flagNetworkID = "network-id"
+	flagRepair    = "repair"
+)
+
+// errMerkleTreeDivergence is returned when a merkle tree doesn't match the synced data and it's not repaired
+var errMerkleTreeDivergence = errors.New("the merkle trees diverge from the synced data")
+
+// merkleTreeStorage is the storage of the merkle trees: postgres, with the nodes in the embedded store if it's configured
+type merkleTreeStorage interface {
+	BeginDBTransaction(ctx context.Context) (pgx.Tx, error)
+	Commit(ctx context.Context, dbTx pgx.Tx) error
+	Rollback(ctx context.Context, dbTx pgx.Tx) error
+	GetBlockRanges(ctx context.Context, dbTx pgx.Tx) ([]etherman.BlockRange, error)
+}
+
+func merkleTreeVerify(ctx *cli.Context) error {
+	c, err := config.Load(ctx.String(flagCfg), ctx.String(flagNetwork))
+	if err != nil {
+		return err
+	}
+	setupLog(c.Log)
+	pg, err := newPostgresStorage(c)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer pg.Close()
+	var storage merkleTreeStorage = pg
+	if c.BridgeController.Store == "leveldb" {
+		nodeStore, err := kvstorage.NewNodeStore(c.BridgeController.StorePath)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		defer nodeStore.Close()
+		storage = kvstorage.NewStorage(pg, nodeStore)
+	}
+
+	networkIDs := ctx.UintSlice(flagNetworkID)
+	if len(networkIDs) == 0 {
+		ranges, err := storage.GetBlockRanges(ctx.Context, nil)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		for _, r := range ranges {
+			networkIDs = append(networkIDs, r.NetworkID)
+		}
+	}
+	height := c.BridgeController.Height
+	diverged := false
+	for _, networkID := range networkIDs {
+		report, err := bridgectrl.VerifyExitTree(ctx.Context, storage, height, networkID, true, nil)
+		if err != nil {
+			log.Errorf("networkID: %d, error verifying the exit tree. Error: %v", networkID, err)
+			return err
+		}
+		logTreeReport(report)
+		if report.Divergence == nil {
+			continue
+		}
+		if !ctx.Bool(flagRepair) {
+			diverged = true
+			continue
+		}
+		if err := repairExitTree(ctx.Context, storage, height, report.Divergence); err != nil {
+			log.Errorf("networkID: %d, error repairing the exit tree. Error: %v", networkID, err)
+			return err
+		}
+	}
+	if !ctx.IsSet(flagNetworkID) {
+		report, err := bridgectrl.VerifyRollupExitTree(ctx.Context, storage, height, nil)
+		if err != nil {
+			log.Error("error verifying the rollup exit tree. Error: ", err)
+			return err
+		}
+		logTreeReport(report)
+		diverged = diverged || report.Divergence != nil
+	}
+	if diverged {
+		return errMerkleTreeDivergence
+	}
+	return nil
+}
+
+// repairExitTree rebuilds the exit tree since the divergence and verifies it again in the same db transaction
+func repairExitTree(ctx context.Context, storage merkleTreeStorage, height uint8, divergence *bridgectrl.Divergence) error {
+	if divergence.Kind == bridgectrl.MissingDepositDivergence {
+		return errors.New("the exit tree can't be repaired without the missing deposits, they must be synced again")
+	}
+	dbTx, err := storage.BeginDBTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	repaired, err := bridgectrl.RepairExitTree(ctx, storage, height, divergence.NetworkID, divergence.DepositCount, dbTx)
+	if err == nil {
+		var report *bridgectrl.TreeReport
+		report, err = bridgectrl.VerifyExitTree(ctx, storage, height, divergence.NetworkID, true, dbTx)
+		if err == nil && report.Divergence != nil {
+			logTreeReport(report)
+			err = errMerkleTreeDivergence
+		}
+	}
+	if err != nil {
+		if errRollback := storage.Rollback(ctx, dbTx); errRollback != nil {
+			log.Errorf("networkID: %d, error rolling back the repair. Error: %v", divergence.NetworkID, errRollback)
+		}
+		return err
+	}
+	if err := storage.Commit(ctx, dbTx); err != nil {
+		return err
+	}
+	log.Infof("networkID: %d, repaired the exit tree since the deposit %d, deposits: %d", divergence.NetworkID, divergence.DepositCount, repaired)
+	return nil
+}
+
+func logTreeReport(report *bridgectrl.TreeReport) {
+	if report.Divergence != nil {
+		log.Errorf("networkID: %d, the merkle tree diverges after %d leaves and %d roots: %s", report.NetworkID, report.Leaves, report.Roots, report.Divergence)
+		return
+	}
+	log.Infof("networkID: %d, the merkle tree matches the synced data, leaves: %d, roots: %d, nodes: %d", report.NetworkID, report.Leaves, report.Roots, report.Nodes)
+}
@@ -2,22 +2,49 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
 	"os"
-	"os/signal"
+	"slices"
+	"syscall"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	merkleTreeMetrics "github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/metrics"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/config"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/kvstorage"
+	dbMetrics "github.com/0xPolygonHermez/zkevm-bridge-service/db/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/monitor"
+	monitorMetrics "github.com/0xPolygonHermez/zkevm-bridge-service/monitor/metrics"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/server/cache"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/synchronizer"
+	syncMetrics "github.com/0xPolygonHermez/zkevm-bridge-service/synchronizer/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/lifecycle"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
 	"github.com/0xPolygonHermez/zkevm-node/jsonrpc/client"
 	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/metrics"
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/urfave/cli/v2"
 )
 
+const (
+	// shutdownTimeout is the time given to the subsystems to finish their work in progress
+	shutdownTimeout = 30 * time.Second
+	// eventBufferSize is the number of pending events kept for each subscriber of the event buses
+	eventBufferSize = 10
+)
+
 func start(ctx *cli.Context) error {
 	configFilePath := ctx.String(flagCfg)
 	network := ctx.String(flagNetwork)
@@ -27,116 +54,258 @@ func start(ctx *cli.Context) error {
 		return err
 	}
 	setupLog(c.Log)
-	err = db.RunMigrations(c.SyncDB)
-	if err != nil {
+	lc := lifecycle.New(ctx.Context, shutdownTimeout, os.Interrupt, syscall.SIGTERM)
+	// fail stops the subsystems already started and closes the resources already opened before returning the error
+	fail := func(err error) error {
 		log.Error(err)
+		lc.Stop()
+		_ = lc.Wait()
 		return err
 	}
+	if c.Metrics.Enabled {
+		metrics.Init()
+		syncMetrics.Register()
+		dbMetrics.Register()
+		merkleTreeMetrics.Register()
+		monitorMetrics.Register()
+		lc.Go("metrics server", func(ctx context.Context) error {
+			return startMetricsHttpServer(ctx, c.Metrics)
+		})
+	}
+	err = db.RunMigrations(c.SyncDB)
+	if err != nil {
+		return fail(err)
+	}
 
 	l1Etherman, l2Ethermans, err := newEthermans(c)
 	if err != nil {
-		log.Error(err)
-		return err
+		return fail(err)
 	}
 
 	networkID, err := l1Etherman.GetNetworkID(ctx.Context)
 	log.Infof("main network id: %d", networkID)
 	if err != nil {
-		log.Error(err)
-		return err
+		return fail(err)
 	}
 
-	var networkIDs = []uint{networkID}
-	for _, client := range l2Ethermans {
+	chainID, err := l1Etherman.GetChainID(ctx.Context)
+	if err != nil {
+		return fail(err)
+	}
+	var (
+		networkIDs = []uint{networkID}
+		networks   = []server.Network{{NetworkID: networkID, ChainID: chainID, BridgeAddress: c.NetworkConfig.PolygonBridgeAddress}}
+	)
+	for i, client := range l2Ethermans {
 		networkID, err := client.GetNetworkID(ctx.Context)
 		if err != nil {
-			log.Error(err)
-			return err
+			return fail(err)
 		}
 		log.Infof("l2 network id: %d", networkID)
+		chainID, err := client.GetChainID(ctx.Context)
+		if err != nil {
+			return fail(err)
+		}
 		networkIDs = append(networkIDs, networkID)
+		// The rollupManager assigns to each rollup the network id equal to its rollup id
+		networks = append(networks, server.Network{NetworkID: networkID, ChainID: chainID, BridgeAddress: c.NetworkConfig.L2PolygonBridgeAddresses[i], RollupID: networkID})
 	}
 
 	storage, err := db.NewStorage(c.SyncDB)
 	if err != nil {
-		log.Error(err)
-		return err
+		return fail(err)
 	}
+	lc.OnShutdown("sync storage", func() { db.CloseStorage(storage) })
 
-	var bridgeController *bridgectrl.BridgeController
-
-	if c.BridgeController.Store == "postgres" {
-		bridgeController, err = bridgectrl.NewBridgeController(ctx.Context, c.BridgeController, networkIDs, storage)
-		if err != nil {
-			log.Error(err)
-			return err
-		}
-	} else {
-		log.Error(gerror.ErrStorageNotRegister)
-		return gerror.ErrStorageNotRegister
+	var outbox *webhook.Outbox
+	if c.Webhook.Enabled {
+		outbox = webhook.NewOutbox(storage)
+		dispatcher := webhook.NewDispatcher(c.Webhook, storage)
+		lc.Go("webhook dispatcher", dispatcher.Start)
 	}
 
 	apiStorage, err := db.NewStorage(c.BridgeServer.DB)
 	if err != nil {
-		log.Error(err)
-		return err
+		return fail(err)
 	}
-	rollupID := l1Etherman.GetRollupID()
-	bridgeService := server.NewBridgeService(c.BridgeServer, c.BridgeController.Height, networkIDs, apiStorage, rollupID)
-	err = server.RunServer(c.BridgeServer, bridgeService)
+	lc.OnShutdown("api storage", func() { db.CloseStorage(apiStorage) })
+
+	switch c.BridgeController.Store {
+	case "postgres":
+	case "leveldb":
+		// The nodes of the merkle trees are stored in the embedded store, shared by the synchronizers and the API
+		syncPG, ok := storage.(*pgstorage.PostgresStorage)
+		apiPG, apiOk := apiStorage.(*pgstorage.PostgresStorage)
+		if !ok || !apiOk {
+			err := errors.New("the leveldb store of the merkle tree nodes requires the postgres database")
+			return fail(err)
+		}
+		nodeStore, err := kvstorage.NewNodeStore(c.BridgeController.StorePath)
+		if err != nil {
+			return fail(err)
+		}
+		lc.OnShutdown("merkle tree node store", func() { _ = nodeStore.Close() })
+		err = nodeStore.Import(ctx.Context, storage)
+		if err != nil {
+			return fail(err)
+		}
+		storage = kvstorage.NewStorage(syncPG, nodeStore)
+		apiStorage = kvstorage.NewStorage(apiPG, nodeStore)
+	default:
+		return fail(gerror.ErrStorageNotRegister)
+	}
+	bridgeController, err := bridgectrl.NewBridgeController(ctx.Context, c.BridgeController, networkIDs, storage)
 	if err != nil {
-		log.Error(err)
-		return err
+		return fail(err)
+	}
+
+	if c.BridgeController.Pruning.Enabled {
+		pruner := bridgectrl.NewPruner(c.BridgeController.Pruning, bridgeController, storage)
+		lc.Go("merkle tree pruner", pruner.Start)
+	}
+
+	syncedEvents := eventbus.New[uint](eventBufferSize)
+	statusEvents := eventbus.New[*synchronizer.Status](eventBufferSize)
+	solvencyReports := eventbus.New[*monitor.SolvencyReport](eventBufferSize)
+	claimReports := eventbus.New[*monitor.ClaimCheckReport](eventBufferSize)
+	commitEvents := eventbus.New[uint](eventBufferSize)
+	var responseCache *cache.Cache
+	if c.BridgeServer.ResponseCache.Enabled {
+		responseCache, err = cache.New(c.BridgeServer.ResponseCache, commitEvents)
+		if err != nil {
+			return fail(err)
+		}
+		lc.Go("response cache", responseCache.Start)
 	}
+	bridgeService := server.NewBridgeService(c.BridgeServer, c.BridgeController.Height, networks, apiStorage, statusEvents, solvencyReports, claimReports, responseCache)
+	lc.Go("bridge server", func(ctx context.Context) error {
+		return server.RunServer(ctx, c.BridgeServer, bridgeService)
+	})
 
 	log.Debug("trusted sequencer URL ", c.Etherman.L2URLs[0])
 	zkEVMClient := client.NewClient(c.Etherman.L2URLs[0])
-	chExitRootEvent := make(chan *etherman.GlobalExitRoot)
-	chSynced := make(chan uint)
-	go runSynchronizer(ctx.Context, c.NetworkConfig.GenBlockNumber, bridgeController, l1Etherman, c.Synchronizer, storage, zkEVMClient, chExitRootEvent, chSynced)
-	for _, client := range l2Ethermans {
-		go runSynchronizer(ctx.Context, 0, bridgeController, client, c.Synchronizer, storage, zkEVMClient, chExitRootEvent, chSynced)
+	exitRootEvents := eventbus.New[*etherman.GlobalExitRoot](eventBufferSize)
+	lc.Go("L1 synchronizer", func(ctx context.Context) error {
+		return runSynchronizer(ctx, c.NetworkConfig.GenBlockNumber, bridgeController, l1Etherman, c.Synchronizer, storage, zkEVMClient, exitRootEvents, syncedEvents, statusEvents, commitEvents, outbox)
+	})
+	for i, client := range l2Ethermans {
+		client := client
+		lc.Go(fmt.Sprintf("L2 synchronizer %d", i), func(ctx context.Context) error {
+			return runSynchronizer(ctx, 0, bridgeController, client, c.Synchronizer, storage, zkEVMClient, exitRootEvents, syncedEvents, statusEvents, commitEvents, outbox)
+		})
+	}
+
+	var solvencyChecker *monitor.SolvencyChecker
+	if c.Monitor.SolvencyEnabled {
+		solvencyChecker = monitor.NewSolvencyChecker(c.Monitor, storage, solvencyReports)
+		solvencyChecker.AddNetwork(monitor.Network{NetworkID: networkID, BridgeAddress: c.NetworkConfig.PolygonBridgeAddress, Client: l1Etherman})
+		for i, client := range l2Ethermans {
+			solvencyChecker.AddNetwork(monitor.Network{NetworkID: networkIDs[i+1], BridgeAddress: c.NetworkConfig.L2PolygonBridgeAddresses[i], Client: client})
+		}
+		lc.Go("solvency checker", solvencyChecker.Start)
+	}
+
+	if c.Monitor.ClaimsEnabled {
+		var hooks []monitor.AlertHook
+		if outbox != nil {
+			hooks = append(hooks, webhookAlertHook(outbox))
+		}
+		claimChecker := monitor.NewClaimChecker(c.Monitor, storage, claimReports, hooks...)
+		lc.Go("claim checker", claimChecker.Start)
 	}
 
+	lc.Go("rollup watcher", func(ctx context.Context) error {
+		return watchRollups(ctx, c, networkIDs, storage, func(rollup etherman.RollupConfig, client *etherman.Client) error {
+			// The network is registered before syncing it, so its deposits can be added to the merkle tree
+			chainID, err := client.GetChainID(ctx)
+			if err != nil {
+				return err
+			}
+			err = bridgeController.AddNetwork(ctx, rollup.RollupID)
+			if err != nil {
+				return err
+			}
+			bridgeService.AddNetwork(server.Network{NetworkID: rollup.RollupID, ChainID: chainID, BridgeAddress: rollup.BridgeAddress, RollupID: rollup.RollupID})
+			lc.Go(fmt.Sprintf("L2 synchronizer %d", rollup.RollupID), func(ctx context.Context) error {
+				return runSynchronizer(ctx, 0, bridgeController, client, c.Synchronizer, storage, zkEVMClient, exitRootEvents, syncedEvents, statusEvents, commitEvents, outbox)
+			})
+			if solvencyChecker != nil {
+				solvencyChecker.AddNetwork(monitor.Network{NetworkID: rollup.RollupID, BridgeAddress: rollup.BridgeAddress, Client: client})
+			}
+			if !c.ClaimTxManager.Enabled {
+				return nil
+			}
+			claimTxManager, err := claimtxman.NewClaimTxManager(lc.Context(), c.ClaimTxManager, exitRootEvents, syncedEvents, commitEvents, rollup.URL, rollup.RollupID, rollup.BridgeAddress, bridgeService, storage, outbox)
+			if err != nil {
+				return err
+			}
+			bridgeService.EnableAutoClaim(rollup.RollupID, c.ClaimTxManager.AuthorizedClaimMessageAddresses)
+			lc.Go(fmt.Sprintf("claim tx manager %d", rollup.RollupID), func(ctx context.Context) error {
+				claimTxManager.Start()
+				return nil
+			})
+			return nil
+		})
+	})
+
 	if c.ClaimTxManager.Enabled {
 		for i := 0; i < len(c.Etherman.L2URLs); i++ {
 			// we should match the orders of L2URLs between etherman and claimtxman
 			// since we are using the networkIDs in the same order
-			claimTxManager, err := claimtxman.NewClaimTxManager(c.ClaimTxManager, chExitRootEvent, chSynced, c.Etherman.L2URLs[i], networkIDs[i+1], c.NetworkConfig.L2PolygonBridgeAddresses[i], bridgeService, storage, rollupID)
+			claimTxManager, err := claimtxman.NewClaimTxManager(lc.Context(), c.ClaimTxManager, exitRootEvents, syncedEvents, commitEvents, c.Etherman.L2URLs[i], networkIDs[i+1], c.NetworkConfig.L2PolygonBridgeAddresses[i], bridgeService, storage, outbox)
 			if err != nil {
-				log.Fatalf("error creating claim tx manager for L2 %s. Error: %v", c.Etherman.L2URLs[i], err)
+				return fail(fmt.Errorf("error creating claim tx manager for L2 %s. Error: %w", c.Etherman.L2URLs[i], err))
 			}
-			go claimTxManager.Start()
+			bridgeService.EnableAutoClaim(networkIDs[i+1], c.ClaimTxManager.AuthorizedClaimMessageAddresses)
+			lc.Go(fmt.Sprintf("claim tx manager %d", networkIDs[i+1]), func(ctx context.Context) error {
+				claimTxManager.Start()
+				return nil
+			})
 		}
 	} else {
 		log.Warn("ClaimTxManager not configured")
-		go func() {
-			for {
-				select {
-				case <-chExitRootEvent:
-					log.Debug("New GER received")
-				case netID := <-chSynced:
-					log.Debug("NetworkID synced: ", netID)
-				case <-ctx.Context.Done():
-					log.Debug("Stopping goroutine that listen new GER updates")
-					return
-				}
-			}
-		}()
 	}
 
-	// Wait for an in interrupt.
-	ch := make(chan os.Signal, 1)
-	signal.Notify(ch, os.Interrupt)
-	<-ch
-
-	return nil
+	// Wait for SIGINT or SIGTERM and stop all the subsystems gracefully
+	return lc.Wait()
 }
 
 func setupLog(c log.Config) {
 	log.Init(c)
 }
 
+func startMetricsHttpServer(ctx context.Context, c metrics.Config) error {
+	const ten = 10
+	mux := http.NewServeMux()
+	address := fmt.Sprintf("%s:%d", c.Host, c.Port)
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.Errorf("failed to create tcp listener for metrics: %v", err)
+		return err
+	}
+	mux.Handle(metrics.Endpoint, promhttp.Handler())
+
+	metricsServer := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: ten * time.Second,
+		ReadTimeout:       ten * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		_ = metricsServer.Close()
+	}()
+	log.Infof("metrics server listening on port %d", c.Port)
+	if err := metricsServer.Serve(lis); err != nil {
+		if err == http.ErrServerClosed {
+			log.Warnf("http server for metrics stopped")
+			return nil
+		}
+		log.Errorf("closed http connection for metrics server: %v", err)
+		return err
+	}
+	return nil
+}
+
 func newEthermans(c *config.Config) (*etherman.Client, []*etherman.Client, error) {
 	l1Etherman, err := etherman.NewClient(c.Etherman, c.NetworkConfig.PolygonBridgeAddress, c.NetworkConfig.PolygonZkEVMGlobalExitRootAddress, c.NetworkConfig.PolygonRollupManagerAddress, c.NetworkConfig.PolygonZkEvmAddress)
 	if err != nil {
@@ -158,12 +327,84 @@ func newEthermans(c *config.Config) (*etherman.Client, []*etherman.Client, error
 	return l1Etherman, l2Ethermans, nil
 }
 
-func runSynchronizer(ctx context.Context, genBlockNumber uint64, brdigeCtrl *bridgectrl.BridgeController, etherman *etherman.Client, cfg synchronizer.Config, storage db.Storage, zkEVMClient *client.Client, chExitRootEvent chan *etherman.GlobalExitRoot, chSynced chan uint) {
-	sy, err := synchronizer.NewSynchronizer(ctx, storage, brdigeCtrl, etherman, zkEVMClient, genBlockNumber, chExitRootEvent, chSynced, cfg)
-	if err != nil {
-		log.Fatal(err)
+type rollupStorage interface {
+	GetRollups(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Rollup, error)
+}
+
+// watchRollups waits until the rollups configured in Etherman.L2Rollups are registered in the rollupManager
+// and then starts syncing them. The rollups that are already synced are skipped.
+func watchRollups(ctx context.Context, c *config.Config, networkIDs []uint, storage db.Storage, start func(rollup etherman.RollupConfig, client *etherman.Client) error) error {
+	rollupStore, ok := storage.(rollupStorage)
+	if !ok {
+		return errors.New("the storage doesn't keep the rollups registered in the rollupManager")
 	}
-	if err := sy.Sync(); err != nil {
-		log.Fatal(err)
+	pending := make(map[uint]etherman.RollupConfig)
+	for _, rollup := range c.Etherman.L2Rollups {
+		if !slices.Contains(networkIDs, rollup.RollupID) {
+			pending[rollup.RollupID] = rollup
+		}
+	}
+	ticker := time.NewTicker(c.Synchronizer.SyncInterval.Duration)
+	defer ticker.Stop()
+	for len(pending) > 0 {
+		rollups, err := rollupStore.GetRollups(ctx, nil)
+		if err != nil {
+			log.Errorf("error getting the rollups registered in the rollupManager. Error: %v", err)
+		}
+		for _, registered := range rollups {
+			rollup, found := pending[registered.RollupID]
+			if !found {
+				continue
+			}
+			client, err := etherman.NewL2Client(rollup.URL, rollup.BridgeAddress)
+			if err != nil {
+				log.Errorf("error creating the L2 etherman of the rollup %d. Error: %v", rollup.RollupID, err)
+				continue
+			}
+			// The rollupManager assigns to each rollup the network id equal to its rollup id
+			networkID, err := client.GetNetworkID(ctx)
+			if err != nil {
+				log.Errorf("error getting the network id of the rollup %d. Error: %v", rollup.RollupID, err)
+				continue
+			}
+			if networkID != rollup.RollupID {
+				return fmt.Errorf("the bridge %s of the rollup %d has the network id %d", rollup.BridgeAddress, rollup.RollupID, networkID)
+			}
+			log.Infof("rollup %d registered in the rollupManager. Starting to sync it from %s", rollup.RollupID, rollup.URL)
+			err = start(rollup, client)
+			if err != nil {
+				return err
+			}
+			delete(pending, rollup.RollupID)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// webhookAlertHook notifies the claim findings through the webhook outbox
+func webhookAlertHook(outbox *webhook.Outbox) monitor.AlertHook {
+	return func(ctx context.Context, finding monitor.ClaimFinding) {
+		event, err := webhook.NewClaimFindingEvent(finding)
+		if err != nil {
+			log.Errorf("error creating the webhook event of the claim finding. Error: %v", err)
+			return
+		}
+		err = outbox.Add(ctx, []*etherman.WebhookEvent{event}, nil)
+		if err != nil {
+			log.Errorf("error adding the webhook event of the claim finding. Error: %v", err)
+		}
+	}
+}
+
+func runSynchronizer(ctx context.Context, genBlockNumber uint64, brdigeCtrl *bridgectrl.BridgeController, etherman *etherman.Client, cfg synchronizer.Config, storage db.Storage, zkEVMClient *client.Client, exitRootEvents *eventbus.Bus[*etherman.GlobalExitRoot], syncedEvents *eventbus.Bus[uint], statusEvents *eventbus.Bus[*synchronizer.Status], commitEvents *eventbus.Bus[uint], outbox *webhook.Outbox) error {
+	sy, err := synchronizer.NewSynchronizer(ctx, storage, brdigeCtrl, etherman, zkEVMClient, genBlockNumber, exitRootEvents, syncedEvents, statusEvents, commitEvents, outbox, cfg)
+	if err != nil {
+		return err
 	}
+	return sy.Sync()
 }
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/snapshot"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/urfave/cli/v2"
+)
+
+const flagFile = "file"
+
+// errPostgresStorage is returned when the sync database is not postgres
+var errPostgresStorage = errors.New("the command requires the postgres database")
+
+// errLevelDBSnapshot is returned when the nodes of the merkle trees are kept in leveldb. The snapshots
+// are taken from the postgres tables, whose nodes are stale in that case.
+var errLevelDBSnapshot = errors.New("the snapshots are not supported with the leveldb store of the merkle tree nodes")
+
+// newPostgresStorage opens the sync database, which must be postgres
+func newPostgresStorage(c *config.Config) (*pgstorage.PostgresStorage, error) {
+	storage, err := db.NewStorage(c.SyncDB)
+	if err != nil {
+		return nil, err
+	}
+	pg, ok := storage.(*pgstorage.PostgresStorage)
+	if !ok {
+		db.CloseStorage(storage)
+		return nil, errPostgresStorage
+	}
+	return pg, nil
+}
+
+func snapshotExport(ctx *cli.Context) error {
+	c, err := config.Load(ctx.String(flagCfg), ctx.String(flagNetwork))
+	if err != nil {
+		return err
+	}
+	setupLog(c.Log)
+	if c.BridgeController.Store == "leveldb" {
+		log.Error(errLevelDBSnapshot)
+		return errLevelDBSnapshot
+	}
+	storage, err := newPostgresStorage(c)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer storage.Close()
+
+	f, err := os.Create(ctx.String(flagFile))
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	manifest, err := snapshot.Export(ctx.Context, storage, f)
+	if errClose := f.Close(); err == nil {
+		err = errClose
+	}
+	if err != nil {
+		log.Error("error exporting the snapshot. Error: ", err)
+		_ = os.Remove(f.Name())
+		return err
+	}
+	for _, network := range manifest.Networks {
+		log.Infof("networkID: %d, exported the blocks %d to %d, last block hash: %s", network.NetworkID, network.FirstBlockNumber, network.LastBlockNumber, network.LastBlockHash)
+	}
+	log.Infof("snapshot %s exported, migration: %s", f.Name(), manifest.Migration)
+	return nil
+}
+
+func snapshotImport(ctx *cli.Context) error {
+	c, err := config.Load(ctx.String(flagCfg), ctx.String(flagNetwork))
+	if err != nil {
+		return err
+	}
+	setupLog(c.Log)
+	if c.BridgeController.Store == "leveldb" {
+		log.Error(errLevelDBSnapshot)
+		return errLevelDBSnapshot
+	}
+	err = db.RunMigrations(c.SyncDB)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	storage, err := newPostgresStorage(c)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer storage.Close()
+
+	f, err := os.Open(ctx.String(flagFile))
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer f.Close()
+	manifest, err := snapshot.Import(ctx.Context, storage, c.BridgeController.Height, f)
+	if err != nil {
+		log.Error("error importing the snapshot. Error: ", err)
+		return err
+	}
+	for _, network := range manifest.Networks {
+		log.Infof("networkID: %d, the synchronizer resumes after the block %d %s", network.NetworkID, network.LastBlockNumber, network.LastBlockHash)
+	}
+	log.Infof("snapshot %s imported, created at: %s", f.Name(), manifest.CreatedAt)
+	return nil
+}
@@ -10,9 +10,12 @@ import (
 	"github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/monitor"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/synchronizer"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
 	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/metrics"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
@@ -26,6 +29,9 @@ type Config struct {
 	Synchronizer     synchronizer.Config
 	BridgeController bridgectrl.Config
 	BridgeServer     server.Config
+	Metrics          metrics.Config
+	Monitor          monitor.Config
+	Webhook          webhook.Config
 	NetworkConfig
 }
 
@@ -72,6 +78,9 @@ func Load(configFilePath string, network string) (*Config, error) {
 		return nil, err
 	}
 
+	if len(cfg.SyncDB.ReadReplicas) > 0 {
+		return nil, errors.New("Read replicas are configured for the SyncDB. The synchronizers must read their own writes, so the read replicas are only supported in the [BridgeServer.DB] section.")
+	}
 	if viper.IsSet("NetworkConfig") && network != "" {
 		return nil, errors.New("Network details are provided in the config file (the [NetworkConfig] section) and as a flag (the --network or -n). Configure it only once and try again please.")
 	}
@@ -14,6 +14,15 @@ Name = "test_db"
 Host = "zkevm-bridge-db"
 Port = "5432"
 MaxConns = 20
+URL = ""
+SSLMode = ""
+SSLRootCert = ""
+SSLCert = ""
+SSLKey = ""
+ApplicationName = "zkevm-bridge-service"
+ConnectTimeout = "10s"
+StatementTimeout = "0s"
+QueryTimeout = "0s"
 
 [ClaimTxManager]
 Enabled = false
@@ -26,6 +35,7 @@ AuthorizedClaimMessageAddresses = []
 [Etherman]
 L1URL = "http://localhost:8545"
 L2URLs = [""]
+L2Rollups = []
 
 [Synchronizer]
 SyncInterval = "2s"
@@ -33,7 +43,14 @@ SyncChunkSize = 100
 
 [BridgeController]
 Store = "postgres"
+StorePath = "./merkletree"
 Height = 32
+    [BridgeController.Pruning]
+    Enabled = false
+    KeepGERs = 100
+    KeepAge = "0s"
+    Interval = "1m"
+    BatchSize = 1000
 
 [BridgeServer]
 GRPCPort = "9090"
@@ -50,4 +67,48 @@ BridgeVersion = "v1"
     Host = "zkevm-bridge-db"
     Port = "5432"
     MaxConns = 20
+    URL = ""
+    SSLMode = ""
+    SSLRootCert = ""
+    SSLCert = ""
+    SSLKey = ""
+    ApplicationName = "zkevm-bridge-service"
+    ConnectTimeout = "10s"
+    StatementTimeout = "0s"
+    QueryTimeout = "30s"
+    ReadReplicas = []
+    MaxReplicaLag = "5s"
+    ReplicaCheckInterval = "1s"
+    [BridgeServer.ResponseCache]
+    Enabled = false
+    Backend = "memory"
+    Size = 10000
+    TTL = "1m"
+        [BridgeServer.ResponseCache.Redis]
+        Addr = "localhost:6379"
+        Username = ""
+        Password = ""
+        DB = 0
+        KeyPrefix = "bridge:"
+
+[Metrics]
+Host = "0.0.0.0"
+Port = 9091
+Enabled = false
+
+[Monitor]
+SolvencyEnabled = false
+SolvencyInterval = "5m"
+ClaimsEnabled = false
+ClaimsInterval = "1m"
+
+[Webhook]
+Enabled = false
+URL = ""
+Secret = ""
+PollInterval = "1s"
+BatchSize = 100
+RequestTimeout = "10s"
+InitialBackoff = "5s"
+MaxBackoff = "1h"
 `
@@ -0,0 +1,149 @@
+package kvstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/jackc/pgx/v4"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+const (
+	// nodeLen is the length of the hashes of the children of a node
+	nodeLen = 32
+	// importBatchSize is the number of nodes written on each batch when importing the nodes from Postgres
+	importBatchSize = 10000
+)
+
+// importedKey marks that the nodes stored in Postgres are already imported. The keys of the nodes
+// are hashes, so they never collide with it.
+var importedKey = []byte("imported")
+
+// NodeStore stores the nodes of the merkle trees in an embedded LevelDB database. The nodes are
+// content addressed, so the nodes written by a db transaction that is rolled back are harmless.
+type NodeStore struct {
+	db *leveldb.DB
+}
+
+// NewNodeStore opens the node store in the given directory, creating it if needed.
+func NewNodeStore(path string) (*NodeStore, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeStore{db: db}, nil
+}
+
+// Close closes the node store
+func (s *NodeStore) Close() error {
+	return s.db.Close()
+}
+
+// Get gets the children of a node
+func (s *NodeStore) Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error) {
+	data, err := s.db.Get(key, nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, gerror.ErrStorageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	value := make([][]byte, 0, len(data)/nodeLen)
+	for i := 0; i+nodeLen <= len(data); i += nodeLen {
+		value = append(value, data[i:i+nodeLen])
+	}
+	return value, nil
+}
+
+// BulkSet stores multiple nodes. Each row contains the key, the children and the deposit id,
+// like the rows of mt.rht. The write is synced, so the nodes are durable before the db transaction
+// that stores the root is committed.
+func (s *NodeStore) BulkSet(ctx context.Context, rows [][]interface{}, dbTx pgx.Tx) error {
+	batch := new(leveldb.Batch)
+	for _, row := range rows {
+		key, ok := row[0].([]byte)
+		if !ok {
+			return fmt.Errorf("invalid node key type %T", row[0])
+		}
+		value, ok := row[1].([][]byte)
+		if !ok {
+			return fmt.Errorf("invalid node value type %T", row[1])
+		}
+		batch.Put(key, encodeNode(value))
+	}
+	return s.db.Write(batch, &opt.WriteOptions{Sync: true})
+}
+
+func encodeNode(value [][]byte) []byte {
+	data := make([]byte, 0, len(value)*nodeLen)
+	for _, child := range value {
+		data = append(data, child...)
+	}
+	return data
+}
+
+type nodeSource interface {
+	IterateNodes(ctx context.Context, fn func(key []byte, value [][]byte) error, dbTx pgx.Tx) error
+}
+
+// Import copies the nodes stored in Postgres the first time the node store is used, so an existing
+// deployment can switch the store of the merkle trees without resyncing.
+func (s *NodeStore) Import(ctx context.Context, source interface{}) error {
+	imported, err := s.db.Has(importedKey, nil)
+	if err != nil || imported {
+		return err
+	}
+	log.Info("importing the merkle tree nodes stored in postgres")
+	var (
+		batch = new(leveldb.Batch)
+		count int
+	)
+	err = source.(nodeSource).IterateNodes(ctx, func(key []byte, value [][]byte) error {
+		batch.Put(key, encodeNode(value))
+		count++
+		if batch.Len() < importBatchSize {
+			return nil
+		}
+		err := s.db.Write(batch, nil)
+		batch.Reset()
+		return err
+	}, nil)
+	if err != nil {
+		return err
+	}
+	batch.Put(importedKey, []byte{1})
+	err = s.db.Write(batch, &opt.WriteOptions{Sync: true})
+	if err != nil {
+		return err
+	}
+	log.Infof("%d merkle tree nodes imported", count)
+	return nil
+}
+
+// Storage is the Postgres storage with the nodes of the merkle trees stored in the node store
+type Storage struct {
+	*pgstorage.PostgresStorage
+	nodes *NodeStore
+}
+
+// NewStorage creates a new storage that stores the relational data in Postgres and the merkle tree nodes in the node store
+func NewStorage(pg *pgstorage.PostgresStorage, nodes *NodeStore) *Storage {
+	return &Storage{
+		PostgresStorage: pg,
+		nodes:           nodes,
+	}
+}
+
+// Get gets the children of a node from the node store
+func (s *Storage) Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error) {
+	return s.nodes.Get(ctx, key, dbTx)
+}
+
+// BulkSet stores multiple nodes in the node store
+func (s *Storage) BulkSet(ctx context.Context, rows [][]interface{}, dbTx pgx.Tx) error {
+	return s.nodes.BulkSet(ctx, rows, dbTx)
+}
@@ -0,0 +1,50 @@
+package kvstorage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type nodeSourceMock map[common.Hash][][]byte
+
+func (m nodeSourceMock) IterateNodes(ctx context.Context, fn func(key []byte, value [][]byte) error, dbTx pgx.Tx) error {
+	for key, value := range m {
+		if err := fn(key.Bytes(), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestNodeStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewNodeStore(t.TempDir())
+	require.NoError(t, err)
+	defer store.Close()
+
+	key := common.HexToHash("0x1")
+	left, right := common.HexToHash("0x2"), common.HexToHash("0x3")
+	_, err = store.Get(ctx, key.Bytes(), nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	require.NoError(t, store.BulkSet(ctx, [][]interface{}{{key.Bytes(), [][]byte{left.Bytes(), right.Bytes()}, uint64(1)}}, nil))
+	value, err := store.Get(ctx, key.Bytes(), nil)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{left.Bytes(), right.Bytes()}, value)
+
+	// The nodes are imported only once
+	source := nodeSourceMock{common.HexToHash("0x4"): {left.Bytes(), right.Bytes()}}
+	require.NoError(t, store.Import(ctx, source))
+	value, err = store.Get(ctx, common.HexToHash("0x4").Bytes(), nil)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{left.Bytes(), right.Bytes()}, value)
+	source[common.HexToHash("0x5")] = [][]byte{left.Bytes(), right.Bytes()}
+	require.NoError(t, store.Import(ctx, source))
+	_, err = store.Get(ctx, common.HexToHash("0x5").Bytes(), nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+}
@@ -0,0 +1,2268 @@
+package memstorage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+var (
+	// errDuplicateKey is returned when a row violates a unique constraint of the Postgres schema
+	errDuplicateKey = errors.New("duplicate key value violates unique constraint")
+	// errInvalidTx is returned when the db transaction doesn't belong to the storage
+	errInvalidTx = errors.New("invalid in-memory db transaction")
+)
+
+type rootRow struct {
+	root      []byte
+	depositID uint64
+	network   uint
+}
+
+// depositKey is the key of the deposits, that are unique by their deposit count in the network
+type depositKey struct {
+	networkID    uint
+	depositCount uint
+}
+
+func depositKeyOf(d *etherman.Deposit) any {
+	return depositKey{networkID: d.NetworkID, depositCount: d.DepositCount}
+}
+
+// claimPrimaryKey is the primary key of sync.claim, the destination network and the global index of the claim
+type claimPrimaryKey struct {
+	networkID   uint
+	index       uint
+	mainnetFlag bool
+	rollupIndex uint64
+}
+
+func claimKeyOf(c *etherman.Claim) any {
+	return claimPrimaryKey{networkID: c.NetworkID, index: c.Index, mainnetFlag: c.MainnetFlag, rollupIndex: c.RollupIndex}
+}
+
+type rollupExitRow struct {
+	leaf          common.Hash
+	rollupID      uint
+	root          common.Hash
+	blockID       uint64
+	invalidatedBy *uint64
+}
+
+// MemoryStorage implements the storage interfaces of the synchronizer, the bridge service, the claim tx manager,
+// the merkle trees, the monitor and the webhook outbox in memory. It mirrors the Postgres schema: the rows
+// of a block are removed together with it and the primary keys and unique constraints are checked, as well as the
+// deposit count of the deposits of a network, that the merkle tree requires to be unique. The db transactions are
+// serialized, each one holds the transaction lock until it's committed or rolled back. The nodes of the merkle trees are
+// content addressed, so they are kept when the deposits are removed. The bridge volume statistics are computed from
+// the deposits and claims, so AddDepositStats and AddClaimStats don't store anything.
+type MemoryStorage struct {
+	// txLock is held by the open db transaction, the reads and writes without a db transaction wait for it
+	txLock              sync.RWMutex
+	lock                sync.RWMutex
+	blocks              *table[etherman.Block]
+	blockIDs            map[common.Hash]uint64
+	exitRoots           *table[etherman.GlobalExitRoot]
+	deposits            *table[etherman.Deposit]
+	claims              *table[etherman.Claim]
+	tokensWrapped       *table[etherman.TokenWrapped]
+	roots               *table[rootRow]
+	rollupExitLeaves    *table[rollupExitRow]
+	pendingStateChanges *table[etherman.PendingStateChange]
+	emergencyStates     *table[etherman.EmergencyState]
+	rollups             *table[etherman.Rollup]
+	rollupUpdates       *table[etherman.RollupUpdate]
+	obsoleteRollupTypes *table[etherman.ObsoleteRollupType]
+	reorgs              *table[etherman.Reorg]
+	claimTxs            *table[ctmtypes.MonitoredTx]
+	webhookEvents       *table[etherman.WebhookEvent]
+	prunedDepositCounts map[uint]uint
+
+	nodesLock sync.RWMutex
+	nodes     map[string][][]byte
+}
+
+// NewMemoryStorage creates a new empty in-memory storage
+func NewMemoryStorage() *MemoryStorage {
+	s := &MemoryStorage{
+		blocks:              newTable[etherman.Block](),
+		blockIDs:            make(map[common.Hash]uint64),
+		exitRoots:           newTable[etherman.GlobalExitRoot](),
+		deposits:            newKeyedTable(depositKeyOf),
+		claims:              newKeyedTable(claimKeyOf),
+		tokensWrapped:       newTable[etherman.TokenWrapped](),
+		roots:               newTable[rootRow](),
+		rollupExitLeaves:    newTable[rollupExitRow](),
+		pendingStateChanges: newTable[etherman.PendingStateChange](),
+		emergencyStates:     newTable[etherman.EmergencyState](),
+		rollups:             newTable[etherman.Rollup](),
+		rollupUpdates:       newTable[etherman.RollupUpdate](),
+		obsoleteRollupTypes: newTable[etherman.ObsoleteRollupType](),
+		reorgs:              newTable[etherman.Reorg](),
+		claimTxs:            newTable[ctmtypes.MonitoredTx](),
+		webhookEvents:       newTable[etherman.WebhookEvent](),
+		prunedDepositCounts: make(map[uint]uint),
+		nodes:               make(map[string][][]byte),
+	}
+	// The block with id 0 doesn't belong to any network. It's the block of the trusted exit roots.
+	genesis := &etherman.Block{ReceivedAt: time.Unix(0, 0).UTC()}
+	s.blocks.records = append(s.blocks.records, record[etherman.Block]{id: 0, row: genesis})
+	s.blocks.byID[0] = genesis
+	return s
+}
+
+func (s *MemoryStorage) getTx(dbTx pgx.Tx) (*memTx, error) {
+	tx, ok := dbTx.(*memTx)
+	if !ok || tx.storage != s {
+		return nil, errInvalidTx
+	}
+	if tx.closed {
+		return nil, pgx.ErrTxClosed
+	}
+	return tx, nil
+}
+
+// read runs fn holding the read lock. Without a db transaction, it waits for the open one to finish.
+func (s *MemoryStorage) read(dbTx pgx.Tx, fn func() error) error {
+	if dbTx == nil {
+		s.txLock.RLock()
+		defer s.txLock.RUnlock()
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if dbTx != nil {
+		if _, err := s.getTx(dbTx); err != nil {
+			return err
+		}
+	}
+	return fn()
+}
+
+// write runs fn holding the write lock. Without a db transaction, it waits for the open one to finish
+// and the changes are undone if fn fails.
+func (s *MemoryStorage) write(dbTx pgx.Tx, fn func(tx *memTx) error) error {
+	if dbTx == nil {
+		s.txLock.Lock()
+		defer s.txLock.Unlock()
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if dbTx == nil {
+		tx := &memTx{storage: s}
+		err := fn(tx)
+		if err != nil {
+			tx.rollback()
+		}
+		return err
+	}
+	tx, err := s.getTx(dbTx)
+	if err != nil {
+		return err
+	}
+	return fn(tx)
+}
+
+// networkBlock gets the block of a row of the network. The block with id 0 doesn't belong to any network.
+func (s *MemoryStorage) networkBlock(blockID uint64, networkID uint) (*etherman.Block, bool) {
+	block, ok := s.blocks.get(blockID)
+	if !ok || blockID == 0 || block.NetworkID != networkID {
+		return nil, false
+	}
+	return block, true
+}
+
+// blockNumber gets the number of a block, 0 if it doesn't exist
+func (s *MemoryStorage) blockNumber(blockID uint64) uint64 {
+	if block, ok := s.blocks.get(blockID); ok {
+		return block.BlockNumber
+	}
+	return 0
+}
+
+// sourceNetwork gets the network of the deposit of a claim, that is 0 for mainnet and rollup_index + 1 for the rollups.
+// The claims of the old bridge don't have a global index, so the ones in a rollup come from mainnet.
+func sourceNetwork(claim *etherman.Claim) uint {
+	if claim.MainnetFlag || uint(claim.RollupIndex)+1 == claim.NetworkID {
+		return 0
+	}
+	return uint(claim.RollupIndex) + 1
+}
+
+// findDeposit gets the deposit with the deposit count in the network
+func (s *MemoryStorage) findDeposit(networkID uint, depositCount uint) (*etherman.Deposit, bool) {
+	return s.deposits.find(depositKey{networkID: networkID, depositCount: depositCount})
+}
+
+// rootDepositCount gets the deposit count of the deposit that produced the root of the network
+func (s *MemoryStorage) rootDepositCount(root []byte, network uint) (uint, bool) {
+	var (
+		depositCount uint
+		found        bool
+	)
+	s.roots.each(func(_ uint64, r *rootRow) bool {
+		if r.network != network || !bytes.Equal(r.root, root) {
+			return true
+		}
+		var deposit *etherman.Deposit
+		deposit, found = s.deposits.get(r.depositID)
+		if found {
+			depositCount = deposit.DepositCount
+		}
+		return false
+	})
+	return depositCount, found
+}
+
+func copyAmount(amount *big.Int) *big.Int {
+	if amount == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Set(amount)
+}
+
+// Rollback rollbacks a db transaction.
+func (s *MemoryStorage) Rollback(ctx context.Context, dbTx pgx.Tx) error {
+	if dbTx != nil {
+		return dbTx.Rollback(ctx)
+	}
+	return gerror.ErrNilDBTransaction
+}
+
+// Commit commits a db transaction.
+func (s *MemoryStorage) Commit(ctx context.Context, dbTx pgx.Tx) error {
+	if dbTx != nil {
+		return dbTx.Commit(ctx)
+	}
+	return gerror.ErrNilDBTransaction
+}
+
+// BeginDBTransaction starts a transaction block. It waits for the open one to finish.
+func (s *MemoryStorage) BeginDBTransaction(ctx context.Context) (pgx.Tx, error) {
+	s.txLock.Lock()
+	return &memTx{storage: s}, nil
+}
+
+// GetLastBlock gets the last block.
+func (s *MemoryStorage) GetLastBlock(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.Block, error) {
+	blocks, err := s.getBlocksDesc(networkID, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return &blocks[0], nil
+}
+
+// GetLastBlocks gets the latest block synced of every network.
+func (s *MemoryStorage) GetLastBlocks(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Block, error) {
+	latest := make(map[uint]*etherman.Block)
+	err := s.read(dbTx, func() error {
+		s.blocks.each(func(id uint64, b *etherman.Block) bool {
+			if id != 0 && (latest[b.NetworkID] == nil || b.BlockNumber > latest[b.NetworkID].BlockNumber) {
+				block := *b
+				latest[b.NetworkID] = &block
+			}
+			return true
+		})
+		return nil
+	})
+	blocks := make([]*etherman.Block, 0)
+	for _, block := range latest {
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i].NetworkID < blocks[j].NetworkID })
+	return blocks, err
+}
+
+// GetPreviousBlock gets the offset previous L1 block respect to latest.
+func (s *MemoryStorage) GetPreviousBlock(ctx context.Context, networkID uint, offset uint64, dbTx pgx.Tx) (*etherman.Block, error) {
+	blocks, err := s.getBlocksDesc(networkID, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	if offset >= uint64(len(blocks)) {
+		return nil, gerror.ErrStorageNotFound
+	}
+	block := blocks[offset]
+	block.ID = 0
+	return &block, nil
+}
+
+func (s *MemoryStorage) getBlocksDesc(networkID uint, dbTx pgx.Tx) ([]etherman.Block, error) {
+	var blocks []etherman.Block
+	err := s.read(dbTx, func() error {
+		s.blocks.each(func(id uint64, b *etherman.Block) bool {
+			if _, ok := s.networkBlock(id, networkID); ok {
+				blocks = append(blocks, *b)
+			}
+			return true
+		})
+		return nil
+	})
+	sort.SliceStable(blocks, func(i, j int) bool { return blocks[i].BlockNumber > blocks[j].BlockNumber })
+	return blocks, err
+}
+
+// AddBlock adds a new block to the storage. If there is a block with the same hash, its id is returned.
+func (s *MemoryStorage) AddBlock(ctx context.Context, block *etherman.Block, dbTx pgx.Tx) (uint64, error) {
+	var blockID uint64
+	err := s.write(dbTx, func(tx *memTx) error {
+		if id, ok := s.blockIDs[block.BlockHash]; ok {
+			blockID = id
+			return nil
+		}
+		blockID = s.blocks.insert(tx, &etherman.Block{
+			BlockNumber: block.BlockNumber,
+			BlockHash:   block.BlockHash,
+			ParentHash:  block.ParentHash,
+			NetworkID:   block.NetworkID,
+			ReceivedAt:  block.ReceivedAt,
+		})
+		s.blocks.byID[blockID].ID = blockID
+		s.setBlockID(tx, block.BlockHash, blockID)
+		return nil
+	})
+	return blockID, err
+}
+
+func (s *MemoryStorage) setBlockID(tx *memTx, hash common.Hash, blockID uint64) {
+	s.blockIDs[hash] = blockID
+	tx.onRollback(func() { delete(s.blockIDs, hash) })
+}
+
+func (s *MemoryStorage) deleteBlockID(tx *memTx, hash common.Hash) {
+	blockID := s.blockIDs[hash]
+	delete(s.blockIDs, hash)
+	tx.onRollback(func() { s.blockIDs[hash] = blockID })
+}
+
+// UpdateBlocksForTesting updates the hash of blocks.
+func (s *MemoryStorage) UpdateBlocksForTesting(ctx context.Context, networkID uint, blockNum uint64, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		s.blocks.each(func(id uint64, b *etherman.Block) bool {
+			if _, ok := s.networkBlock(id, networkID); ok && b.BlockNumber >= blockNum {
+				s.deleteBlockID(tx, b.BlockHash)
+				update(tx, b, func(b *etherman.Block) { b.BlockHash[len(b.BlockHash)-1] = 'a' })
+				s.setBlockID(tx, b.BlockHash, id)
+			}
+			return true
+		})
+		return nil
+	})
+}
+
+// Reset resets the state to a block for the given DB tx. The rows of the removed blocks are removed too.
+func (s *MemoryStorage) Reset(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		blockIDs := s.blocks.delete(tx, func(id uint64, b *etherman.Block) bool {
+			_, ok := s.networkBlock(id, networkID)
+			if ok && b.BlockNumber > blockNumber {
+				s.deleteBlockID(tx, b.BlockHash)
+				return true
+			}
+			return false
+		})
+		inBlocks := func(blockID uint64) bool {
+			_, ok := blockIDs[blockID]
+			return ok
+		}
+		s.exitRoots.delete(tx, func(_ uint64, ger *etherman.GlobalExitRoot) bool { return inBlocks(ger.BlockID) })
+		depositIDs := s.deposits.delete(tx, func(_ uint64, d *etherman.Deposit) bool { return inBlocks(d.BlockID) })
+		s.roots.delete(tx, func(_ uint64, r *rootRow) bool {
+			_, ok := depositIDs[r.depositID]
+			return ok
+		})
+		s.claims.delete(tx, func(_ uint64, c *etherman.Claim) bool { return inBlocks(c.BlockID) })
+		s.tokensWrapped.delete(tx, func(_ uint64, t *etherman.TokenWrapped) bool { return inBlocks(t.BlockID) })
+		s.rollupExitLeaves.delete(tx, func(_ uint64, r *rollupExitRow) bool { return inBlocks(r.blockID) })
+		pendingStateIDs := s.pendingStateChanges.delete(tx, func(_ uint64, p *etherman.PendingStateChange) bool { return inBlocks(p.BlockID) })
+		s.rollupExitLeaves.each(func(_ uint64, r *rollupExitRow) bool {
+			if r.invalidatedBy != nil {
+				if _, ok := pendingStateIDs[*r.invalidatedBy]; ok {
+					update(tx, r, func(r *rollupExitRow) { r.invalidatedBy = nil })
+				}
+			}
+			return true
+		})
+		s.emergencyStates.delete(tx, func(_ uint64, e *etherman.EmergencyState) bool { return inBlocks(e.BlockID) })
+		s.rollups.delete(tx, func(_ uint64, r *etherman.Rollup) bool { return inBlocks(r.BlockID) })
+		s.rollupUpdates.delete(tx, func(_ uint64, u *etherman.RollupUpdate) bool { return inBlocks(u.BlockID) })
+		s.obsoleteRollupTypes.delete(tx, func(_ uint64, o *etherman.ObsoleteRollupType) bool { return inBlocks(o.BlockID) })
+		return nil
+	})
+}
+
+// AddGlobalExitRoot adds a new ExitRoot to the db.
+func (s *MemoryStorage) AddGlobalExitRoot(ctx context.Context, exitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		_, inserted := s.addExitRoot(tx, exitRoot.BlockID, exitRoot)
+		if !inserted {
+			return errDuplicateKey
+		}
+		return nil
+	})
+}
+
+// AddTrustedGlobalExitRoot adds new global exit root which comes from the trusted sequencer.
+func (s *MemoryStorage) AddTrustedGlobalExitRoot(ctx context.Context, trustedExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) (bool, error) {
+	var inserted bool
+	err := s.write(dbTx, func(tx *memTx) error {
+		_, inserted = s.addExitRoot(tx, 0, trustedExitRoot)
+		return nil
+	})
+	return inserted, err
+}
+
+func (s *MemoryStorage) addExitRoot(tx *memTx, blockID uint64, exitRoot *etherman.GlobalExitRoot) (uint64, bool) {
+	_, _, exists := s.exitRoots.last(func(_ uint64, ger *etherman.GlobalExitRoot) bool {
+		return ger.BlockID == blockID && ger.GlobalExitRoot == exitRoot.GlobalExitRoot
+	})
+	if exists {
+		return 0, false
+	}
+	return s.exitRoots.insert(tx, &etherman.GlobalExitRoot{
+		BlockID:        blockID,
+		GlobalExitRoot: exitRoot.GlobalExitRoot,
+		ExitRoots:      []common.Hash{exitRoot.ExitRoots[0], exitRoot.ExitRoots[1]},
+	}), true
+}
+
+// GetLatestExitRoot gets the latest global exit root.
+func (s *MemoryStorage) GetLatestExitRoot(ctx context.Context, isRollup bool, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	if !isRollup {
+		return s.GetLatestTrustedExitRoot(ctx, dbTx)
+	}
+
+	return s.GetLatestL1SyncedExitRoot(ctx, dbTx)
+}
+
+// GetLatestL1SyncedExitRoot gets the latest L1 synced global exit root.
+func (s *MemoryStorage) GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	var (
+		ger   etherman.GlobalExitRoot
+		found bool
+	)
+	err := s.read(dbTx, func() error {
+		var row *etherman.GlobalExitRoot
+		_, row, found = s.exitRoots.last(func(_ uint64, ger *etherman.GlobalExitRoot) bool { return ger.BlockID > 0 })
+		if found {
+			ger = etherman.GlobalExitRoot{BlockID: row.BlockID, GlobalExitRoot: row.GlobalExitRoot, ExitRoots: append([]common.Hash(nil), row.ExitRoots...)}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return &ger, gerror.ErrStorageNotFound
+	}
+	return &ger, nil
+}
+
+// GetLatestTrustedExitRoot gets the latest trusted global exit root.
+func (s *MemoryStorage) GetLatestTrustedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	var ger *etherman.GlobalExitRoot
+	err := s.read(dbTx, func() error {
+		_, row, found := s.exitRoots.last(func(_ uint64, ger *etherman.GlobalExitRoot) bool { return ger.BlockID == 0 })
+		if !found {
+			return gerror.ErrStorageNotFound
+		}
+		ger = &etherman.GlobalExitRoot{GlobalExitRoot: row.GlobalExitRoot, ExitRoots: append([]common.Hash(nil), row.ExitRoots...)}
+		return nil
+	})
+	return ger, err
+}
+
+// AddDeposit adds new deposit to the storage.
+func (s *MemoryStorage) AddDeposit(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) (uint64, error) {
+	var depositID uint64
+	err := s.write(dbTx, func(tx *memTx) error {
+		var err error
+		depositID, err = s.deposits.insertUnique(tx, &etherman.Deposit{
+			LeafType:           deposit.LeafType,
+			OriginalNetwork:    deposit.OriginalNetwork,
+			OriginalAddress:    deposit.OriginalAddress,
+			Amount:             copyAmount(deposit.Amount),
+			DestinationNetwork: deposit.DestinationNetwork,
+			DestinationAddress: deposit.DestinationAddress,
+			DepositCount:       deposit.DepositCount,
+			BlockID:            deposit.BlockID,
+			NetworkID:          deposit.NetworkID,
+			TxHash:             deposit.TxHash,
+			Metadata:           deposit.Metadata,
+		})
+		return err
+	})
+	return depositID, err
+}
+
+// AddClaim adds new claim to the storage.
+func (s *MemoryStorage) AddClaim(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		_, err := s.claims.insertUnique(tx, &etherman.Claim{
+			MainnetFlag:        claim.MainnetFlag,
+			RollupIndex:        claim.RollupIndex,
+			Index:              claim.Index,
+			OriginalNetwork:    claim.OriginalNetwork,
+			OriginalAddress:    claim.OriginalAddress,
+			Amount:             copyAmount(claim.Amount),
+			DestinationAddress: claim.DestinationAddress,
+			BlockID:            claim.BlockID,
+			NetworkID:          claim.NetworkID,
+			TxHash:             claim.TxHash,
+		})
+		return err
+	})
+}
+
+// AddDepositStats does nothing, the bridge volume statistics are computed from the deposits.
+func (s *MemoryStorage) AddDepositStats(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) error {
+	return s.read(dbTx, func() error { return nil })
+}
+
+// AddClaimStats does nothing, the bridge volume statistics are computed from the claims.
+func (s *MemoryStorage) AddClaimStats(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) error {
+	return s.read(dbTx, func() error { return nil })
+}
+
+// GetTokenMetadata gets the metadata of the dedicated token. It returns pgx.ErrNoRows if there is no metadata, like Postgres.
+func (s *MemoryStorage) GetTokenMetadata(ctx context.Context, networkID, destNet uint, originalTokenAddr common.Address, dbTx pgx.Tx) ([]byte, error) {
+	var metadata []byte
+	err := s.read(dbTx, func() error {
+		var found bool
+		metadata, found = s.tokenMetadata(networkID, destNet, originalTokenAddr)
+		if !found {
+			return pgx.ErrNoRows
+		}
+		return nil
+	})
+	return metadata, err
+}
+
+func (s *MemoryStorage) tokenMetadata(networkID, destNet uint, originalTokenAddr common.Address) ([]byte, bool) {
+	var metadata []byte
+	s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+		if d.NetworkID == networkID && d.OriginalAddress == originalTokenAddr && d.DestinationNetwork == destNet && d.Metadata != nil {
+			metadata = d.Metadata
+			return false
+		}
+		return true
+	})
+	return metadata, metadata != nil
+}
+
+// AddTokenWrapped adds new wrapped token to the storage.
+func (s *MemoryStorage) AddTokenWrapped(ctx context.Context, tokenWrapped *etherman.TokenWrapped, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		tokenMetadata := &etherman.TokenMetadata{}
+		// The metadata is missing if the related deposit in the opposite network is not synced in fast sync mode.
+		// ref: https://github.com/0xPolygonHermez/zkevm-bridge-service/issues/230
+		if metadata, found := s.tokenMetadata(tokenWrapped.OriginalNetwork, tokenWrapped.NetworkID, tokenWrapped.OriginalTokenAddress); found {
+			var err error
+			tokenMetadata, err = etherman.DecodeTokenMetadata(metadata)
+			if err != nil {
+				return err
+			}
+		}
+		s.tokensWrapped.insert(tx, &etherman.TokenWrapped{
+			TokenMetadata:        *tokenMetadata,
+			OriginalNetwork:      tokenWrapped.OriginalNetwork,
+			OriginalTokenAddress: tokenWrapped.OriginalTokenAddress,
+			WrappedTokenAddress:  tokenWrapped.WrappedTokenAddress,
+			BlockID:              tokenWrapped.BlockID,
+			NetworkID:            tokenWrapped.NetworkID,
+		})
+		return nil
+	})
+}
+
+// GetTokenWrapped gets a specific wrapped token. The metadata is filled if it was missing when the token was stored.
+func (s *MemoryStorage) GetTokenWrapped(ctx context.Context, originalNetwork uint, originalTokenAddress common.Address, dbTx pgx.Tx) (*etherman.TokenWrapped, error) {
+	var token etherman.TokenWrapped
+	err := s.write(dbTx, func(tx *memTx) error {
+		var row *etherman.TokenWrapped
+		s.tokensWrapped.each(func(_ uint64, t *etherman.TokenWrapped) bool {
+			if t.OriginalNetwork == originalNetwork && t.OriginalTokenAddress == originalTokenAddress {
+				row = t
+				return false
+			}
+			return true
+		})
+		if row == nil {
+			return gerror.ErrStorageNotFound
+		}
+		if row.Symbol == "" {
+			if metadata, found := s.tokenMetadata(row.OriginalNetwork, row.NetworkID, row.OriginalTokenAddress); found {
+				tokenMetadata, err := etherman.DecodeTokenMetadata(metadata)
+				if err != nil {
+					return err
+				}
+				s.tokensWrapped.each(func(_ uint64, t *etherman.TokenWrapped) bool {
+					if t.OriginalNetwork == originalNetwork && t.OriginalTokenAddress == originalTokenAddress {
+						update(tx, t, func(t *etherman.TokenWrapped) { t.TokenMetadata = *tokenMetadata })
+					}
+					return true
+				})
+			}
+		}
+		token = *row
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// GetTokensWrapped gets the wrapped tokens. If networkID is nil, the wrapped tokens of all the networks are returned.
+func (s *MemoryStorage) GetTokensWrapped(ctx context.Context, networkID *uint, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.TokenWrapped, error) {
+	tokens := make([]*etherman.TokenWrapped, 0)
+	err := s.read(dbTx, func() error {
+		s.tokensWrapped.each(func(_ uint64, t *etherman.TokenWrapped) bool {
+			if networkID == nil || t.NetworkID == *networkID {
+				token := *t
+				tokens = append(tokens, &token)
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(tokens, func(i, j int) bool {
+		a, b := tokens[i], tokens[j]
+		if a.BlockID != b.BlockID {
+			return a.BlockID > b.BlockID
+		}
+		if a.NetworkID != b.NetworkID {
+			return a.NetworkID < b.NetworkID
+		}
+		if a.OriginalNetwork != b.OriginalNetwork {
+			return a.OriginalNetwork < b.OriginalNetwork
+		}
+		return bytes.Compare(a.OriginalTokenAddress.Bytes(), b.OriginalTokenAddress.Bytes()) < 0
+	})
+	return paginate(tokens, limit, offset), nil
+}
+
+// GetTokenWrappedCount gets the number of wrapped tokens. If networkID is nil, the wrapped tokens of all the networks are counted.
+func (s *MemoryStorage) GetTokenWrappedCount(ctx context.Context, networkID *uint, dbTx pgx.Tx) (uint64, error) {
+	var count uint64
+	err := s.read(dbTx, func() error {
+		s.tokensWrapped.each(func(_ uint64, t *etherman.TokenWrapped) bool {
+			if networkID == nil || t.NetworkID == *networkID {
+				count++
+			}
+			return true
+		})
+		return nil
+	})
+	return count, err
+}
+
+// GetTokenWrappedByAddress gets the wrapped token from its address in the network where it was created.
+func (s *MemoryStorage) GetTokenWrappedByAddress(ctx context.Context, wrappedTokenAddress common.Address, networkID uint, dbTx pgx.Tx) (*etherman.TokenWrapped, error) {
+	var token *etherman.TokenWrapped
+	err := s.read(dbTx, func() error {
+		s.tokensWrapped.each(func(_ uint64, t *etherman.TokenWrapped) bool {
+			if t.WrappedTokenAddress == wrappedTokenAddress && t.NetworkID == networkID {
+				row := *t
+				token = &row
+				return false
+			}
+			return true
+		})
+		if token == nil {
+			return gerror.ErrStorageNotFound
+		}
+		return nil
+	})
+	return token, err
+}
+
+// GetNativeTokenMetadata gets the metadata of a token of the network from the metadata of its deposits.
+func (s *MemoryStorage) GetNativeTokenMetadata(ctx context.Context, tokenAddress common.Address, networkID uint, dbTx pgx.Tx) (*etherman.TokenMetadata, error) {
+	var metadata []byte
+	err := s.read(dbTx, func() error {
+		s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+			if d.NetworkID == networkID && d.OriginalNetwork == networkID && d.OriginalAddress == tokenAddress && len(d.Metadata) > 0 {
+				metadata = d.Metadata
+				return false
+			}
+			return true
+		})
+		if metadata == nil {
+			return gerror.ErrStorageNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return etherman.DecodeTokenMetadata(metadata)
+}
+
+// AddReorg stores a reorg in the journal together with the deposits, claims and global exit roots
+// that are going to be removed when the state is reset to the fork block. It must be called
+// before Reset and using the same DB tx.
+func (s *MemoryStorage) AddReorg(ctx context.Context, reorg *etherman.Reorg, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		reorg.Deposits, reorg.Claims, reorg.GlobalExitRoots = nil, nil, nil
+		removed := func(blockID uint64) (uint64, bool) {
+			block, ok := s.networkBlock(blockID, reorg.NetworkID)
+			if !ok || block.BlockNumber <= reorg.ForkBlockNumber {
+				return 0, false
+			}
+			return block.BlockNumber, true
+		}
+		s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+			if blockNumber, ok := removed(d.BlockID); ok && d.NetworkID == reorg.NetworkID {
+				reorg.Deposits = append(reorg.Deposits, etherman.Deposit{
+					LeafType:           d.LeafType,
+					OriginalNetwork:    d.OriginalNetwork,
+					OriginalAddress:    d.OriginalAddress,
+					Amount:             d.Amount,
+					DestinationNetwork: d.DestinationNetwork,
+					DestinationAddress: d.DestinationAddress,
+					DepositCount:       d.DepositCount,
+					BlockNumber:        blockNumber,
+					NetworkID:          d.NetworkID,
+					TxHash:             d.TxHash,
+					Metadata:           d.Metadata,
+				})
+			}
+			return true
+		})
+		sort.SliceStable(reorg.Deposits, func(i, j int) bool { return reorg.Deposits[i].DepositCount < reorg.Deposits[j].DepositCount })
+		s.claims.each(func(_ uint64, c *etherman.Claim) bool {
+			if blockNumber, ok := removed(c.BlockID); ok && c.NetworkID == reorg.NetworkID {
+				claim := *c
+				claim.BlockID = 0
+				claim.BlockNumber = blockNumber
+				reorg.Claims = append(reorg.Claims, claim)
+			}
+			return true
+		})
+		sort.SliceStable(reorg.Claims, func(i, j int) bool {
+			if reorg.Claims[i].BlockNumber != reorg.Claims[j].BlockNumber {
+				return reorg.Claims[i].BlockNumber < reorg.Claims[j].BlockNumber
+			}
+			return reorg.Claims[i].Index < reorg.Claims[j].Index
+		})
+		s.exitRoots.each(func(_ uint64, ger *etherman.GlobalExitRoot) bool {
+			if blockNumber, ok := removed(ger.BlockID); ok {
+				reorg.GlobalExitRoots = append(reorg.GlobalExitRoots, etherman.GlobalExitRoot{
+					BlockNumber:    blockNumber,
+					GlobalExitRoot: ger.GlobalExitRoot,
+					ExitRoots:      append([]common.Hash(nil), ger.ExitRoots...),
+				})
+			}
+			return true
+		})
+		sort.SliceStable(reorg.GlobalExitRoots, func(i, j int) bool {
+			return reorg.GlobalExitRoots[i].BlockNumber < reorg.GlobalExitRoots[j].BlockNumber
+		})
+		row := *reorg
+		reorg.ID = s.reorgs.insert(tx, &row)
+		row.ID = reorg.ID
+		return nil
+	})
+}
+
+// GetReorgs gets the reorg journal of a network, the most recent first.
+func (s *MemoryStorage) GetReorgs(ctx context.Context, networkID uint, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Reorg, error) {
+	reorgs := make([]*etherman.Reorg, 0)
+	err := s.read(dbTx, func() error {
+		for i := s.reorgs.len() - 1; i >= 0; i-- {
+			if r := s.reorgs.records[i].row; r.NetworkID == networkID {
+				reorg := *r
+				reorgs = append(reorgs, &reorg)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paginate(reorgs, limit, offset), nil
+}
+
+// GetReorgCount gets the number of reorgs stored in the journal for a network.
+func (s *MemoryStorage) GetReorgCount(ctx context.Context, networkID uint, dbTx pgx.Tx) (uint64, error) {
+	var count uint64
+	err := s.read(dbTx, func() error {
+		s.reorgs.each(func(_ uint64, r *etherman.Reorg) bool {
+			if r.NetworkID == networkID {
+				count++
+			}
+			return true
+		})
+		return nil
+	})
+	return count, err
+}
+
+// GetNumberDeposits gets the number of deposits.
+func (s *MemoryStorage) GetNumberDeposits(ctx context.Context, networkID uint, blockNumber uint64, dbTx pgx.Tx) (uint64, error) {
+	var count uint64
+	err := s.read(dbTx, func() error {
+		s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+			block, ok := s.networkBlock(d.BlockID, d.NetworkID)
+			if ok && d.NetworkID == networkID && block.BlockNumber <= blockNumber && uint64(d.DepositCount)+1 > count {
+				count = uint64(d.DepositCount) + 1
+			}
+			return true
+		})
+		return nil
+	})
+	return count, err
+}
+
+// GetClaim gets a specific claim from the storage.
+// The deposit is identified by its deposit count and its origin network, which is taken from the global index of the claim.
+func (s *MemoryStorage) GetClaim(ctx context.Context, depositCount, origNetworkID, networkID uint, dbTx pgx.Tx) (*etherman.Claim, error) {
+	var claim *etherman.Claim
+	err := s.read(dbTx, func() error {
+		s.claims.each(func(_ uint64, c *etherman.Claim) bool {
+			if c.Index == depositCount && c.NetworkID == networkID && sourceNetwork(c) == origNetworkID {
+				row := *c
+				claim = &row
+				return false
+			}
+			return true
+		})
+		if claim == nil {
+			return gerror.ErrStorageNotFound
+		}
+		return nil
+	})
+	return claim, err
+}
+
+// GetClaims gets the claim list which be smaller than index.
+func (s *MemoryStorage) GetClaims(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Claim, error) {
+	claims := make([]*etherman.Claim, 0)
+	addr := common.FromHex(destAddr)
+	err := s.read(dbTx, func() error {
+		s.claims.each(func(_ uint64, c *etherman.Claim) bool {
+			if bytes.Equal(c.DestinationAddress.Bytes(), addr) {
+				claim := *c
+				claims = append(claims, &claim)
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(claims, func(i, j int) bool { return claims[i].BlockID > claims[j].BlockID })
+	return paginate(claims, limit, offset), nil
+}
+
+// GetClaimCount gets the claim count for the destination address.
+func (s *MemoryStorage) GetClaimCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error) {
+	var count uint64
+	addr := common.FromHex(destAddr)
+	err := s.read(dbTx, func() error {
+		s.claims.each(func(_ uint64, c *etherman.Claim) bool {
+			if bytes.Equal(c.DestinationAddress.Bytes(), addr) {
+				count++
+			}
+			return true
+		})
+		return nil
+	})
+	return count, err
+}
+
+// withBlock returns a copy of the deposit with the fields of its block. The deposits without block are skipped.
+func (s *MemoryStorage) withBlock(d *etherman.Deposit) (*etherman.Deposit, bool) {
+	block, ok := s.networkBlock(d.BlockID, d.NetworkID)
+	if !ok {
+		return nil, false
+	}
+	deposit := *d
+	deposit.BlockNumber = block.BlockNumber
+	deposit.ReceivedAt = block.ReceivedAt
+	return &deposit, true
+}
+
+// claimKey identifies the claims of a deposit: its network, its deposit count and the network where it's claimed
+type claimKey struct {
+	sourceNetwork uint
+	index         uint
+	networkID     uint
+}
+
+func depositClaimKey(d *etherman.Deposit) claimKey {
+	return claimKey{d.NetworkID, d.DepositCount, d.DestinationNetwork}
+}
+
+// claimTxHashes gets the hash of the tx of the first claim of each deposit
+func (s *MemoryStorage) claimTxHashes() map[claimKey]common.Hash {
+	txHashes := make(map[claimKey]common.Hash)
+	s.claims.each(func(_ uint64, c *etherman.Claim) bool {
+		key := claimKey{sourceNetwork(c), c.Index, c.NetworkID}
+		if _, found := txHashes[key]; !found {
+			txHashes[key] = c.TxHash
+		}
+		return true
+	})
+	return txHashes
+}
+
+// withClaim sets the hash of the tx that claimed the deposit, if any
+func withClaim(deposit *etherman.Deposit, txHashes map[claimKey]common.Hash) *etherman.Deposit {
+	if txHash, found := txHashes[depositClaimKey(deposit)]; found {
+		deposit.ClaimTxHash = &txHash
+	}
+	return deposit
+}
+
+// GetDeposit gets a specific deposit from the storage, with the hash of the tx that claimed it.
+func (s *MemoryStorage) GetDeposit(ctx context.Context, depositCounterUser uint, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error) {
+	var deposit *etherman.Deposit
+	err := s.read(dbTx, func() error {
+		s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+			if d.NetworkID == networkID && d.DepositCount == depositCounterUser {
+				var ok bool
+				deposit, ok = s.withBlock(d)
+				if ok {
+					withClaim(deposit, s.claimTxHashes())
+				}
+				return !ok
+			}
+			return true
+		})
+		if deposit == nil {
+			return gerror.ErrStorageNotFound
+		}
+		return nil
+	})
+	return deposit, err
+}
+
+// GetDeposits gets the deposits to the destination address, with the hash of the tx that claimed each one.
+func (s *MemoryStorage) GetDeposits(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	deposits := make([]*etherman.Deposit, 0)
+	addr := common.FromHex(destAddr)
+	err := s.read(dbTx, func() error {
+		txHashes := s.claimTxHashes()
+		s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+			if bytes.Equal(d.DestinationAddress.Bytes(), addr) {
+				if deposit, ok := s.withBlock(d); ok {
+					deposits = append(deposits, withClaim(deposit, txHashes))
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(deposits, func(i, j int) bool {
+		if deposits[i].BlockID != deposits[j].BlockID {
+			return deposits[i].BlockID > deposits[j].BlockID
+		}
+		return deposits[i].DepositCount > deposits[j].DepositCount
+	})
+	return paginate(deposits, limit, offset), nil
+}
+
+// GetDepositCount gets the deposit count for the destination address.
+func (s *MemoryStorage) GetDepositCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error) {
+	var count uint64
+	addr := common.FromHex(destAddr)
+	err := s.read(dbTx, func() error {
+		s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+			if bytes.Equal(d.DestinationAddress.Bytes(), addr) {
+				count++
+			}
+			return true
+		})
+		return nil
+	})
+	return count, err
+}
+
+// pendingClaims gets the deposits to the destination address that are ready for claim but not claimed yet
+func (s *MemoryStorage) pendingClaims(destAddr string) []*etherman.Deposit {
+	txHashes := s.claimTxHashes()
+	deposits := make([]*etherman.Deposit, 0)
+	addr := common.FromHex(destAddr)
+	s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+		if _, claimed := txHashes[depositClaimKey(d)]; claimed || !d.ReadyForClaim || !bytes.Equal(d.DestinationAddress.Bytes(), addr) {
+			return true
+		}
+		if deposit, ok := s.withBlock(d); ok {
+			deposits = append(deposits, deposit)
+		}
+		return true
+	})
+	return deposits
+}
+
+// GetPendingClaims gets the deposits to the destination address that are ready for claim but not claimed yet.
+func (s *MemoryStorage) GetPendingClaims(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	var deposits []*etherman.Deposit
+	err := s.read(dbTx, func() error {
+		deposits = s.pendingClaims(destAddr)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(deposits, func(i, j int) bool {
+		if deposits[i].BlockID != deposits[j].BlockID {
+			return deposits[i].BlockID > deposits[j].BlockID
+		}
+		return deposits[i].DepositCount > deposits[j].DepositCount
+	})
+	return paginate(deposits, limit, offset), nil
+}
+
+// GetPendingClaimTotals gets the value of each origin token that is ready for claim by the destination address but not claimed yet.
+// The value of the message deposits is accounted as ether.
+func (s *MemoryStorage) GetPendingClaimTotals(ctx context.Context, destAddr string, dbTx pgx.Tx) ([]*etherman.PendingClaimTotal, error) {
+	type tokenKey struct {
+		originalNetwork uint
+		originalAddress common.Address
+	}
+	totals := make(map[tokenKey]*etherman.PendingClaimTotal)
+	err := s.read(dbTx, func() error {
+		for _, d := range s.pendingClaims(destAddr) {
+			key := tokenKey{d.OriginalNetwork, d.OriginalAddress}
+			if d.LeafType == 1 {
+				key = tokenKey{}
+			}
+			if _, ok := totals[key]; !ok {
+				totals[key] = &etherman.PendingClaimTotal{OriginalNetwork: key.originalNetwork, OriginalAddress: key.originalAddress, Amount: big.NewInt(0)}
+			}
+			totals[key].Count++
+			totals[key].Amount.Add(totals[key].Amount, copyAmount(d.Amount))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*etherman.PendingClaimTotal, 0, len(totals))
+	for _, total := range totals {
+		res = append(res, total)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].OriginalNetwork != res[j].OriginalNetwork {
+			return res[i].OriginalNetwork < res[j].OriginalNetwork
+		}
+		return bytes.Compare(res[i].OriginalAddress.Bytes(), res[j].OriginalAddress.Bytes()) < 0
+	})
+	return res, nil
+}
+
+// UpdateDepositsStatusForTesting updates the ready_for_claim status of all deposits for testing.
+func (s *MemoryStorage) UpdateDepositsStatusForTesting(ctx context.Context, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+			update(tx, d, func(d *etherman.Deposit) { d.ReadyForClaim = true })
+			return true
+		})
+		return nil
+	})
+}
+
+// UpdateL1DepositsStatus updates the ready_for_claim status of the L1 deposits to the destination network.
+func (s *MemoryStorage) UpdateL1DepositsStatus(ctx context.Context, exitRoot []byte, destNetworkID uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	var deposits []*etherman.Deposit
+	err := s.write(dbTx, func(tx *memTx) error {
+		depositCount, found := s.rootDepositCount(exitRoot, 0)
+		if found {
+			deposits = s.setReadyForClaim(tx, 0, depositCount, &destNetworkID)
+		}
+		return nil
+	})
+	return deposits, err
+}
+
+// UpdateL2DepositsStatus updates the ready_for_claim status of L2 deposits.
+func (s *MemoryStorage) UpdateL2DepositsStatus(ctx context.Context, exitRoot []byte, rollupID, networkID uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	var deposits []*etherman.Deposit
+	err := s.write(dbTx, func(tx *memTx) error {
+		root := common.BytesToHash(exitRoot)
+		_, leaf, found := s.rollupExitLeaves.last(func(_ uint64, r *rollupExitRow) bool {
+			return r.root == root && r.rollupID == rollupID && r.invalidatedBy == nil
+		})
+		if !found {
+			return nil
+		}
+		depositCount, found := s.rootDepositCount(leaf.leaf.Bytes(), networkID)
+		if found {
+			deposits = s.setReadyForClaim(tx, networkID, depositCount, nil)
+		}
+		return nil
+	})
+	return deposits, err
+}
+
+func (s *MemoryStorage) setReadyForClaim(tx *memTx, networkID, depositCount uint, destNetworkID *uint) []*etherman.Deposit {
+	deposits := make([]*etherman.Deposit, 0)
+	s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+		if d.NetworkID != networkID || d.DepositCount > depositCount || d.ReadyForClaim || (destNetworkID != nil && d.DestinationNetwork != *destNetworkID) {
+			return true
+		}
+		update(tx, d, func(d *etherman.Deposit) { d.ReadyForClaim = true })
+		deposit := *d
+		deposit.BlockNumber = s.blockNumber(d.BlockID)
+		deposits = append(deposits, &deposit)
+		return true
+	})
+	return deposits
+}
+
+// GetDepositCountByRoot gets the deposit count by the root.
+func (s *MemoryStorage) GetDepositCountByRoot(ctx context.Context, root []byte, network uint8, dbTx pgx.Tx) (uint, error) {
+	var depositCount uint
+	err := s.read(dbTx, func() error {
+		var found bool
+		depositCount, found = s.rootDepositCount(root, uint(network))
+		if !found {
+			return gerror.ErrStorageNotFound
+		}
+		return nil
+	})
+	return depositCount, err
+}
+
+// CheckIfRootExists checks that the root exists on the db.
+func (s *MemoryStorage) CheckIfRootExists(ctx context.Context, root []byte, network uint8, dbTx pgx.Tx) (bool, error) {
+	var exists bool
+	err := s.read(dbTx, func() error {
+		_, _, exists = s.roots.last(func(_ uint64, r *rootRow) bool {
+			return r.network == uint(network) && bytes.Equal(r.root, root)
+		})
+		return nil
+	})
+	return exists, err
+}
+
+// GetRoot gets root by the deposit count from the merkle tree.
+func (s *MemoryStorage) GetRoot(ctx context.Context, depositCnt uint, network uint, dbTx pgx.Tx) ([]byte, error) {
+	var root []byte
+	err := s.read(dbTx, func() error {
+		s.roots.each(func(_ uint64, r *rootRow) bool {
+			if r.network != network {
+				return true
+			}
+			if deposit, ok := s.deposits.get(r.depositID); ok && deposit.DepositCount == depositCnt {
+				root = r.root
+				return false
+			}
+			return true
+		})
+		if root == nil {
+			return gerror.ErrStorageNotFound
+		}
+		return nil
+	})
+	return root, err
+}
+
+// SetRoot store the root with deposit count to the storage.
+func (s *MemoryStorage) SetRoot(ctx context.Context, root []byte, depositID uint64, network uint, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		_, _, exists := s.roots.last(func(_ uint64, r *rootRow) bool { return r.depositID == depositID })
+		if exists {
+			return errDuplicateKey
+		}
+		s.roots.insert(tx, &rootRow{root: root, depositID: depositID, network: network})
+		return nil
+	})
+}
+
+// GetLastDepositCount gets the last deposit count from the merkle tree.
+func (s *MemoryStorage) GetLastDepositCount(ctx context.Context, network uint, dbTx pgx.Tx) (uint, error) {
+	var depositCount uint
+	err := s.read(dbTx, func() error {
+		var (
+			lastDepositID uint64
+			found         bool
+		)
+		s.roots.each(func(_ uint64, r *rootRow) bool {
+			if r.network == network && (!found || r.depositID > lastDepositID) {
+				lastDepositID, found = r.depositID, true
+			}
+			return true
+		})
+		deposit, ok := s.deposits.get(lastDepositID)
+		if !found || !ok {
+			return gerror.ErrStorageNotFound
+		}
+		depositCount = deposit.DepositCount
+		return nil
+	})
+	return depositCount, err
+}
+
+// Get gets value of key from the merkle tree.
+func (s *MemoryStorage) Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error) {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+	value, ok := s.nodes[string(key)]
+	if !ok {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return value, nil
+}
+
+// Set inserts a key-value pair into the merkle tree.
+func (s *MemoryStorage) Set(ctx context.Context, key []byte, value [][]byte, depositID uint64, dbTx pgx.Tx) error {
+	return s.BulkSet(ctx, [][]interface{}{{key, value, depositID}}, dbTx)
+}
+
+// BulkSet is similar to Set, but it inserts multiple key-value pairs. Each row contains the key,
+// the children and the deposit id, like the rows of mt.rht. The nodes are kept if the db transaction
+// is rolled back, which is harmless because they are content addressed.
+func (s *MemoryStorage) BulkSet(ctx context.Context, rows [][]interface{}, dbTx pgx.Tx) error {
+	s.nodesLock.Lock()
+	defer s.nodesLock.Unlock()
+	for _, row := range rows {
+		key, ok := row[0].([]byte)
+		if !ok {
+			return fmt.Errorf("invalid node key type %T", row[0])
+		}
+		value, ok := row[1].([][]byte)
+		if !ok {
+			return fmt.Errorf("invalid node value type %T", row[1])
+		}
+		if _, exists := s.nodes[string(key)]; !exists {
+			// The children are copied because the caller may reuse their arrays, like the siblings of the merkle tree
+			children := make([][]byte, len(value))
+			for i, child := range value {
+				children[i] = common.CopyBytes(child)
+			}
+			s.nodes[string(key)] = children
+		}
+	}
+	return nil
+}
+
+// IterateNodes calls fn for each node of the merkle trees.
+func (s *MemoryStorage) IterateNodes(ctx context.Context, fn func(key []byte, value [][]byte) error, dbTx pgx.Tx) error {
+	s.nodesLock.RLock()
+	defer s.nodesLock.RUnlock()
+	for key, value := range s.nodes {
+		if err := fn([]byte(key), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetExitRootDepositCount gets the deposit count of the root of the network included in a global exit root synced from L1.
+// The offset is the position of the global exit root from the latest one. The local exit roots of the rollups are
+// taken from the leaves of the rollup exit root.
+func (s *MemoryStorage) GetExitRootDepositCount(ctx context.Context, network uint, offset uint, dbTx pgx.Tx) (uint, error) {
+	var depositCount uint
+	err := s.read(dbTx, func() error {
+		var (
+			ger   *etherman.GlobalExitRoot
+			found bool
+		)
+		for i := s.exitRoots.len() - 1; i >= 0; i-- {
+			if row := s.exitRoots.records[i].row; row.BlockID > 0 {
+				if offset == 0 {
+					ger = row
+					break
+				}
+				offset--
+			}
+		}
+		if ger == nil {
+			return gerror.ErrStorageNotFound
+		}
+		root := ger.ExitRoots[0]
+		if network > 0 {
+			var leaf *rollupExitRow
+			_, leaf, found = s.rollupExitLeaves.last(func(_ uint64, r *rollupExitRow) bool {
+				return r.root == ger.ExitRoots[1] && r.rollupID == network && r.invalidatedBy == nil
+			})
+			if !found {
+				return gerror.ErrStorageNotFound
+			}
+			root = leaf.leaf
+		}
+		depositCount, found = s.rootDepositCount(root.Bytes(), network)
+		if !found {
+			return gerror.ErrStorageNotFound
+		}
+		return nil
+	})
+	return depositCount, err
+}
+
+// GetFirstDepositCountSince gets the count of the first deposit of the network whose block was received since the given time.
+func (s *MemoryStorage) GetFirstDepositCountSince(ctx context.Context, network uint, since time.Time, dbTx pgx.Tx) (uint, error) {
+	var (
+		depositCount uint
+		found        bool
+	)
+	err := s.read(dbTx, func() error {
+		s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+			block, ok := s.networkBlock(d.BlockID, network)
+			if ok && d.NetworkID == network && !block.ReceivedAt.Before(since) && (!found || d.DepositCount < depositCount) {
+				depositCount, found = d.DepositCount, true
+			}
+			return true
+		})
+		if !found {
+			return gerror.ErrStorageNotFound
+		}
+		return nil
+	})
+	return depositCount, err
+}
+
+// GetPrunedDepositCount gets the number of deposits of the network whose historical merkle tree nodes are pruned.
+func (s *MemoryStorage) GetPrunedDepositCount(ctx context.Context, network uint, dbTx pgx.Tx) (uint, error) {
+	var depositCount uint
+	err := s.read(dbTx, func() error {
+		depositCount = s.prunedDepositCounts[network]
+		return nil
+	})
+	return depositCount, err
+}
+
+// PruneMerkleTree removes the root of the deposit of the network and records the deposit as pruned. The nodes are
+// kept, because they are content addressed and the same node may be stored by a kept deposit, so it returns 0.
+func (s *MemoryStorage) PruneMerkleTree(ctx context.Context, network uint, depositCount uint, keys [][]byte, dbTx pgx.Tx) (int64, error) {
+	err := s.write(dbTx, func(tx *memTx) error {
+		s.roots.delete(tx, func(_ uint64, r *rootRow) bool {
+			deposit, ok := s.deposits.get(r.depositID)
+			return ok && r.network == network && deposit.NetworkID == network && deposit.DepositCount == depositCount
+		})
+		previous, ok := s.prunedDepositCounts[network]
+		s.prunedDepositCounts[network] = depositCount + 1
+		tx.onRollback(func() {
+			if ok {
+				s.prunedDepositCounts[network] = previous
+			} else {
+				delete(s.prunedDepositCounts, network)
+			}
+		})
+		return nil
+	})
+	return 0, err
+}
+
+// GetBlockRanges gets the first and last synced blocks of each network.
+func (s *MemoryStorage) GetBlockRanges(ctx context.Context, dbTx pgx.Tx) ([]etherman.BlockRange, error) {
+	ranges := make(map[uint]*etherman.BlockRange)
+	err := s.read(dbTx, func() error {
+		s.blocks.each(func(id uint64, b *etherman.Block) bool {
+			if id == 0 {
+				return true
+			}
+			r, ok := ranges[b.NetworkID]
+			if !ok {
+				r = &etherman.BlockRange{NetworkID: b.NetworkID, FirstBlockNumber: b.BlockNumber, FirstBlockHash: b.BlockHash}
+				ranges[b.NetworkID] = r
+			}
+			if b.BlockNumber < r.FirstBlockNumber {
+				r.FirstBlockNumber, r.FirstBlockHash = b.BlockNumber, b.BlockHash
+			}
+			if !ok || b.BlockNumber > r.LastBlockNumber {
+				r.LastBlockNumber, r.LastBlockHash = b.BlockNumber, b.BlockHash
+			}
+			return true
+		})
+		return nil
+	})
+	result := make([]etherman.BlockRange, 0, len(ranges))
+	for _, r := range ranges {
+		result = append(result, *r)
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].NetworkID < result[j].NetworkID })
+	return result, err
+}
+
+// IterateDepositRoots calls fn for each deposit of the network in the order of the deposit count, with its id and
+// the root of the merkle tree stored for it. The root is nil if it's not stored, like the pruned ones.
+func (s *MemoryStorage) IterateDepositRoots(ctx context.Context, networkID uint, fn func(depositID uint64, deposit *etherman.Deposit, root []byte) error, dbTx pgx.Tx) error {
+	type depositRoot struct {
+		id      uint64
+		deposit etherman.Deposit
+		root    []byte
+	}
+	var deposits []depositRoot
+	err := s.read(dbTx, func() error {
+		roots := make(map[uint64][]byte)
+		s.roots.each(func(_ uint64, r *rootRow) bool {
+			if r.network == networkID {
+				roots[r.depositID] = r.root
+			}
+			return true
+		})
+		s.deposits.each(func(id uint64, d *etherman.Deposit) bool {
+			if d.NetworkID == networkID {
+				deposits = append(deposits, depositRoot{id: id, deposit: *d, root: roots[id]})
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(deposits, func(i, j int) bool { return deposits[i].deposit.DepositCount < deposits[j].deposit.DepositCount })
+	for i := range deposits {
+		if err := fn(deposits[i].id, &deposits[i].deposit, deposits[i].root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteMerkleTreeFrom deletes the roots stored by the deposits of the network since the given deposit count. The
+// nodes are kept, because they are content addressed and may be stored by other deposits.
+func (s *MemoryStorage) DeleteMerkleTreeFrom(ctx context.Context, networkID uint, depositCount uint, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		s.roots.delete(tx, func(_ uint64, r *rootRow) bool {
+			deposit, ok := s.deposits.get(r.depositID)
+			return ok && r.network == networkID && deposit.NetworkID == networkID && deposit.DepositCount >= depositCount
+		})
+		return nil
+	})
+}
+
+// IterateRollupExitRoots calls fn for each root of the rollup exit tree in the order it was stored, with its leaves
+// ordered by rollup id. The invalidated leaves are included, because they were the leaves of the root.
+func (s *MemoryStorage) IterateRollupExitRoots(ctx context.Context, fn func(root common.Hash, leaves []etherman.RollupExitLeaf) error, dbTx pgx.Tx) error {
+	var (
+		roots  []common.Hash
+		leaves = make(map[common.Hash][]etherman.RollupExitLeaf)
+	)
+	err := s.read(dbTx, func() error {
+		s.rollupExitLeaves.each(func(id uint64, r *rollupExitRow) bool {
+			if _, ok := leaves[r.root]; !ok {
+				roots = append(roots, r.root)
+			}
+			leaves[r.root] = append(leaves[r.root], etherman.RollupExitLeaf{ID: id, BlockID: r.blockID, Leaf: r.leaf, RollupId: r.rollupID, Root: r.root})
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, root := range roots {
+		sort.SliceStable(leaves[root], func(i, j int) bool { return leaves[root][i].RollupId < leaves[root][j].RollupId })
+		if err := fn(root, leaves[root]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddRollupExitLeaves inserts multiple entries. Each row contains the leaf, the rollup id, the root and the block id,
+// like the rows of mt.rollup_exit.
+func (s *MemoryStorage) AddRollupExitLeaves(ctx context.Context, rows [][]interface{}, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		for _, row := range rows {
+			leaf, ok := row[0].([]byte)
+			if !ok {
+				return fmt.Errorf("invalid leaf type %T", row[0])
+			}
+			rollupID, ok := toUint64(row[1])
+			if !ok {
+				return fmt.Errorf("invalid rollup id type %T", row[1])
+			}
+			root, ok := row[2].([]byte)
+			if !ok {
+				return fmt.Errorf("invalid root type %T", row[2])
+			}
+			blockID, ok := toUint64(row[3])
+			if !ok {
+				return fmt.Errorf("invalid block id type %T", row[3])
+			}
+			s.rollupExitLeaves.insert(tx, &rollupExitRow{
+				leaf:     common.BytesToHash(leaf),
+				rollupID: uint(rollupID),
+				root:     common.BytesToHash(root),
+				blockID:  blockID,
+			})
+		}
+		return nil
+	})
+}
+
+func toUint64(v interface{}) (uint64, bool) {
+	switch n := v.(type) {
+	case int:
+		return uint64(n), n >= 0
+	case uint:
+		return uint64(n), true
+	case uint32:
+		return uint64(n), true
+	case uint64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+func rollupExitLeaf(id uint64, r *rollupExitRow) etherman.RollupExitLeaf {
+	return etherman.RollupExitLeaf{ID: id, BlockID: r.blockID, Leaf: r.leaf, RollupId: r.rollupID, Root: r.root}
+}
+
+// GetRollupExitLeavesByRoot gets the leaves of the rollupExitTree given a root
+func (s *MemoryStorage) GetRollupExitLeavesByRoot(ctx context.Context, root common.Hash, dbTx pgx.Tx) ([]etherman.RollupExitLeaf, error) {
+	leaves := make([]etherman.RollupExitLeaf, 0)
+	err := s.read(dbTx, func() error {
+		s.rollupExitLeaves.each(func(id uint64, r *rollupExitRow) bool {
+			if r.root == root && r.invalidatedBy == nil {
+				leaves = append(leaves, rollupExitLeaf(id, r))
+			}
+			return true
+		})
+		return nil
+	})
+	sort.SliceStable(leaves, func(i, j int) bool { return leaves[i].RollupId < leaves[j].RollupId })
+	return leaves, err
+}
+
+// IsRollupExitRoot checks if the storage contains the root
+func (s *MemoryStorage) IsRollupExitRoot(ctx context.Context, root common.Hash, dbTx pgx.Tx) (bool, error) {
+	var exists bool
+	err := s.read(dbTx, func() error {
+		_, _, exists = s.rollupExitLeaves.last(func(_ uint64, r *rollupExitRow) bool { return r.root == root && r.invalidatedBy == nil })
+		return nil
+	})
+	return exists, err
+}
+
+// IsLxLyActivated checks if LxLy is activated
+func (s *MemoryStorage) IsLxLyActivated(ctx context.Context, dbTx pgx.Tx) (bool, error) {
+	var activated bool
+	err := s.read(dbTx, func() error {
+		activated = s.rollupExitLeaves.len() > 0
+		return nil
+	})
+	return activated, err
+}
+
+// GetLatestRollupExitRoot gets the latest root of the rollupExitTree that is not invalidated.
+func (s *MemoryStorage) GetLatestRollupExitRoot(ctx context.Context, dbTx pgx.Tx) (common.Hash, error) {
+	var root common.Hash
+	err := s.read(dbTx, func() error {
+		_, row, found := s.rollupExitLeaves.last(func(_ uint64, r *rollupExitRow) bool { return r.invalidatedBy == nil })
+		if !found {
+			return gerror.ErrStorageNotFound
+		}
+		root = row.root
+		return nil
+	})
+	return root, err
+}
+
+// GetLatestRollupExitLeaves gets the latest leaves of the rollupExitTree
+func (s *MemoryStorage) GetLatestRollupExitLeaves(ctx context.Context, dbTx pgx.Tx) ([]etherman.RollupExitLeaf, error) {
+	latest := make(map[uint]etherman.RollupExitLeaf)
+	err := s.read(dbTx, func() error {
+		s.rollupExitLeaves.each(func(id uint64, r *rollupExitRow) bool {
+			if r.invalidatedBy == nil {
+				latest[r.rollupID] = rollupExitLeaf(id, r)
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	leaves := make([]etherman.RollupExitLeaf, 0, len(latest))
+	for _, leaf := range latest {
+		leaves = append(leaves, leaf)
+	}
+	sort.Slice(leaves, func(i, j int) bool { return leaves[i].RollupId < leaves[j].RollupId })
+	return leaves, nil
+}
+
+// AddPendingStateChange adds a consolidation or an override of the pending state of a rollup.
+func (s *MemoryStorage) AddPendingStateChange(ctx context.Context, pendingState *etherman.PendingStateChange, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		row := *pendingState
+		row.BlockNumber = 0
+		pendingState.ID = s.pendingStateChanges.insert(tx, &row)
+		row.ID = pendingState.ID
+		return nil
+	})
+}
+
+// InvalidateRollupExitLeaves invalidates the rollupExitTree roots that include a pending state discarded by the override.
+// The pending states of the rollup are the leaves that appeared after its previous pending state change. Leaves matching
+// the overridden exit root are kept. The override must be stored before. It returns the number of invalidated leaves.
+func (s *MemoryStorage) InvalidateRollupExitLeaves(ctx context.Context, override *etherman.PendingStateChange, dbTx pgx.Tx) (int64, error) {
+	var invalidated int64
+	err := s.write(dbTx, func(tx *memTx) error {
+		var consolidated uint64
+		s.pendingStateChanges.each(func(id uint64, p *etherman.PendingStateChange) bool {
+			if block, ok := s.blocks.get(p.BlockID); ok && p.RollupID == override.RollupID && id < override.ID && block.BlockNumber > consolidated {
+				consolidated = block.BlockNumber
+			}
+			return true
+		})
+		// The first block where each leaf of the rollup appeared
+		firstBlocks := make(map[common.Hash]uint64)
+		s.rollupExitLeaves.each(func(_ uint64, r *rollupExitRow) bool {
+			block, ok := s.blocks.get(r.blockID)
+			if !ok || r.rollupID != override.RollupID || r.leaf == override.ExitRoot || r.invalidatedBy != nil {
+				return true
+			}
+			if first, ok := firstBlocks[r.leaf]; !ok || block.BlockNumber < first {
+				firstBlocks[r.leaf] = block.BlockNumber
+			}
+			return true
+		})
+		roots := make(map[common.Hash]struct{})
+		s.rollupExitLeaves.each(func(_ uint64, r *rollupExitRow) bool {
+			if first, ok := firstBlocks[r.leaf]; ok && r.rollupID == override.RollupID && first > consolidated {
+				roots[r.root] = struct{}{}
+			}
+			return true
+		})
+		s.rollupExitLeaves.each(func(_ uint64, r *rollupExitRow) bool {
+			if _, ok := roots[r.root]; ok && r.invalidatedBy == nil {
+				id := override.ID
+				update(tx, r, func(r *rollupExitRow) { r.invalidatedBy = &id })
+				invalidated++
+			}
+			return true
+		})
+		return nil
+	})
+	return invalidated, err
+}
+
+// AddEmergencyState adds an activation or deactivation of the emergency state.
+func (s *MemoryStorage) AddEmergencyState(ctx context.Context, emergencyState *etherman.EmergencyState, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		row := *emergencyState
+		row.BlockNumber = 0
+		s.emergencyStates.insert(tx, &row)
+		return nil
+	})
+}
+
+// GetEmergencyState gets the latest change of the emergency state in the network.
+func (s *MemoryStorage) GetEmergencyState(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.EmergencyState, error) {
+	var emergencyState *etherman.EmergencyState
+	err := s.read(dbTx, func() error {
+		s.emergencyStates.each(func(_ uint64, e *etherman.EmergencyState) bool {
+			block, ok := s.blocks.get(e.BlockID)
+			// The latest change wins the ties because the rows are iterated in the order of their ids
+			if ok && e.NetworkID == networkID && (emergencyState == nil || block.BlockNumber >= emergencyState.BlockNumber) {
+				row := *e
+				row.BlockNumber = block.BlockNumber
+				emergencyState = &row
+			}
+			return true
+		})
+		if emergencyState == nil {
+			return gerror.ErrStorageNotFound
+		}
+		return nil
+	})
+	return emergencyState, err
+}
+
+// AddRollup adds a rollup registered in the rollupManager.
+func (s *MemoryStorage) AddRollup(ctx context.Context, rollup *etherman.Rollup, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		_, _, exists := s.rollups.last(func(_ uint64, r *etherman.Rollup) bool { return r.RollupID == rollup.RollupID })
+		if exists {
+			return errDuplicateKey
+		}
+		row := *rollup
+		row.BlockNumber = 0
+		s.rollups.insert(tx, &row)
+		return nil
+	})
+}
+
+// AddRollupUpdate adds an upgrade of a rollup to a new rollup type.
+func (s *MemoryStorage) AddRollupUpdate(ctx context.Context, update *etherman.RollupUpdate, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		row := *update
+		row.BlockNumber = 0
+		s.rollupUpdates.insert(tx, &row)
+		return nil
+	})
+}
+
+// AddObsoleteRollupType adds a rollup type made obsolete in the rollupManager.
+func (s *MemoryStorage) AddObsoleteRollupType(ctx context.Context, obsolete *etherman.ObsoleteRollupType, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		row := *obsolete
+		row.BlockNumber = 0
+		s.obsoleteRollupTypes.insert(tx, &row)
+		return nil
+	})
+}
+
+// GetRollups gets the rollups registered in the rollupManager with the rollup type of their latest upgrade.
+func (s *MemoryStorage) GetRollups(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Rollup, error) {
+	rollups := make([]*etherman.Rollup, 0)
+	err := s.read(dbTx, func() error {
+		s.rollups.each(func(_ uint64, r *etherman.Rollup) bool {
+			if block, ok := s.blocks.get(r.BlockID); ok {
+				rollup := *r
+				rollup.BlockNumber = block.BlockNumber
+				rollups = append(rollups, &rollup)
+			}
+			return true
+		})
+		for _, rollup := range rollups {
+			var latest uint64
+			// The latest upgrade wins the ties because the rows are iterated in the order of their ids
+			s.rollupUpdates.each(func(_ uint64, u *etherman.RollupUpdate) bool {
+				if block, ok := s.blocks.get(u.BlockID); ok && u.RollupID == rollup.RollupID && block.BlockNumber >= latest {
+					latest = block.BlockNumber
+					rollup.RollupTypeID = u.RollupTypeID
+					rollup.LastVerifiedBatchBeforeUpgrade = u.LastVerifiedBatchBeforeUpgrade
+				}
+				return true
+			})
+			_, _, rollup.RollupTypeObsolete = s.obsoleteRollupTypes.last(func(_ uint64, o *etherman.ObsoleteRollupType) bool {
+				return o.RollupTypeID == rollup.RollupTypeID
+			})
+		}
+		return nil
+	})
+	sort.SliceStable(rollups, func(i, j int) bool { return rollups[i].RollupID < rollups[j].RollupID })
+	return rollups, err
+}
+
+// GetClaimableDelay gets the median delay between the block of a deposit and the block of the first exit root synced from L1
+// that covers it, for the latest deposits of the network that are ready for claim. The deposits of L1 are covered by the mainnet
+// exit root of a global exit root and the deposits of a rollup by a verified rollup exit leaf.
+func (s *MemoryStorage) GetClaimableDelay(ctx context.Context, networkID uint, limit uint, dbTx pgx.Tx) (time.Duration, error) {
+	var delays []time.Duration
+	err := s.read(dbTx, func() error {
+		var latest []*etherman.Deposit
+		s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+			if _, ok := s.blocks.get(d.BlockID); ok && d.NetworkID == networkID && d.ReadyForClaim {
+				latest = append(latest, d)
+			}
+			return true
+		})
+		sort.SliceStable(latest, func(i, j int) bool { return latest[i].DepositCount > latest[j].DepositCount })
+		if uint(len(latest)) > limit {
+			latest = latest[:limit]
+		}
+		for _, d := range latest {
+			deposited, _ := s.blocks.get(d.BlockID)
+			if covered, ok := s.coveredAt(networkID, d.DepositCount); ok {
+				delays = append(delays, covered.Sub(deposited.ReceivedAt))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(delays) == 0 {
+		return 0, gerror.ErrStorageNotFound
+	}
+	sort.Slice(delays, func(i, j int) bool { return delays[i] < delays[j] })
+	middle := len(delays) / 2 //nolint:gomnd
+	if len(delays)%2 == 1 {
+		return delays[middle], nil
+	}
+	return (delays[middle-1] + delays[middle]) / 2, nil //nolint:gomnd
+}
+
+// coveredAt gets the time of the block of the first exit root synced from L1 that covers the deposit count of the network
+func (s *MemoryStorage) coveredAt(networkID uint, depositCount uint) (time.Time, bool) {
+	covers := func(root common.Hash) bool {
+		count, found := s.rootDepositCount(root.Bytes(), networkID)
+		return found && count >= depositCount
+	}
+	var (
+		coveredBlock *etherman.Block
+		found        bool
+	)
+	if networkID == 0 {
+		// The mainnet exit root is the first element of the exit roots
+		s.exitRoots.each(func(_ uint64, ger *etherman.GlobalExitRoot) bool {
+			block, ok := s.blocks.get(ger.BlockID)
+			if ok && ger.BlockID > 0 && (!found || block.BlockNumber < coveredBlock.BlockNumber) && covers(ger.ExitRoots[0]) {
+				coveredBlock, found = block, true
+			}
+			return true
+		})
+	} else {
+		s.rollupExitLeaves.each(func(_ uint64, r *rollupExitRow) bool {
+			block, ok := s.blocks.get(r.blockID)
+			if ok && r.rollupID == networkID && r.invalidatedBy == nil && covers(r.leaf) {
+				coveredBlock, found = block, true
+				return false
+			}
+			return true
+		})
+	}
+	if !found {
+		return time.Time{}, false
+	}
+	return coveredBlock.ReceivedAt, true
+}
+
+// AddClaimTx adds a claim monitored transaction to the storage.
+func (s *MemoryStorage) AddClaimTx(ctx context.Context, mTx ctmtypes.MonitoredTx, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		_, _, exists := s.claimTxs.last(func(_ uint64, m *ctmtypes.MonitoredTx) bool { return m.DepositID == mTx.DepositID })
+		if exists {
+			return errDuplicateKey
+		}
+		row := copyMonitoredTx(mTx)
+		row.CreatedAt = time.Now().UTC()
+		row.UpdatedAt = row.CreatedAt
+		s.claimTxs.insert(tx, &row)
+		return nil
+	})
+}
+
+// UpdateClaimTx updates a claim monitored transaction in the storage.
+func (s *MemoryStorage) UpdateClaimTx(ctx context.Context, mTx ctmtypes.MonitoredTx, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		s.claimTxs.each(func(_ uint64, m *ctmtypes.MonitoredTx) bool {
+			if m.DepositID != mTx.DepositID {
+				return true
+			}
+			update(tx, m, func(m *ctmtypes.MonitoredTx) {
+				createdAt := m.CreatedAt
+				*m = copyMonitoredTx(mTx)
+				m.CreatedAt = createdAt
+				m.UpdatedAt = time.Now().UTC()
+			})
+			return false
+		})
+		return nil
+	})
+}
+
+// GetClaimTxsByStatus gets the monitored transactions by status.
+func (s *MemoryStorage) GetClaimTxsByStatus(ctx context.Context, statuses []ctmtypes.MonitoredTxStatus, dbTx pgx.Tx) ([]ctmtypes.MonitoredTx, error) {
+	mTxs := make([]ctmtypes.MonitoredTx, 0)
+	err := s.read(dbTx, func() error {
+		s.claimTxs.each(func(_ uint64, m *ctmtypes.MonitoredTx) bool {
+			for _, status := range statuses {
+				if m.Status == status {
+					mTxs = append(mTxs, copyMonitoredTx(*m))
+					break
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	sort.SliceStable(mTxs, func(i, j int) bool { return mTxs[i].CreatedAt.Before(mTxs[j].CreatedAt) })
+	return mTxs, err
+}
+
+// copyMonitoredTx copies the fields of a monitored tx that are stored, so the history of the stored one can't be changed
+func copyMonitoredTx(mTx ctmtypes.MonitoredTx) ctmtypes.MonitoredTx {
+	history := make(map[common.Hash]bool, len(mTx.History))
+	for h := range mTx.History {
+		history[h] = true
+	}
+	return ctmtypes.MonitoredTx{
+		DepositID: mTx.DepositID,
+		From:      mTx.From,
+		To:        mTx.To,
+		Nonce:     mTx.Nonce,
+		Value:     copyAmount(mTx.Value),
+		Data:      mTx.Data,
+		Gas:       mTx.Gas,
+		Status:    mTx.Status,
+		History:   history,
+		CreatedAt: mTx.CreatedAt,
+		UpdatedAt: mTx.UpdatedAt,
+	}
+}
+
+type bridgeStatsKey struct {
+	bucket          time.Time
+	originalNetwork uint
+	originalAddress common.Address
+	fromNetwork     uint
+	toNetwork       uint
+}
+
+// eachBridgeEvent calls fn for each deposit and claim with the hour of its block. The claims come from the network of the deposit.
+func (s *MemoryStorage) eachBridgeEvent(fn func(key bridgeStatsKey, deposit *etherman.Deposit, claim *etherman.Claim)) {
+	s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+		if block, ok := s.blocks.get(d.BlockID); ok {
+			fn(bridgeStatsKey{block.ReceivedAt.UTC().Truncate(time.Hour), d.OriginalNetwork, d.OriginalAddress, d.NetworkID, d.DestinationNetwork}, d, nil)
+		}
+		return true
+	})
+	s.claims.each(func(_ uint64, c *etherman.Claim) bool {
+		if block, ok := s.blocks.get(c.BlockID); ok {
+			fn(bridgeStatsKey{block.ReceivedAt.UTC().Truncate(time.Hour), c.OriginalNetwork, c.OriginalAddress, sourceNetwork(c), c.NetworkID}, nil, c)
+		}
+		return true
+	})
+}
+
+func addBridgeEvent(stats *etherman.BridgeStats, deposit *etherman.Deposit, claim *etherman.Claim) {
+	if deposit != nil {
+		stats.DepositCount++
+		stats.DepositAmount.Add(stats.DepositAmount, deposit.Amount)
+	} else {
+		stats.ClaimCount++
+		stats.ClaimAmount.Add(stats.ClaimAmount, claim.Amount)
+	}
+}
+
+func lessBridgeStats(a, b bridgeStatsKey) bool {
+	if !a.bucket.Equal(b.bucket) {
+		return a.bucket.Before(b.bucket)
+	}
+	if a.originalNetwork != b.originalNetwork {
+		return a.originalNetwork < b.originalNetwork
+	}
+	if c := bytes.Compare(a.originalAddress.Bytes(), b.originalAddress.Bytes()); c != 0 {
+		return c < 0
+	}
+	if a.fromNetwork != b.fromNetwork {
+		return a.fromNetwork < b.fromNetwork
+	}
+	return a.toNetwork < b.toNetwork
+}
+
+// GetBridgeStats gets the bridge volume statistics between from (inclusive) and to (exclusive), grouped by interval ("hour" or "day").
+// If origNet and origAddr are not nil, only the statistics of that origin token are returned.
+func (s *MemoryStorage) GetBridgeStats(ctx context.Context, interval string, from, to time.Time, origNet *uint, origAddr *common.Address, dbTx pgx.Tx) ([]*etherman.BridgeStats, error) {
+	var truncate func(time.Time) time.Time
+	switch interval {
+	case "hour":
+		truncate = func(t time.Time) time.Time { return t }
+	case "day":
+		truncate = func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC) }
+	default:
+		return nil, fmt.Errorf("unsupported interval %q", interval)
+	}
+	stats := make(map[bridgeStatsKey]*etherman.BridgeStats)
+	err := s.read(dbTx, func() error {
+		s.eachBridgeEvent(func(key bridgeStatsKey, deposit *etherman.Deposit, claim *etherman.Claim) {
+			if key.bucket.Before(from.UTC()) || !key.bucket.Before(to.UTC()) ||
+				(origNet != nil && key.originalNetwork != *origNet) || (origAddr != nil && key.originalAddress != *origAddr) {
+				return
+			}
+			key.bucket = truncate(key.bucket)
+			if _, ok := stats[key]; !ok {
+				stats[key] = &etherman.BridgeStats{
+					Bucket:          key.bucket,
+					OriginalNetwork: key.originalNetwork,
+					OriginalAddress: key.originalAddress,
+					FromNetwork:     key.fromNetwork,
+					ToNetwork:       key.toNetwork,
+					DepositAmount:   big.NewInt(0),
+					ClaimAmount:     big.NewInt(0),
+				}
+			}
+			addBridgeEvent(stats[key], deposit, claim)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]bridgeStatsKey, 0, len(stats))
+	for key := range stats {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return lessBridgeStats(keys[i], keys[j]) })
+	result := make([]*etherman.BridgeStats, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, stats[key])
+	}
+	return result, nil
+}
+
+// GetUnclaimedStats gets the value of each origin token and direction that is deposited but not claimed yet.
+// If origNet and origAddr are not nil, only the value of that origin token is returned.
+func (s *MemoryStorage) GetUnclaimedStats(ctx context.Context, origNet *uint, origAddr *common.Address, dbTx pgx.Tx) ([]*etherman.UnclaimedStats, error) {
+	totals := make(map[bridgeStatsKey]*etherman.BridgeStats)
+	err := s.read(dbTx, func() error {
+		s.eachBridgeEvent(func(key bridgeStatsKey, deposit *etherman.Deposit, claim *etherman.Claim) {
+			if (origNet != nil && key.originalNetwork != *origNet) || (origAddr != nil && key.originalAddress != *origAddr) {
+				return
+			}
+			key.bucket = time.Time{}
+			if _, ok := totals[key]; !ok {
+				totals[key] = &etherman.BridgeStats{DepositAmount: big.NewInt(0), ClaimAmount: big.NewInt(0)}
+			}
+			addBridgeEvent(totals[key], deposit, claim)
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]bridgeStatsKey, 0, len(totals))
+	for key, total := range totals {
+		if total.DepositAmount.Cmp(total.ClaimAmount) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return lessBridgeStats(keys[i], keys[j]) })
+	stats := make([]*etherman.UnclaimedStats, 0, len(keys))
+	for _, key := range keys {
+		total := totals[key]
+		var count uint64
+		if total.DepositCount > total.ClaimCount {
+			count = total.DepositCount - total.ClaimCount
+		}
+		stats = append(stats, &etherman.UnclaimedStats{
+			OriginalNetwork: key.originalNetwork,
+			OriginalAddress: key.originalAddress,
+			FromNetwork:     key.fromNetwork,
+			ToNetwork:       key.toNetwork,
+			Count:           count,
+			Amount:          new(big.Int).Sub(total.DepositAmount, total.ClaimAmount),
+		})
+	}
+	return stats, nil
+}
+
+// GetTokenBalances gets the amount of each origin token deposited from the network and claimed in it up to the block number.
+// The value of the message deposits is accounted as ether.
+func (s *MemoryStorage) GetTokenBalances(ctx context.Context, networkID uint, blockNumber uint64, dbTx pgx.Tx) ([]*etherman.TokenBalance, error) {
+	type tokenKey struct {
+		originalNetwork uint
+		originalAddress common.Address
+	}
+	var (
+		keys      []tokenKey
+		deposited = make(map[tokenKey]*big.Int)
+		claimed   = make(map[tokenKey]*big.Int)
+		wrapped   = make(map[tokenKey][]common.Address)
+	)
+	add := func(key tokenKey, depositAmount, claimAmount *big.Int) {
+		if _, ok := deposited[key]; !ok {
+			keys = append(keys, key)
+			deposited[key], claimed[key] = big.NewInt(0), big.NewInt(0)
+		}
+		deposited[key].Add(deposited[key], depositAmount)
+		claimed[key].Add(claimed[key], claimAmount)
+	}
+	synced := func(blockID uint64) bool {
+		block, ok := s.blocks.get(blockID)
+		return ok && block.BlockNumber <= blockNumber
+	}
+	err := s.read(dbTx, func() error {
+		s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+			if d.NetworkID == networkID && synced(d.BlockID) {
+				key := tokenKey{d.OriginalNetwork, d.OriginalAddress}
+				if d.LeafType == 1 {
+					key = tokenKey{}
+				}
+				add(key, d.Amount, big.NewInt(0))
+			}
+			return true
+		})
+		s.claims.each(func(_ uint64, c *etherman.Claim) bool {
+			if c.NetworkID != networkID || !synced(c.BlockID) {
+				return true
+			}
+			key := tokenKey{c.OriginalNetwork, c.OriginalAddress}
+			if deposit, ok := s.findDeposit(sourceNetwork(c), c.Index); ok && deposit.LeafType == 1 {
+				key = tokenKey{}
+			}
+			add(key, big.NewInt(0), c.Amount)
+			return true
+		})
+		s.tokensWrapped.each(func(_ uint64, t *etherman.TokenWrapped) bool {
+			if t.NetworkID == networkID {
+				key := tokenKey{t.OriginalNetwork, t.OriginalTokenAddress}
+				wrapped[key] = append(wrapped[key], t.WrappedTokenAddress)
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].originalNetwork != keys[j].originalNetwork {
+			return keys[i].originalNetwork < keys[j].originalNetwork
+		}
+		return bytes.Compare(keys[i].originalAddress.Bytes(), keys[j].originalAddress.Bytes()) < 0
+	})
+	balances := make([]*etherman.TokenBalance, 0, len(keys))
+	for _, key := range keys {
+		balance := etherman.TokenBalance{OriginalNetwork: key.originalNetwork, OriginalAddress: key.originalAddress, Deposited: deposited[key], Claimed: claimed[key]}
+		if len(wrapped[key]) == 0 {
+			balances = append(balances, &balance)
+			continue
+		}
+		for i := range wrapped[key] {
+			b := balance
+			b.WrappedAddress = &wrapped[key][i]
+			balances = append(balances, &b)
+		}
+	}
+	return balances, nil
+}
+
+// GetInconsistentClaims gets the claims that don't match a single synced deposit of their global index: the claims
+// of a global index that is claimed more than once, the claims whose fields differ from the deposit ones and the
+// claims whose deposit is not synced although the source network is synced beyond it.
+func (s *MemoryStorage) GetInconsistentClaims(ctx context.Context, dbTx pgx.Tx) ([]*etherman.InconsistentClaim, error) {
+	type globalIndex struct {
+		sourceNetwork uint
+		index         uint
+	}
+	claims := make([]*etherman.InconsistentClaim, 0)
+	sourceNetworks := make(map[*etherman.InconsistentClaim]uint)
+	err := s.read(dbTx, func() error {
+		claimCounts := make(map[globalIndex]uint64)
+		s.claims.each(func(_ uint64, c *etherman.Claim) bool {
+			claimCounts[globalIndex{sourceNetwork(c), c.Index}]++
+			return true
+		})
+		deposits := make(map[globalIndex][]*etherman.Deposit)
+		lastDeposits := make(map[uint]uint)
+		s.deposits.each(func(_ uint64, d *etherman.Deposit) bool {
+			deposits[globalIndex{d.NetworkID, d.DepositCount}] = append(deposits[globalIndex{d.NetworkID, d.DepositCount}], d)
+			if last, ok := lastDeposits[d.NetworkID]; !ok || d.DepositCount > last {
+				lastDeposits[d.NetworkID] = d.DepositCount
+			}
+			return true
+		})
+		s.claims.each(func(_ uint64, c *etherman.Claim) bool {
+			block, ok := s.blocks.get(c.BlockID)
+			if !ok {
+				return true
+			}
+			key := globalIndex{sourceNetwork(c), c.Index}
+			claim := *c
+			claim.BlockNumber = block.BlockNumber
+			if len(deposits[key]) == 0 {
+				last, synced := lastDeposits[key.sourceNetwork]
+				if claimCounts[key] > 1 || (synced && c.Index <= last) {
+					inconsistent := &etherman.InconsistentClaim{Claim: claim, ClaimCount: claimCounts[key]}
+					claims = append(claims, inconsistent)
+					sourceNetworks[inconsistent] = key.sourceNetwork
+				}
+				return true
+			}
+			for _, d := range deposits[key] {
+				mismatch := d.OriginalNetwork != c.OriginalNetwork || d.OriginalAddress != c.OriginalAddress || d.Amount.Cmp(c.Amount) != 0 ||
+					d.DestinationAddress != c.DestinationAddress || d.DestinationNetwork != c.NetworkID
+				if claimCounts[key] > 1 || mismatch {
+					deposit := *d
+					deposit.ReadyForClaim = false
+					deposit.BlockNumber = s.blockNumber(d.BlockID)
+					inconsistent := &etherman.InconsistentClaim{Claim: claim, Deposit: &deposit, ClaimCount: claimCounts[key]}
+					claims = append(claims, inconsistent)
+					sourceNetworks[inconsistent] = key.sourceNetwork
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	sort.SliceStable(claims, func(i, j int) bool {
+		a, b := claims[i], claims[j]
+		if a.Claim.NetworkID != b.Claim.NetworkID {
+			return a.Claim.NetworkID < b.Claim.NetworkID
+		}
+		if sourceNetworks[a] != sourceNetworks[b] {
+			return sourceNetworks[a] < sourceNetworks[b]
+		}
+		return a.Claim.Index < b.Claim.Index
+	})
+	return claims, err
+}
+
+// AddWebhookEvents adds events to the webhook outbox. They are ready to be delivered right away.
+func (s *MemoryStorage) AddWebhookEvents(ctx context.Context, events []*etherman.WebhookEvent, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		for _, event := range events {
+			event.CreatedAt = time.Now().UTC()
+			event.NextAttemptAt = event.CreatedAt
+			row := etherman.WebhookEvent{Type: event.Type, Payload: event.Payload, CreatedAt: event.CreatedAt, NextAttemptAt: event.NextAttemptAt}
+			event.ID = s.webhookEvents.insert(tx, &row)
+			row.ID = event.ID
+		}
+		return nil
+	})
+}
+
+// GetPendingWebhookEvents gets the oldest events of the webhook outbox whose next attempt is due.
+func (s *MemoryStorage) GetPendingWebhookEvents(ctx context.Context, now time.Time, limit uint, dbTx pgx.Tx) ([]*etherman.WebhookEvent, error) {
+	events := make([]*etherman.WebhookEvent, 0)
+	err := s.read(dbTx, func() error {
+		s.webhookEvents.each(func(_ uint64, e *etherman.WebhookEvent) bool {
+			if uint(len(events)) >= limit {
+				return false
+			}
+			if !e.NextAttemptAt.After(now) {
+				event := *e
+				events = append(events, &event)
+			}
+			return true
+		})
+		return nil
+	})
+	return events, err
+}
+
+// UpdateWebhookEvent updates the delivery attempts of an event of the webhook outbox.
+func (s *MemoryStorage) UpdateWebhookEvent(ctx context.Context, event *etherman.WebhookEvent, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		if row, ok := s.webhookEvents.get(event.ID); ok {
+			update(tx, row, func(row *etherman.WebhookEvent) {
+				row.Attempts, row.NextAttemptAt, row.LastError = event.Attempts, event.NextAttemptAt, event.LastError
+			})
+		}
+		return nil
+	})
+}
+
+// DeleteWebhookEvent removes a delivered event from the webhook outbox.
+func (s *MemoryStorage) DeleteWebhookEvent(ctx context.Context, id uint64, dbTx pgx.Tx) error {
+	return s.write(dbTx, func(tx *memTx) error {
+		s.webhookEvents.delete(tx, func(eventID uint64, _ *etherman.WebhookEvent) bool { return eventID == id })
+		return nil
+	})
+}
+
+// paginate applies the limit and offset of a query to the rows
+func paginate[T any](rows []T, limit, offset uint) []T {
+	if offset >= uint(len(rows)) {
+		return rows[:0]
+	}
+	rows = rows[offset:]
+	if limit < uint(len(rows)) {
+		rows = rows[:limit]
+	}
+	return rows
+}
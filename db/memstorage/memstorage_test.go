@@ -0,0 +1,441 @@
+package memstorage
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func addBlock(t *testing.T, s *MemoryStorage, networkID uint, blockNumber uint64, receivedAt time.Time) uint64 {
+	blockID, err := s.AddBlock(context.Background(), &etherman.Block{
+		BlockNumber: blockNumber,
+		BlockHash:   common.BigToHash(big.NewInt(int64(networkID*1000) + int64(blockNumber))),
+		NetworkID:   networkID,
+		ReceivedAt:  receivedAt,
+	}, nil)
+	require.NoError(t, err)
+	return blockID
+}
+
+func TestTransaction(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+
+	_, err := s.GetLastBlock(ctx, 0, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	tx, err := s.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	block := &etherman.Block{BlockNumber: 1, BlockHash: common.HexToHash("0x1"), ReceivedAt: time.Now()}
+	blockID, err := s.AddBlock(ctx, block, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), blockID)
+	// The same hash returns the existing block
+	blockID, err = s.AddBlock(ctx, block, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), blockID)
+	_, err = s.AddDeposit(ctx, &etherman.Deposit{BlockID: blockID, Amount: big.NewInt(1)}, tx)
+	require.NoError(t, err)
+	require.NoError(t, s.Rollback(ctx, tx))
+	require.ErrorIs(t, s.Commit(ctx, tx), pgx.ErrTxClosed)
+	_, err = s.GetLastBlock(ctx, 0, tx)
+	require.ErrorIs(t, err, pgx.ErrTxClosed)
+
+	_, err = s.GetLastBlock(ctx, 0, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+	_, err = s.GetDeposit(ctx, 0, 0, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	tx, err = s.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	blockID, err = s.AddBlock(ctx, block, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), blockID)
+	require.NoError(t, s.Commit(ctx, tx))
+	lastBlock, err := s.GetLastBlock(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, block.BlockHash, lastBlock.BlockHash)
+
+	// A failed change without db transaction is undone
+	require.NoError(t, s.AddRollup(ctx, &etherman.Rollup{RollupID: 1, BlockID: blockID}, nil))
+	require.ErrorIs(t, s.AddRollup(ctx, &etherman.Rollup{RollupID: 1, BlockID: blockID}, nil), errDuplicateKey)
+	rollups, err := s.GetRollups(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, rollups, 1)
+
+	otherTx, err := NewMemoryStorage().BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	_, err = s.GetLastBlock(ctx, 0, otherTx)
+	require.ErrorIs(t, err, errInvalidTx)
+}
+
+func TestTransactionIsolation(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+
+	tx, err := s.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	_, err = s.AddBlock(ctx, &etherman.Block{BlockNumber: 1, BlockHash: common.HexToHash("0x1"), ReceivedAt: time.Now()}, tx)
+	require.NoError(t, err)
+
+	// The reads and writes without db transaction, and the other transactions, wait for the commit
+	read := make(chan error)
+	go func() {
+		_, err := s.GetLastBlock(ctx, 0, nil)
+		read <- err
+	}()
+	began := make(chan pgx.Tx)
+	go func() {
+		otherTx, err := s.BeginDBTransaction(ctx)
+		require.NoError(t, err)
+		began <- otherTx
+	}()
+	select {
+	case <-read:
+		t.Fatal("the block is read before the commit")
+	case <-began:
+		t.Fatal("a db transaction began before the commit")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.NoError(t, s.Commit(ctx, tx))
+	select {
+	case err = <-read:
+		require.NoError(t, err)
+	case otherTx := <-began:
+		require.NoError(t, s.Rollback(ctx, otherTx))
+		require.NoError(t, <-read)
+		return
+	}
+	require.NoError(t, s.Rollback(ctx, <-began))
+}
+
+func TestUniqueKeys(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+	blockID := addBlock(t, s, 0, 1, time.Now())
+	destAddr := common.HexToAddress("0x1")
+
+	_, err := s.AddDeposit(ctx, &etherman.Deposit{NetworkID: 0, DepositCount: 1, BlockID: blockID}, nil)
+	require.NoError(t, err)
+	_, err = s.AddDeposit(ctx, &etherman.Deposit{NetworkID: 1, DepositCount: 1, BlockID: blockID}, nil)
+	require.NoError(t, err)
+	_, err = s.AddDeposit(ctx, &etherman.Deposit{NetworkID: 0, DepositCount: 1, BlockID: blockID}, nil)
+	require.ErrorIs(t, err, errDuplicateKey)
+
+	// The claims of the deposits with the same count from different networks don't collide
+	require.NoError(t, s.AddClaim(ctx, &etherman.Claim{NetworkID: 1, Index: 1, MainnetFlag: true, DestinationAddress: destAddr, BlockID: blockID}, nil))
+	require.NoError(t, s.AddClaim(ctx, &etherman.Claim{NetworkID: 1, Index: 1, RollupIndex: 1, DestinationAddress: destAddr, BlockID: blockID}, nil))
+	require.ErrorIs(t, s.AddClaim(ctx, &etherman.Claim{NetworkID: 1, Index: 1, RollupIndex: 1, DestinationAddress: destAddr, BlockID: blockID}, nil), errDuplicateKey)
+	claims, err := s.GetClaims(ctx, destAddr.String(), 10, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, claims, 2)
+
+	// The key of a removed row can be used again
+	require.NoError(t, s.Reset(ctx, 0, 0, nil))
+	require.NoError(t, s.AddClaim(ctx, &etherman.Claim{NetworkID: 1, Index: 1, MainnetFlag: true, BlockID: addBlock(t, s, 0, 1, time.Now())}, nil))
+}
+
+func TestTxNotSupported(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+	tx, err := s.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, tx.Rollback(ctx)) }()
+
+	_, err = tx.Exec(ctx, "SELECT 1")
+	require.ErrorIs(t, err, errNotSupported)
+	_, err = tx.Query(ctx, "SELECT 1")
+	require.ErrorIs(t, err, errNotSupported)
+	var n int
+	require.ErrorIs(t, tx.QueryRow(ctx, "SELECT 1").Scan(&n), errNotSupported)
+	_, err = tx.Begin(ctx)
+	require.ErrorIs(t, err, errNotSupported)
+	_, err = tx.SendBatch(ctx, &pgx.Batch{}).Exec()
+	require.ErrorIs(t, err, errNotSupported)
+	require.Nil(t, tx.Conn())
+}
+
+func TestReset(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+	now := time.Now()
+	var depositIDs []uint64
+	for i := uint64(1); i <= 3; i++ {
+		blockID := addBlock(t, s, 0, i, now)
+		depositID, err := s.AddDeposit(ctx, &etherman.Deposit{BlockID: blockID, DepositCount: uint(i - 1), Amount: big.NewInt(1)}, nil)
+		require.NoError(t, err)
+		depositIDs = append(depositIDs, depositID)
+		require.NoError(t, s.SetRoot(ctx, common.BigToHash(big.NewInt(int64(i))).Bytes(), depositID, 0, nil))
+		require.NoError(t, s.AddGlobalExitRoot(ctx, &etherman.GlobalExitRoot{
+			BlockID:        blockID,
+			GlobalExitRoot: common.BigToHash(big.NewInt(int64(i))),
+			ExitRoots:      []common.Hash{common.BigToHash(big.NewInt(int64(i))), {}},
+		}, nil))
+	}
+	l2BlockID := addBlock(t, s, 1, 5, now)
+	require.NoError(t, s.AddClaim(ctx, &etherman.Claim{BlockID: l2BlockID, NetworkID: 1, MainnetFlag: true, Index: 0, Amount: big.NewInt(1)}, nil))
+	inserted, err := s.AddTrustedGlobalExitRoot(ctx, &etherman.GlobalExitRoot{GlobalExitRoot: common.HexToHash("0xff"), ExitRoots: []common.Hash{{}, {}}}, nil)
+	require.NoError(t, err)
+	require.True(t, inserted)
+	require.ErrorIs(t, s.SetRoot(ctx, []byte{1}, depositIDs[0], 0, nil), errDuplicateKey)
+
+	reorg := &etherman.Reorg{NetworkID: 0, ForkBlockNumber: 1}
+	tx, err := s.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	require.NoError(t, s.AddReorg(ctx, reorg, tx))
+	require.NoError(t, s.Reset(ctx, 1, 0, tx))
+	require.NoError(t, s.Commit(ctx, tx))
+	require.Len(t, reorg.Deposits, 2)
+	require.Equal(t, uint64(2), reorg.Deposits[0].BlockNumber)
+	require.Len(t, reorg.GlobalExitRoots, 2)
+	count, err := s.GetReorgCount(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	lastBlock, err := s.GetLastBlock(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), lastBlock.BlockNumber)
+	depositCount, err := s.GetLastDepositCount(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint(0), depositCount)
+	ger, err := s.GetLatestL1SyncedExitRoot(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, common.BigToHash(big.NewInt(1)), ger.GlobalExitRoot)
+	// The trusted exit roots and the rows of the other networks are kept
+	ger, err = s.GetLatestTrustedExitRoot(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0xff"), ger.GlobalExitRoot)
+	claim, err := s.GetClaim(ctx, 0, 0, 1, nil)
+	require.NoError(t, err)
+	require.Equal(t, l2BlockID, claim.BlockID)
+}
+
+func TestRollupUpdates(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+	now := time.Now()
+	firstBlockID := addBlock(t, s, 0, 1, now)
+	require.NoError(t, s.AddRollup(ctx, &etherman.Rollup{RollupID: 1, BlockID: firstBlockID, RollupTypeID: 1}, nil))
+	secondBlockID := addBlock(t, s, 0, 2, now)
+	require.NoError(t, s.AddRollupUpdate(ctx, &etherman.RollupUpdate{BlockID: secondBlockID, RollupID: 1, RollupTypeID: 2, LastVerifiedBatchBeforeUpgrade: 10}, nil))
+	require.NoError(t, s.AddObsoleteRollupType(ctx, &etherman.ObsoleteRollupType{BlockID: secondBlockID, RollupTypeID: 2}, nil))
+
+	rollups, err := s.GetRollups(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, rollups, 1)
+	require.Equal(t, uint(2), rollups[0].RollupTypeID)
+	require.Equal(t, uint64(10), rollups[0].LastVerifiedBatchBeforeUpgrade)
+	require.True(t, rollups[0].RollupTypeObsolete)
+
+	// Reorging the upgrade restores the previous rollup type
+	require.NoError(t, s.Reset(ctx, 1, 0, nil))
+	rollups, err = s.GetRollups(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, rollups, 1)
+	require.Equal(t, uint(1), rollups[0].RollupTypeID)
+	require.Zero(t, rollups[0].LastVerifiedBatchBeforeUpgrade)
+	require.False(t, rollups[0].RollupTypeObsolete)
+}
+
+func TestDepositsStatus(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+	blockID := addBlock(t, s, 0, 1, time.Now())
+	destAddr := common.HexToAddress("0xabc")
+	for i := uint(0); i < 3; i++ {
+		depositID, err := s.AddDeposit(ctx, &etherman.Deposit{
+			BlockID:            blockID,
+			DepositCount:       i,
+			DestinationNetwork: 1,
+			DestinationAddress: destAddr,
+			Amount:             big.NewInt(10),
+		}, nil)
+		require.NoError(t, err)
+		require.NoError(t, s.SetRoot(ctx, common.BigToHash(big.NewInt(int64(i+1))).Bytes(), depositID, 0, nil))
+	}
+
+	deposits, err := s.UpdateL1DepositsStatus(ctx, common.BigToHash(big.NewInt(2)).Bytes(), 1, nil)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+	require.Equal(t, uint64(1), deposits[0].BlockNumber)
+	deposits, err = s.UpdateL1DepositsStatus(ctx, common.BigToHash(big.NewInt(3)).Bytes(), 1, nil)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+
+	userDeposits, err := s.GetDeposits(ctx, destAddr.String(), 2, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, userDeposits, 2)
+	require.Equal(t, uint(2), userDeposits[0].DepositCount)
+	require.True(t, userDeposits[0].ReadyForClaim)
+	count, err := s.GetDepositCount(ctx, destAddr.String(), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+	count, err = s.GetNumberDeposits(ctx, 0, 1, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+}
+
+func TestPendingClaims(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+	l1BlockID := addBlock(t, s, 0, 1, time.Now())
+	l2BlockID := addBlock(t, s, 1, 1, time.Now())
+	token := common.HexToAddress("0x1")
+	destAddr := common.HexToAddress("0xabc")
+	deposits := []*etherman.Deposit{
+		{BlockID: l1BlockID, DepositCount: 0, OriginalAddress: token, DestinationNetwork: 1, DestinationAddress: destAddr, Amount: big.NewInt(10)},
+		{BlockID: l1BlockID, DepositCount: 1, OriginalAddress: token, DestinationNetwork: 1, DestinationAddress: destAddr, Amount: big.NewInt(20)},
+		// The value of the messages is ether
+		{BlockID: l1BlockID, DepositCount: 2, LeafType: 1, OriginalAddress: common.HexToAddress("0x2"), DestinationNetwork: 1, DestinationAddress: destAddr, Amount: big.NewInt(5)},
+		// Not ready for claim
+		{BlockID: l1BlockID, DepositCount: 3, OriginalAddress: token, DestinationNetwork: 1, DestinationAddress: destAddr, Amount: big.NewInt(40)},
+	}
+	for i, deposit := range deposits {
+		depositID, err := s.AddDeposit(ctx, deposit, nil)
+		require.NoError(t, err)
+		require.NoError(t, s.SetRoot(ctx, common.BigToHash(big.NewInt(int64(i+1))).Bytes(), depositID, 0, nil))
+	}
+	_, err := s.UpdateL1DepositsStatus(ctx, common.BigToHash(big.NewInt(3)).Bytes(), 1, nil)
+	require.NoError(t, err)
+	claimTxHash := common.HexToHash("0x1")
+	require.NoError(t, s.AddClaim(ctx, &etherman.Claim{BlockID: l2BlockID, NetworkID: 1, Index: 1, MainnetFlag: true, OriginalAddress: token, DestinationAddress: destAddr, Amount: big.NewInt(20), TxHash: claimTxHash}, nil))
+
+	// The deposits are returned with the tx that claimed them
+	userDeposits, err := s.GetDeposits(ctx, destAddr.String(), 10, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, userDeposits, 4)
+	require.Nil(t, userDeposits[3].ClaimTxHash)
+	require.Equal(t, &claimTxHash, userDeposits[2].ClaimTxHash)
+	deposit, err := s.GetDeposit(ctx, 1, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, &claimTxHash, deposit.ClaimTxHash)
+
+	pending, err := s.GetPendingClaims(ctx, destAddr.String(), 10, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, uint(2), pending[0].DepositCount)
+	require.Equal(t, uint(0), pending[1].DepositCount)
+	pending, err = s.GetPendingClaims(ctx, destAddr.String(), 1, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, uint(0), pending[0].DepositCount)
+
+	totals, err := s.GetPendingClaimTotals(ctx, destAddr.String(), nil)
+	require.NoError(t, err)
+	require.Len(t, totals, 2)
+	require.Equal(t, common.Address{}, totals[0].OriginalAddress)
+	require.Equal(t, big.NewInt(5), totals[0].Amount)
+	require.Equal(t, token, totals[1].OriginalAddress)
+	require.Equal(t, uint64(1), totals[1].Count)
+	require.Equal(t, big.NewInt(10), totals[1].Amount)
+}
+
+func TestClaimTxs(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+	mTx := ctmtypes.MonitoredTx{DepositID: 1, Value: big.NewInt(1), Status: ctmtypes.MonitoredTxStatusCreated, History: map[common.Hash]bool{}}
+	require.NoError(t, s.AddClaimTx(ctx, mTx, nil))
+	require.ErrorIs(t, s.AddClaimTx(ctx, mTx, nil), errDuplicateKey)
+
+	// The stored history can't be changed without updating the tx
+	mTx.History[common.HexToHash("0x1")] = true
+	mTxs, err := s.GetClaimTxsByStatus(ctx, []ctmtypes.MonitoredTxStatus{ctmtypes.MonitoredTxStatusCreated}, nil)
+	require.NoError(t, err)
+	require.Len(t, mTxs, 1)
+	require.Empty(t, mTxs[0].History)
+
+	mTx.Status = ctmtypes.MonitoredTxStatusConfirmed
+	require.NoError(t, s.UpdateClaimTx(ctx, mTx, nil))
+	mTxs, err = s.GetClaimTxsByStatus(ctx, []ctmtypes.MonitoredTxStatus{ctmtypes.MonitoredTxStatusConfirmed}, nil)
+	require.NoError(t, err)
+	require.Len(t, mTxs, 1)
+	require.Len(t, mTxs[0].History, 1)
+	require.False(t, mTxs[0].CreatedAt.IsZero())
+}
+
+func TestNodes(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+	key := common.HexToHash("0x1").Bytes()
+	value := [][]byte{common.HexToHash("0x2").Bytes(), common.HexToHash("0x3").Bytes()}
+	_, err := s.Get(ctx, key, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	tx, err := s.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	require.NoError(t, s.BulkSet(ctx, [][]interface{}{{key, value, uint64(1)}}, tx))
+	require.NoError(t, s.Rollback(ctx, tx))
+	// The nodes are content addressed, so they are kept
+	node, err := s.Get(ctx, key, nil)
+	require.NoError(t, err)
+	require.Equal(t, value, node)
+}
+
+func TestBridgeStats(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	token := common.HexToAddress("0x1")
+	l1BlockID := addBlock(t, s, 0, 1, day.Add(time.Hour+time.Minute))
+	l2BlockID := addBlock(t, s, 1, 1, day.Add(2*time.Hour))
+	for i := uint(0); i < 2; i++ {
+		_, err := s.AddDeposit(ctx, &etherman.Deposit{BlockID: l1BlockID, DepositCount: i, OriginalAddress: token, DestinationNetwork: 1, Amount: big.NewInt(10)}, nil)
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.AddClaim(ctx, &etherman.Claim{BlockID: l2BlockID, NetworkID: 1, MainnetFlag: true, OriginalAddress: token, Amount: big.NewInt(10)}, nil))
+
+	stats, err := s.GetBridgeStats(ctx, "hour", day, day.Add(24*time.Hour), nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, day.Add(time.Hour), stats[0].Bucket)
+	require.Equal(t, uint64(2), stats[0].DepositCount)
+	require.Equal(t, big.NewInt(20), stats[0].DepositAmount)
+	require.Equal(t, uint64(1), stats[1].ClaimCount)
+	require.Equal(t, uint(0), stats[1].FromNetwork)
+
+	stats, err = s.GetBridgeStats(ctx, "day", day, day.Add(24*time.Hour), nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	require.Equal(t, day, stats[0].Bucket)
+	_, err = s.GetBridgeStats(ctx, "week", day, day.Add(24*time.Hour), nil, nil, nil)
+	require.Error(t, err)
+
+	unclaimed, err := s.GetUnclaimedStats(ctx, nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, unclaimed, 1)
+	require.Equal(t, uint64(1), unclaimed[0].Count)
+	require.Equal(t, big.NewInt(10), unclaimed[0].Amount)
+
+	balances, err := s.GetTokenBalances(ctx, 1, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	require.Equal(t, big.NewInt(10), balances[0].Claimed)
+}
+
+func TestWebhookEvents(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStorage()
+	events := []*etherman.WebhookEvent{{Type: "deposit.indexed"}, {Type: "claim_tx.sent"}}
+	require.NoError(t, s.AddWebhookEvents(ctx, events, nil))
+	require.Equal(t, uint64(1), events[0].ID)
+
+	pending, err := s.GetPendingWebhookEvents(ctx, time.Now(), 10, nil)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+
+	pending[0].Attempts = 1
+	pending[0].NextAttemptAt = time.Now().Add(time.Hour)
+	require.NoError(t, s.UpdateWebhookEvent(ctx, pending[0], nil))
+	require.NoError(t, s.DeleteWebhookEvent(ctx, pending[1].ID, nil))
+	pending, err = s.GetPendingWebhookEvents(ctx, time.Now(), 10, nil)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
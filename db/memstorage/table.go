@@ -0,0 +1,279 @@
+package memstorage
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+// errNotSupported is returned by the methods of pgx.Tx that the in-memory storage doesn't implement
+var errNotSupported = errors.New("not supported by the memory storage")
+
+// memTx is a db transaction of the in-memory storage. It holds the transaction lock of the storage until
+// it's committed or rolled back, so the changes, that are applied right away and undone if the
+// transaction is rolled back, aren't visible to the other readers and writers before the commit.
+// Only Commit and Rollback are implemented, the rest of the methods of pgx.Tx return errNotSupported.
+type memTx struct {
+	storage *MemoryStorage
+	undo    []func()
+	closed  bool
+}
+
+// Begin starts a pseudo nested transaction, which isn't supported
+func (tx *memTx) Begin(ctx context.Context) (pgx.Tx, error) {
+	return nil, errNotSupported
+}
+
+// BeginFunc starts a pseudo nested transaction, which isn't supported
+func (tx *memTx) BeginFunc(ctx context.Context, f func(pgx.Tx) error) error {
+	return errNotSupported
+}
+
+// Commit commits the db transaction
+func (tx *memTx) Commit(ctx context.Context) error {
+	if err := tx.close(); err != nil {
+		return err
+	}
+	tx.undo = nil
+	tx.storage.txLock.Unlock()
+	return nil
+}
+
+// Rollback undoes the changes of the db transaction
+func (tx *memTx) Rollback(ctx context.Context) error {
+	if err := tx.close(); err != nil {
+		return err
+	}
+	tx.rollback()
+	tx.storage.txLock.Unlock()
+	return nil
+}
+
+func (tx *memTx) close() error {
+	tx.storage.lock.Lock()
+	defer tx.storage.lock.Unlock()
+	if tx.closed {
+		return pgx.ErrTxClosed
+	}
+	tx.closed = true
+	return nil
+}
+
+// CopyFrom isn't supported
+func (tx *memTx) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return 0, errNotSupported
+}
+
+// SendBatch isn't supported, the results of the batch fail with errNotSupported
+func (tx *memTx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	return errBatchResults{}
+}
+
+// LargeObjects isn't supported, the large objects have no transaction
+func (tx *memTx) LargeObjects() pgx.LargeObjects {
+	return pgx.LargeObjects{}
+}
+
+// Prepare isn't supported
+func (tx *memTx) Prepare(ctx context.Context, name, sql string) (*pgconn.StatementDescription, error) {
+	return nil, errNotSupported
+}
+
+// Exec isn't supported
+func (tx *memTx) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	return nil, errNotSupported
+}
+
+// Query isn't supported
+func (tx *memTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return nil, errNotSupported
+}
+
+// QueryRow isn't supported, the row fails with errNotSupported when it's scanned
+func (tx *memTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return errRow{}
+}
+
+// QueryFunc isn't supported
+func (tx *memTx) QueryFunc(ctx context.Context, sql string, args []interface{}, scans []interface{}, f func(pgx.QueryFuncRow) error) (pgconn.CommandTag, error) {
+	return nil, errNotSupported
+}
+
+// Conn returns nil, as the transaction doesn't belong to any connection
+func (tx *memTx) Conn() *pgx.Conn {
+	return nil
+}
+
+type errRow struct{}
+
+func (errRow) Scan(dest ...interface{}) error {
+	return errNotSupported
+}
+
+type errBatchResults struct{}
+
+func (errBatchResults) Exec() (pgconn.CommandTag, error) {
+	return nil, errNotSupported
+}
+
+func (errBatchResults) Query() (pgx.Rows, error) {
+	return nil, errNotSupported
+}
+
+func (errBatchResults) QueryRow() pgx.Row {
+	return errRow{}
+}
+
+func (errBatchResults) QueryFunc(scans []interface{}, f func(pgx.QueryFuncRow) error) (pgconn.CommandTag, error) {
+	return nil, errNotSupported
+}
+
+func (errBatchResults) Close() error {
+	return nil
+}
+
+func (tx *memTx) rollback() {
+	for i := len(tx.undo) - 1; i >= 0; i-- {
+		tx.undo[i]()
+	}
+	tx.undo = nil
+}
+
+func (tx *memTx) onRollback(fn func()) {
+	tx.undo = append(tx.undo, fn)
+}
+
+// update changes a row in place, restoring it if the db transaction is rolled back
+func update[T any](tx *memTx, row *T, fn func(row *T)) {
+	old := *row
+	fn(row)
+	tx.onRollback(func() { *row = old })
+}
+
+type record[T any] struct {
+	id  uint64
+	row *T
+}
+
+// table keeps the rows in the order of their ids, that are assigned like a sequence. The rows of a table
+// with a key are unique by it, like the rows of a Postgres table with a primary key.
+type table[T any] struct {
+	lastID  uint64
+	records []record[T]
+	byID    map[uint64]*T
+	key     func(row *T) any
+	byKey   map[any]*T
+}
+
+func newTable[T any]() *table[T] {
+	return &table[T]{byID: make(map[uint64]*T)}
+}
+
+func newKeyedTable[T any](key func(row *T) any) *table[T] {
+	return &table[T]{byID: make(map[uint64]*T), key: key, byKey: make(map[any]*T)}
+}
+
+func (t *table[T]) insert(tx *memTx, row *T) uint64 {
+	t.lastID++
+	id := t.lastID
+	t.records = append(t.records, record[T]{id: id, row: row})
+	t.byID[id] = row
+	if t.key != nil {
+		t.byKey[t.key(row)] = row
+	}
+	tx.onRollback(func() {
+		t.remove(func(rid uint64, _ *T) bool { return rid == id })
+	})
+	return id
+}
+
+// insertUnique inserts the row if there isn't another one with the same key
+func (t *table[T]) insertUnique(tx *memTx, row *T) (uint64, error) {
+	if _, exists := t.byKey[t.key(row)]; exists {
+		return 0, errDuplicateKey
+	}
+	return t.insert(tx, row), nil
+}
+
+func (t *table[T]) get(id uint64) (*T, bool) {
+	row, ok := t.byID[id]
+	return row, ok
+}
+
+// find gets the row with the key
+func (t *table[T]) find(key any) (*T, bool) {
+	row, ok := t.byKey[key]
+	return row, ok
+}
+
+// delete removes the rows that match and returns their ids
+func (t *table[T]) delete(tx *memTx, match func(id uint64, row *T) bool) map[uint64]struct{} {
+	removed := t.remove(match)
+	ids := make(map[uint64]struct{}, len(removed))
+	for _, r := range removed {
+		ids[r.id] = struct{}{}
+	}
+	if len(removed) > 0 {
+		tx.onRollback(func() { t.restore(removed) })
+	}
+	return ids
+}
+
+func (t *table[T]) remove(match func(id uint64, row *T) bool) []record[T] {
+	var removed []record[T]
+	kept := t.records[:0]
+	for _, r := range t.records {
+		if match(r.id, r.row) {
+			removed = append(removed, r)
+			delete(t.byID, r.id)
+			if t.key != nil {
+				delete(t.byKey, t.key(r.row))
+			}
+			continue
+		}
+		kept = append(kept, r)
+	}
+	// Clear the tail, so the removed rows can be garbage collected
+	for i := len(kept); i < len(t.records); i++ {
+		t.records[i] = record[T]{}
+	}
+	t.records = kept
+	return removed
+}
+
+func (t *table[T]) restore(records []record[T]) {
+	for _, r := range records {
+		t.byID[r.id] = r.row
+		if t.key != nil {
+			t.byKey[t.key(r.row)] = r.row
+		}
+	}
+	t.records = append(t.records, records...)
+	sort.Slice(t.records, func(i, j int) bool { return t.records[i].id < t.records[j].id })
+}
+
+// each calls fn for each row in the order of their ids until it returns false
+func (t *table[T]) each(fn func(id uint64, row *T) bool) {
+	for _, r := range t.records {
+		if !fn(r.id, r.row) {
+			return
+		}
+	}
+}
+
+// last returns the row with the highest id that matches
+func (t *table[T]) last(match func(id uint64, row *T) bool) (uint64, *T, bool) {
+	for i := len(t.records) - 1; i >= 0; i-- {
+		if r := t.records[i]; match(r.id, r.row) {
+			return r.id, r.row, true
+		}
+	}
+	return 0, nil, false
+}
+
+func (t *table[T]) len() int {
+	return len(t.records)
+}
@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Prefix for the metrics of the db package.
+	Prefix = "bridge_db_"
+
+	// ReplicaLabelName is the name of the label that identifies the read replica.
+	ReplicaLabelName = "replica"
+
+	// TargetLabelName is the name of the label that identifies the database that served a read.
+	TargetLabelName = "target"
+
+	// PrimaryTarget is the value of the target label for the reads served by the primary database.
+	PrimaryTarget = "primary"
+
+	// ReplicaLagName is the name of the metric that observes the replication lag of the read replicas.
+	ReplicaLagName = Prefix + "replica_lag_seconds"
+
+	// ReplicaCheckErrorsName is the name of the metric that counts the failed lag checks of the read replicas.
+	ReplicaCheckErrorsName = Prefix + "replica_check_errors"
+
+	// ReadsName is the name of the metric that counts the reads routed to the primary database and to each read replica.
+	ReadsName = Prefix + "reads"
+)
+
+// Register the metrics for the db package.
+func Register() {
+	counterVecs := []metrics.CounterVecOpts{
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: ReplicaCheckErrorsName,
+				Help: "[DB] number of failed lag checks of the read replicas",
+			},
+			Labels: []string{ReplicaLabelName},
+		},
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: ReadsName,
+				Help: "[DB] number of reads routed to the primary database and to each read replica",
+			},
+			Labels: []string{TargetLabelName},
+		},
+	}
+	histogramVecs := []metrics.HistogramVecOpts{
+		{
+			HistogramOpts: prometheus.HistogramOpts{
+				Name:    ReplicaLagName,
+				Help:    "[DB] replication lag in seconds of the read replicas",
+				Buckets: prometheus.ExponentialBuckets(0.01, 2, 14), //nolint:gomnd
+			},
+			Labels: []string{ReplicaLabelName},
+		},
+	}
+
+	metrics.RegisterCounterVecs(counterVecs...)
+	metrics.RegisterHistogramVecs(histogramVecs...)
+}
+
+// ReplicaLag observes the replication lag of a read replica.
+func ReplicaLag(replica string, seconds float64) {
+	metrics.HistogramVecObserve(ReplicaLagName, replica, seconds)
+}
+
+// ReplicaCheckError counts a failed lag check of a read replica.
+func ReplicaCheckError(replica string) {
+	metrics.CounterVecInc(ReplicaCheckErrorsName, replica)
+}
+
+// Read counts a read routed to the target database.
+func Read(target string) {
+	metrics.CounterVecInc(ReadsName, target)
+}
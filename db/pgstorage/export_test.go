@@ -0,0 +1,6 @@
+package pgstorage
+
+// ConnString exposes the connection URL of the config to the external tests
+func (cfg Config) ConnString() (string, error) {
+	return cfg.connString("", "", true)
+}
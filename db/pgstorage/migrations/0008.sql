@@ -0,0 +1,61 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS sync.reorg
+(
+    id             BIGSERIAL PRIMARY KEY,
+    network_id     INTEGER NOT NULL,
+    fork_block_num BIGINT NOT NULL,
+    depth          BIGINT NOT NULL,
+    old_block_hash BYTEA,
+    new_block_hash BYTEA,
+    detected_at    TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sync.reorg_deposit
+(
+    reorg_id    BIGINT NOT NULL REFERENCES sync.reorg (id) ON DELETE CASCADE,
+    leaf_type   INTEGER,
+    network_id  INTEGER,
+    orig_net    INTEGER,
+    orig_addr   BYTEA NOT NULL,
+    amount      VARCHAR,
+    dest_net    INTEGER NOT NULL,
+    dest_addr   BYTEA NOT NULL,
+    block_num   BIGINT,
+    deposit_cnt BIGINT,
+    tx_hash     BYTEA NOT NULL,
+    metadata    BYTEA NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sync.reorg_claim
+(
+    reorg_id     BIGINT NOT NULL REFERENCES sync.reorg (id) ON DELETE CASCADE,
+    network_id   INTEGER NOT NULL,
+    index        BIGINT,
+    orig_net     INTEGER,
+    orig_addr    BYTEA NOT NULL,
+    amount       VARCHAR,
+    dest_addr    BYTEA NOT NULL,
+    block_num    BIGINT,
+    tx_hash      BYTEA NOT NULL,
+    rollup_index BIGINT DEFAULT 0,
+    mainnet_flag BOOLEAN DEFAULT FALSE
+);
+
+CREATE TABLE IF NOT EXISTS sync.reorg_exit_root
+(
+    reorg_id         BIGINT NOT NULL REFERENCES sync.reorg (id) ON DELETE CASCADE,
+    block_num        BIGINT,
+    global_exit_root BYTEA,
+    exit_roots       BYTEA[]
+);
+
+CREATE INDEX IF NOT EXISTS reorg_network_id ON sync.reorg USING btree (network_id);
+CREATE INDEX IF NOT EXISTS reorg_deposit_reorg_id ON sync.reorg_deposit USING btree (reorg_id);
+CREATE INDEX IF NOT EXISTS reorg_claim_reorg_id ON sync.reorg_claim USING btree (reorg_id);
+CREATE INDEX IF NOT EXISTS reorg_exit_root_reorg_id ON sync.reorg_exit_root USING btree (reorg_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS sync.reorg_exit_root;
+DROP TABLE IF EXISTS sync.reorg_claim;
+DROP TABLE IF EXISTS sync.reorg_deposit;
+DROP TABLE IF EXISTS sync.reorg;
@@ -0,0 +1,60 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This migration creates the reorg journal tables.
+
+type migrationTest0008 struct{}
+
+func (m migrationTest0008) InsertData(db *sql.DB) error {
+	block := "INSERT INTO sync.block (id, block_num, block_hash, parent_hash, network_id, received_at) VALUES(2, 2803824, decode('27474F16174BBE50C294FE13C190B92E42B2368A6D4AEB8A4A015F52816296C3','hex'), decode('C9B5033799ADF3739383A0489EFBE8A0D4D5E4478778A4F4304562FD51AE4C07','hex'), 1, '0001-01-01 01:00:00.000');"
+	if _, err := db.Exec(block); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m migrationTest0008) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	insertReorg := "INSERT INTO sync.reorg (id, network_id, fork_block_num, depth, old_block_hash, new_block_hash, detected_at) VALUES(1, 1, 2803823, 1, decode('27474F16174BBE50C294FE13C190B92E42B2368A6D4AEB8A4A015F52816296C3','hex'), decode('27474F16174BBE50C294FE13C190B92E42B2368A6D4AEB8A4A015F52816296C4','hex'), '0001-01-01 01:00:00.000');"
+	_, err := db.Exec(insertReorg)
+	assert.NoError(t, err)
+	insertDeposit := "INSERT INTO sync.reorg_deposit (reorg_id, leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_num, deposit_cnt, tx_hash, metadata) VALUES(1, 0, 1, 0, decode('0000000000000000000000000000000000000000','hex'), '10000000000000000000', 0, decode('C949254D682D8C9AD5682521675B8F43B102AEC4','hex'), 2803824, 0, decode('C2D6575EA98EB55E36B5AC6E11196800362594458A4B3143DB50E4995CB2422E','hex'), decode('','hex'));"
+	_, err = db.Exec(insertDeposit)
+	assert.NoError(t, err)
+	insertClaim := "INSERT INTO sync.reorg_claim (reorg_id, network_id, index, orig_net, orig_addr, amount, dest_addr, block_num, tx_hash, rollup_index, mainnet_flag) VALUES(1, 1, 3, 0, decode('0000000000000000000000000000000000000000','hex'), '300000000000000000', decode('14567C0DCF79C20FE1A21E36EC975D1775A1905C','hex'), 2803824, decode('A9505DB7D7EDD08947F12F2B1F7898148FFB43D80BCB977B78161EF14173D575','hex'), 0, true);"
+	_, err = db.Exec(insertClaim)
+	assert.NoError(t, err)
+	insertExitRoot := "INSERT INTO sync.reorg_exit_root (reorg_id, block_num, global_exit_root, exit_roots) VALUES(1, 2803824, decode('16C571C7A60CF3694BA81AFF143E8A8C9A393D351213DBFD4D539F39F1C4648C','hex'), ARRAY[decode('16C571C7A60CF3694BA81AFF143E8A8C9A393D351213DBFD4D539F39F1C4648C','hex'), decode('16C571C7A60CF3694BA81AFF143E8A8C9A393D351213DBFD4D539F39F1C4648D','hex')]);"
+	_, err = db.Exec(insertExitRoot)
+	assert.NoError(t, err)
+
+	// Removing the reorged block must not remove the journal
+	_, err = db.Exec("DELETE FROM sync.block WHERE id = 2;")
+	assert.NoError(t, err)
+	var count int
+	err = db.QueryRow("SELECT count(*) FROM sync.reorg_deposit WHERE reorg_id = 1;").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Removing the reorg entry cascades to the removed items
+	_, err = db.Exec("DELETE FROM sync.reorg WHERE id = 1;")
+	assert.NoError(t, err)
+	err = db.QueryRow("SELECT count(*) FROM sync.reorg_claim WHERE reorg_id = 1;").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func (m migrationTest0008) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	insertReorg := "INSERT INTO sync.reorg (id, network_id, fork_block_num, depth, detected_at) VALUES(2, 1, 2803823, 1, '0001-01-01 01:00:00.000');"
+	_, err := db.Exec(insertReorg)
+	assert.Error(t, err)
+}
+
+func TestMigration0008(t *testing.T) {
+	runMigrationTest(t, 8, migrationTest0008{})
+}
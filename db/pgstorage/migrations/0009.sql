@@ -0,0 +1,38 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS sync.pending_state_change
+(
+    id                BIGSERIAL PRIMARY KEY,
+    block_id          BIGINT NOT NULL REFERENCES sync.block (id) ON DELETE CASCADE,
+    event             VARCHAR NOT NULL,
+    rollup_id         BIGINT NOT NULL,
+    batch_num         BIGINT NOT NULL,
+    state_root        BYTEA NOT NULL,
+    exit_root         BYTEA NOT NULL,
+    aggregator        BYTEA,
+    pending_state_num BIGINT,
+    tx_hash           BYTEA NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sync.emergency_state
+(
+    id         BIGSERIAL PRIMARY KEY,
+    block_id   BIGINT NOT NULL REFERENCES sync.block (id) ON DELETE CASCADE,
+    network_id INTEGER NOT NULL,
+    address    BYTEA NOT NULL,
+    activated  BOOLEAN NOT NULL,
+    tx_hash    BYTEA NOT NULL
+);
+
+-- The rollup exit roots that include an overridden pending state are invalidated. If the override is reorged, they are valid again.
+ALTER TABLE mt.rollup_exit
+ADD COLUMN IF NOT EXISTS invalidated_by BIGINT REFERENCES sync.pending_state_change (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS pending_state_change_rollup_id ON sync.pending_state_change USING btree (rollup_id);
+CREATE INDEX IF NOT EXISTS emergency_state_network_id ON sync.emergency_state USING btree (network_id);
+
+-- +migrate Down
+ALTER TABLE mt.rollup_exit
+DROP COLUMN IF EXISTS invalidated_by;
+
+DROP TABLE IF EXISTS sync.emergency_state;
+DROP TABLE IF EXISTS sync.pending_state_change;
@@ -0,0 +1,63 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This migration creates the tables for the pending state changes and the emergency state,
+// and adds the invalidated_by column to the rollup exit leaves.
+
+type migrationTest0009 struct{}
+
+func (m migrationTest0009) InsertData(db *sql.DB) error {
+	block := "INSERT INTO sync.block (id, block_num, block_hash, parent_hash, network_id, received_at) VALUES(2, 2803824, decode('27474F16174BBE50C294FE13C190B92E42B2368A6D4AEB8A4A015F52816296C3','hex'), decode('C9B5033799ADF3739383A0489EFBE8A0D4D5E4478778A4F4304562FD51AE4C07','hex'), 0, '0001-01-01 01:00:00.000');"
+	if _, err := db.Exec(block); err != nil {
+		return err
+	}
+	rollupExit := "INSERT INTO mt.rollup_exit (id, leaf, rollup_id, root, block_id) VALUES(1, decode('A4BFA0908DC7B06D98DA4309F859023D6947561BC19BC00D77F763DEA1A0B9F5','hex'), 1, decode('42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25721','hex'), 2);"
+	if _, err := db.Exec(rollupExit); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m migrationTest0009) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	insertPendingState := "INSERT INTO sync.pending_state_change (id, block_id, event, rollup_id, batch_num, state_root, exit_root, aggregator, pending_state_num, tx_hash) VALUES(1, 2, 'overridePendingState', 1, 10, decode('16C571C7A60CF3694BA81AFF143E8A8C9A393D351213DBFD4D539F39F1C4648C','hex'), decode('16C571C7A60CF3694BA81AFF143E8A8C9A393D351213DBFD4D539F39F1C4648D','hex'), decode('14567C0DCF79C20FE1A21E36EC975D1775A1905C','hex'), 0, decode('A9505DB7D7EDD08947F12F2B1F7898148FFB43D80BCB977B78161EF14173D575','hex'));"
+	_, err := db.Exec(insertPendingState)
+	assert.NoError(t, err)
+	insertEmergencyState := "INSERT INTO sync.emergency_state (block_id, network_id, address, activated, tx_hash) VALUES(2, 0, decode('14567C0DCF79C20FE1A21E36EC975D1775A1905C','hex'), true, decode('A9505DB7D7EDD08947F12F2B1F7898148FFB43D80BCB977B78161EF14173D575','hex'));"
+	_, err = db.Exec(insertEmergencyState)
+	assert.NoError(t, err)
+	_, err = db.Exec("UPDATE mt.rollup_exit SET invalidated_by = 1 WHERE id = 1;")
+	assert.NoError(t, err)
+
+	// Removing the override makes the leaf valid again
+	_, err = db.Exec("DELETE FROM sync.pending_state_change WHERE id = 1;")
+	assert.NoError(t, err)
+	var count int
+	err = db.QueryRow("SELECT count(*) FROM mt.rollup_exit WHERE invalidated_by IS NULL;").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Removing the block cascades to the emergency state
+	_, err = db.Exec("DELETE FROM sync.block WHERE id = 2;")
+	assert.NoError(t, err)
+	err = db.QueryRow("SELECT count(*) FROM sync.emergency_state;").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func (m migrationTest0009) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	insertEmergencyState := "INSERT INTO sync.emergency_state (block_id, network_id, address, activated, tx_hash) VALUES(2, 0, decode('14567C0DCF79C20FE1A21E36EC975D1775A1905C','hex'), true, decode('A9505DB7D7EDD08947F12F2B1F7898148FFB43D80BCB977B78161EF14173D575','hex'));"
+	_, err := db.Exec(insertEmergencyState)
+	assert.Error(t, err)
+	_, err = db.Exec("UPDATE mt.rollup_exit SET invalidated_by = NULL;")
+	assert.Error(t, err)
+}
+
+func TestMigration0009(t *testing.T) {
+	runMigrationTest(t, 9, migrationTest0009{})
+}
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS sync.rollup
+(
+    rollup_id               BIGINT PRIMARY KEY,
+    block_id                BIGINT NOT NULL REFERENCES sync.block (id) ON DELETE CASCADE,
+    chain_id                BIGINT NOT NULL,
+    rollup_address          BYTEA NOT NULL,
+    gas_token_address       BYTEA NOT NULL,
+    rollup_type_id          BIGINT NOT NULL,
+    fork_id                 BIGINT NOT NULL,
+    rollup_compatibility_id SMALLINT NOT NULL,
+    tx_hash                 BYTEA NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS sync.rollup;
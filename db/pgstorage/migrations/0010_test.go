@@ -0,0 +1,46 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This migration creates the table for the rollups registered in the rollup manager.
+
+type migrationTest0010 struct{}
+
+func (m migrationTest0010) InsertData(db *sql.DB) error {
+	block := "INSERT INTO sync.block (id, block_num, block_hash, parent_hash, network_id, received_at) VALUES(2, 2803824, decode('27474F16174BBE50C294FE13C190B92E42B2368A6D4AEB8A4A015F52816296C3','hex'), decode('C9B5033799ADF3739383A0489EFBE8A0D4D5E4478778A4F4304562FD51AE4C07','hex'), 0, '0001-01-01 01:00:00.000');"
+	if _, err := db.Exec(block); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m migrationTest0010) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	insertRollup := "INSERT INTO sync.rollup (rollup_id, block_id, chain_id, rollup_address, gas_token_address, rollup_type_id, fork_id, rollup_compatibility_id, tx_hash) VALUES(1, 2, 1101, decode('14567C0DCF79C20FE1A21E36EC975D1775A1905C','hex'), decode('0000000000000000000000000000000000000000','hex'), 1, 0, 0, decode('A9505DB7D7EDD08947F12F2B1F7898148FFB43D80BCB977B78161EF14173D575','hex'));"
+	_, err := db.Exec(insertRollup)
+	assert.NoError(t, err)
+	// A rollup is registered only once
+	_, err = db.Exec(insertRollup)
+	assert.Error(t, err)
+
+	// Removing the block cascades to the rollup
+	_, err = db.Exec("DELETE FROM sync.block WHERE id = 2;")
+	assert.NoError(t, err)
+	var count int
+	err = db.QueryRow("SELECT count(*) FROM sync.rollup;").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func (m migrationTest0010) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	_, err := db.Exec("SELECT count(*) FROM sync.rollup;")
+	assert.Error(t, err)
+}
+
+func TestMigration0010(t *testing.T) {
+	runMigrationTest(t, 10, migrationTest0010{})
+}
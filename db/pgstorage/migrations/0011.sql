@@ -0,0 +1,32 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS sync.bridge_stats
+(
+    bucket         TIMESTAMP NOT NULL, -- UTC hour
+    orig_net       INTEGER NOT NULL,
+    orig_addr      BYTEA NOT NULL,
+    from_net       INTEGER NOT NULL,
+    to_net         INTEGER NOT NULL,
+    deposit_cnt    BIGINT NOT NULL DEFAULT 0,
+    deposit_amount NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    claim_cnt      BIGINT NOT NULL DEFAULT 0,
+    claim_amount   NUMERIC(78, 0) NOT NULL DEFAULT 0,
+    PRIMARY KEY (bucket, orig_net, orig_addr, from_net, to_net)
+);
+
+-- The claims come from the network of the deposit, that is 0 for mainnet and rollup_index + 1 for the rollups.
+-- The claims of the old bridge don't have a global index, so the ones in a rollup come from mainnet
+INSERT INTO sync.bridge_stats (bucket, orig_net, orig_addr, from_net, to_net, deposit_cnt, deposit_amount, claim_cnt, claim_amount)
+SELECT bucket, orig_net, orig_addr, from_net, to_net, SUM(deposit_cnt), SUM(deposit_amount), SUM(claim_cnt), SUM(claim_amount)
+FROM (
+    SELECT date_trunc('hour', b.received_at AT TIME ZONE 'UTC') AS bucket, d.orig_net, d.orig_addr, d.network_id AS from_net, d.dest_net AS to_net,
+        1 AS deposit_cnt, d.amount::NUMERIC AS deposit_amount, 0 AS claim_cnt, 0 AS claim_amount
+    FROM sync.deposit AS d INNER JOIN sync.block AS b ON d.block_id = b.id
+    UNION ALL
+    SELECT date_trunc('hour', b.received_at AT TIME ZONE 'UTC'), c.orig_net, c.orig_addr, CASE WHEN c.mainnet_flag OR c.rollup_index + 1 = c.network_id THEN 0 ELSE c.rollup_index + 1 END, c.network_id,
+        0, 0, 1, c.amount::NUMERIC
+    FROM sync.claim AS c INNER JOIN sync.block AS b ON c.block_id = b.id
+) AS events
+GROUP BY bucket, orig_net, orig_addr, from_net, to_net;
+
+-- +migrate Down
+DROP TABLE IF EXISTS sync.bridge_stats;
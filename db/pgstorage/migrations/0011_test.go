@@ -0,0 +1,62 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This migration creates the table for the hourly bridge volume statistics and fills it with the existing deposits and claims.
+
+type migrationTest0011 struct{}
+
+func (m migrationTest0011) InsertData(db *sql.DB) error {
+	block := "INSERT INTO sync.block (id, block_num, block_hash, parent_hash, network_id, received_at) VALUES(2, 2803824, decode('27474F16174BBE50C294FE13C190B92E42B2368A6D4AEB8A4A015F52816296C3','hex'), decode('C9B5033799ADF3739383A0489EFBE8A0D4D5E4478778A4F4304562FD51AE4C07','hex'), 0, '2023-10-01 10:20:00+00');"
+	if _, err := db.Exec(block); err != nil {
+		return err
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := db.Exec("INSERT INTO sync.deposit (leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+			0, 0, 0, []byte{}, "100000000000000000000", 1, []byte{}, 2, i, []byte{}, []byte{}); err != nil {
+			return err
+		}
+	}
+	claim := "INSERT INTO sync.claim (network_id, index, orig_net, orig_addr, amount, dest_addr, block_id, tx_hash, rollup_index, mainnet_flag) VALUES(0, 1, 0, decode('','hex'), '5', decode('','hex'), 2, decode('','hex'), 0, false);"
+	if _, err := db.Exec(claim); err != nil {
+		return err
+	}
+	// A claim of the old bridge in the rollup 1, that comes from mainnet although the mainnet flag is not set
+	oldBridgeClaim := "INSERT INTO sync.claim (network_id, index, orig_net, orig_addr, amount, dest_addr, block_id, tx_hash, rollup_index, mainnet_flag) VALUES(1, 0, 0, decode('','hex'), '7', decode('','hex'), 2, decode('','hex'), 0, false);"
+	if _, err := db.Exec(oldBridgeClaim); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m migrationTest0011) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	var depositCnt, claimCnt int
+	var depositAmount, claimAmount string
+	const getStatsSQL = "SELECT deposit_cnt, deposit_amount::TEXT, claim_cnt, claim_amount::TEXT FROM sync.bridge_stats WHERE bucket = '2023-10-01 10:00:00' AND from_net = $1 AND to_net = $2;"
+	err := db.QueryRow(getStatsSQL, 0, 1).Scan(&depositCnt, &depositAmount, &claimCnt, &claimAmount)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, depositCnt)
+	assert.Equal(t, "200000000000000000000", depositAmount)
+	assert.Equal(t, 1, claimCnt)
+	assert.Equal(t, "7", claimAmount)
+	// The claim of the deposit from the rollup 1
+	err = db.QueryRow(getStatsSQL, 1, 0).Scan(&depositCnt, &depositAmount, &claimCnt, &claimAmount)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, depositCnt)
+	assert.Equal(t, 1, claimCnt)
+	assert.Equal(t, "5", claimAmount)
+}
+
+func (m migrationTest0011) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	_, err := db.Exec("SELECT count(*) FROM sync.bridge_stats;")
+	assert.Error(t, err)
+}
+
+func TestMigration0011(t *testing.T) {
+	runMigrationTest(t, 11, migrationTest0011{})
+}
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS sync.webhook_event
+(
+    id              BIGSERIAL PRIMARY KEY,
+    event_type      VARCHAR NOT NULL,
+    payload         JSONB NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_error      VARCHAR
+);
+
+CREATE INDEX IF NOT EXISTS webhook_event_next_attempt_at ON sync.webhook_event USING btree (next_attempt_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS sync.webhook_event;
@@ -0,0 +1,34 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This migration creates the outbox of the webhook events.
+
+type migrationTest0012 struct{}
+
+func (m migrationTest0012) InsertData(db *sql.DB) error {
+	return nil
+}
+
+func (m migrationTest0012) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	const addEventSQL = "INSERT INTO sync.webhook_event (event_type, payload, created_at, next_attempt_at) VALUES ($1, $2, NOW(), NOW()) RETURNING id, attempts;"
+	var id, attempts int
+	err := db.QueryRow(addEventSQL, "deposit.indexed", `{"deposit_cnt": 1}`).Scan(&id, &attempts)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, id)
+	assert.Equal(t, 0, attempts)
+}
+
+func (m migrationTest0012) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	_, err := db.Exec("SELECT count(*) FROM sync.webhook_event;")
+	assert.Error(t, err)
+}
+
+func TestMigration0012(t *testing.T) {
+	runMigrationTest(t, 12, migrationTest0012{})
+}
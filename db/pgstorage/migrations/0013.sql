@@ -0,0 +1,9 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS mt.prune_state
+(
+    network     BIGINT PRIMARY KEY,
+    deposit_cnt BIGINT NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS mt.prune_state;
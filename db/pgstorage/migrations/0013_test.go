@@ -0,0 +1,37 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This migration creates the progress of the pruning of the merkle trees.
+
+type migrationTest0013 struct{}
+
+func (m migrationTest0013) InsertData(db *sql.DB) error {
+	return nil
+}
+
+func (m migrationTest0013) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	const setPrunedSQL = "INSERT INTO mt.prune_state (network, deposit_cnt) VALUES ($1, $2) ON CONFLICT (network) DO UPDATE SET deposit_cnt = EXCLUDED.deposit_cnt;"
+	_, err := db.Exec(setPrunedSQL, 0, 10)
+	assert.NoError(t, err)
+	_, err = db.Exec(setPrunedSQL, 0, 20)
+	assert.NoError(t, err)
+	var depositCnt int
+	err = db.QueryRow("SELECT deposit_cnt FROM mt.prune_state WHERE network = 0;").Scan(&depositCnt)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, depositCnt)
+}
+
+func (m migrationTest0013) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	_, err := db.Exec("SELECT count(*) FROM mt.prune_state;")
+	assert.Error(t, err)
+}
+
+func TestMigration0013(t *testing.T) {
+	runMigrationTest(t, 13, migrationTest0013{})
+}
@@ -0,0 +1,5 @@
+-- +migrate Up
+CREATE INDEX IF NOT EXISTS deposit_dest_addr_ready_for_claim ON sync.deposit USING btree (dest_addr) WHERE ready_for_claim;
+
+-- +migrate Down
+DROP INDEX IF EXISTS sync.deposit_dest_addr_ready_for_claim;
@@ -0,0 +1,34 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This migration creates the index of the deposits ready for claim by destination address.
+
+type migrationTest0014 struct{}
+
+func (m migrationTest0014) InsertData(db *sql.DB) error {
+	return nil
+}
+
+func (m migrationTest0014) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	const getIndex = "SELECT count(*) FROM pg_indexes WHERE schemaname = 'sync' AND indexname = 'deposit_dest_addr_ready_for_claim';"
+	var count int
+	assert.NoError(t, db.QueryRow(getIndex).Scan(&count))
+	assert.Equal(t, 1, count)
+}
+
+func (m migrationTest0014) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	const getIndex = "SELECT count(*) FROM pg_indexes WHERE schemaname = 'sync' AND indexname = 'deposit_dest_addr_ready_for_claim';"
+	var count int
+	assert.NoError(t, db.QueryRow(getIndex).Scan(&count))
+	assert.Equal(t, 0, count)
+}
+
+func TestMigration0014(t *testing.T) {
+	runMigrationTest(t, 14, migrationTest0014{})
+}
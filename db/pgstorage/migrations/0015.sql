@@ -0,0 +1,25 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS sync.rollup_update
+(
+    id                  BIGSERIAL PRIMARY KEY,
+    block_id            BIGINT NOT NULL REFERENCES sync.block (id) ON DELETE CASCADE,
+    rollup_id           BIGINT NOT NULL,
+    rollup_type_id      BIGINT NOT NULL,
+    last_verified_batch BIGINT NOT NULL,
+    tx_hash             BYTEA NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS sync.obsolete_rollup_type
+(
+    id             BIGSERIAL PRIMARY KEY,
+    block_id       BIGINT NOT NULL REFERENCES sync.block (id) ON DELETE CASCADE,
+    rollup_type_id BIGINT NOT NULL,
+    tx_hash        BYTEA NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS rollup_update_rollup_id ON sync.rollup_update USING btree (rollup_id);
+CREATE INDEX IF NOT EXISTS obsolete_rollup_type_rollup_type_id ON sync.obsolete_rollup_type USING btree (rollup_type_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS sync.obsolete_rollup_type;
+DROP TABLE IF EXISTS sync.rollup_update;
@@ -0,0 +1,48 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This migration creates the tables for the upgrades of the rollups and the obsolete rollup types.
+
+type migrationTest0015 struct{}
+
+func (m migrationTest0015) InsertData(db *sql.DB) error {
+	block := "INSERT INTO sync.block (id, block_num, block_hash, parent_hash, network_id, received_at) VALUES(2, 2803824, decode('27474F16174BBE50C294FE13C190B92E42B2368A6D4AEB8A4A015F52816296C3','hex'), decode('C9B5033799ADF3739383A0489EFBE8A0D4D5E4478778A4F4304562FD51AE4C07','hex'), 0, '0001-01-01 01:00:00.000');"
+	if _, err := db.Exec(block); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m migrationTest0015) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	const insertUpdate = "INSERT INTO sync.rollup_update (block_id, rollup_id, rollup_type_id, last_verified_batch, tx_hash) VALUES(2, 1, 2, 100, decode('A9505DB7D7EDD08947F12F2B1F7898148FFB43D80BCB977B78161EF14173D575','hex'));"
+	_, err := db.Exec(insertUpdate)
+	assert.NoError(t, err)
+	const insertObsolete = "INSERT INTO sync.obsolete_rollup_type (block_id, rollup_type_id, tx_hash) VALUES(2, 1, decode('A9505DB7D7EDD08947F12F2B1F7898148FFB43D80BCB977B78161EF14173D575','hex'));"
+	_, err = db.Exec(insertObsolete)
+	assert.NoError(t, err)
+
+	// Removing the block cascades to the upgrades and the obsolete rollup types
+	_, err = db.Exec("DELETE FROM sync.block WHERE id = 2;")
+	assert.NoError(t, err)
+	var count int
+	err = db.QueryRow("SELECT (SELECT count(*) FROM sync.rollup_update) + (SELECT count(*) FROM sync.obsolete_rollup_type);").Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func (m migrationTest0015) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	_, err := db.Exec("SELECT count(*) FROM sync.rollup_update;")
+	assert.Error(t, err)
+	_, err = db.Exec("SELECT count(*) FROM sync.obsolete_rollup_type;")
+	assert.Error(t, err)
+}
+
+func TestMigration0015(t *testing.T) {
+	runMigrationTest(t, 15, migrationTest0015{})
+}
@@ -0,0 +1,7 @@
+-- +migrate Up
+CREATE INDEX IF NOT EXISTS token_wrapped_wrapped_token_addr_network_id ON sync.token_wrapped USING btree (wrapped_token_addr, network_id);
+CREATE INDEX IF NOT EXISTS deposit_orig_addr_network_id_orig_net ON sync.deposit USING btree (orig_addr, network_id, orig_net);
+
+-- +migrate Down
+DROP INDEX IF EXISTS sync.deposit_orig_addr_network_id_orig_net;
+DROP INDEX IF EXISTS sync.token_wrapped_wrapped_token_addr_network_id;
@@ -0,0 +1,34 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This migration creates the indexes to look up the wrapped tokens by address and the native tokens by their deposits.
+
+type migrationTest0016 struct{}
+
+const getIndexes0016 = "SELECT count(*) FROM pg_indexes WHERE schemaname = 'sync' AND indexname IN ('token_wrapped_wrapped_token_addr_network_id', 'deposit_orig_addr_network_id_orig_net');"
+
+func (m migrationTest0016) InsertData(db *sql.DB) error {
+	return nil
+}
+
+func (m migrationTest0016) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	var count int
+	assert.NoError(t, db.QueryRow(getIndexes0016).Scan(&count))
+	assert.Equal(t, 2, count)
+}
+
+func (m migrationTest0016) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	var count int
+	assert.NoError(t, db.QueryRow(getIndexes0016).Scan(&count))
+	assert.Equal(t, 0, count)
+}
+
+func TestMigration0016(t *testing.T) {
+	runMigrationTest(t, 16, migrationTest0016{})
+}
@@ -0,0 +1,24 @@
+-- +migrate Up
+UPDATE sync.claim SET rollup_index = 0 WHERE rollup_index IS NULL;
+UPDATE sync.claim SET mainnet_flag = FALSE WHERE mainnet_flag IS NULL;
+
+ALTER TABLE sync.claim
+ALTER COLUMN rollup_index SET NOT NULL,
+ALTER COLUMN mainnet_flag SET NOT NULL;
+
+ALTER TABLE sync.claim DROP CONSTRAINT IF EXISTS claim_pkey;
+ALTER TABLE sync.claim ADD CONSTRAINT claim_pkey PRIMARY KEY (network_id, index, mainnet_flag, rollup_index);
+
+-- +migrate Down
+-- The old key can hold a single claim per destination network and deposit count, so the claims that collide on it are removed keeping the oldest one.
+DELETE FROM sync.claim AS c USING sync.claim AS o
+WHERE c.network_id = o.network_id AND c.index = o.index AND c.block_id > o.block_id;
+DELETE FROM sync.claim AS c USING sync.claim AS o
+WHERE c.network_id = o.network_id AND c.index = o.index AND c.block_id = o.block_id AND c.ctid > o.ctid;
+
+ALTER TABLE sync.claim DROP CONSTRAINT IF EXISTS claim_pkey;
+ALTER TABLE sync.claim ADD CONSTRAINT claim_pkey PRIMARY KEY (network_id, index);
+
+ALTER TABLE sync.claim
+ALTER COLUMN rollup_index DROP NOT NULL,
+ALTER COLUMN mainnet_flag DROP NOT NULL;
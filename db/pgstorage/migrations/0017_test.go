@@ -0,0 +1,54 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This migration keys the claims by their destination network and global index, so the claims of deposits with the same deposit count coming from different networks can be stored.
+
+type migrationTest0017 struct{}
+
+const (
+	insertClaim0017 = "INSERT INTO sync.claim (network_id, index, orig_net, orig_addr, amount, dest_addr, block_id, tx_hash, rollup_index, mainnet_flag) VALUES(1, 3, 0, decode('0000000000000000000000000000000000000000','hex'), '300000000000000000', decode('14567C0DCF79C20FE1A21E36EC975D1775A1905C','hex'), 2, decode('A9505DB7D7EDD08947F12F2B1F7898148FFB43D80BCB977B78161EF14173D575','hex'), $1, $2);"
+	countClaims0017 = "SELECT count(*) FROM sync.claim WHERE network_id = 1 AND index = 3;"
+)
+
+func (m migrationTest0017) InsertData(db *sql.DB) error {
+	block := "INSERT INTO sync.block (id, block_num, block_hash, parent_hash, network_id, received_at) VALUES(2, 2803824, decode('27474F16174BBE50C294FE13C190B92E42B2368A6D4AEB8A4A015F52816296C3','hex'), decode('C9B5033799ADF3739383A0489EFBE8A0D4D5E4478778A4F4304562FD51AE4C07','hex'), 1, '0001-01-01 01:00:00.000');"
+	if _, err := db.Exec(block); err != nil {
+		return err
+	}
+	if _, err := db.Exec(insertClaim0017, 0, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m migrationTest0017) RunAssertsAfterMigrationUp(t *testing.T, db *sql.DB) {
+	_, err := db.Exec(insertClaim0017, 0, false)
+	assert.NoError(t, err)
+	_, err = db.Exec(insertClaim0017, 1, false)
+	assert.NoError(t, err)
+	_, err = db.Exec(insertClaim0017, 1, false)
+	assert.Error(t, err)
+
+	var count int
+	assert.NoError(t, db.QueryRow(countClaims0017).Scan(&count))
+	assert.Equal(t, 3, count)
+}
+
+func (m migrationTest0017) RunAssertsAfterMigrationDown(t *testing.T, db *sql.DB) {
+	var count int
+	assert.NoError(t, db.QueryRow(countClaims0017).Scan(&count))
+	assert.Equal(t, 1, count)
+
+	_, err := db.Exec(insertClaim0017, 1, false)
+	assert.Error(t, err)
+}
+
+func TestMigration0017(t *testing.T) {
+	runMigrationTest(t, 17, migrationTest0017{})
+}
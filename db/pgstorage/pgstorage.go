@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/metrics"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/0xPolygonHermez/zkevm-node/log"
@@ -20,6 +22,8 @@ import (
 // PostgresStorage implements the Storage interface.
 type PostgresStorage struct {
 	*pgxpool.Pool
+	replicas     *replicaSet
+	queryTimeout time.Duration
 }
 
 // getExecQuerier determines which execQuerier to use, dbTx or the main pgxpool
@@ -30,9 +34,69 @@ func (p *PostgresStorage) getExecQuerier(dbTx pgx.Tx) execQuerier {
 	return p
 }
 
+// getReadQuerier determines which execQuerier to use for a read, dbTx, an available read replica or the main pgxpool.
+// The reads that are not part of a transaction are cancelled after the query timeout, if any.
+func (p *PostgresStorage) getReadQuerier(ctx context.Context, dbTx pgx.Tx) execQuerier {
+	if dbTx != nil {
+		return dbTx
+	}
+	e := p.getPoolQuerier(ctx)
+	if p.queryTimeout > 0 {
+		return &timeoutQuerier{execQuerier: e, timeout: p.queryTimeout}
+	}
+	return e
+}
+
+// getPoolQuerier determines which pool to use for a read, an available read replica or the main pgxpool.
+// The reads of a read session use the pool picked for the first one, unless they must be consistent.
+func (p *PostgresStorage) getPoolQuerier(ctx context.Context) execQuerier {
+	if p.replicas == nil {
+		return p
+	}
+	if isConsistentRead(ctx) {
+		metrics.Read(metrics.PrimaryTarget)
+		return p
+	}
+	session := getReadSession(ctx)
+	if session == nil {
+		querier, _ := p.pickPoolQuerier()
+		return querier
+	}
+	session.once.Do(func() { session.querier, session.replica = p.pickPoolQuerier() })
+	return session.querier
+}
+
+// pickPoolQuerier picks an available read replica, or the main pgxpool if none is available. It returns
+// true if the pool is a read replica.
+func (p *PostgresStorage) pickPoolQuerier() (execQuerier, bool) {
+	if r := p.replicas.pick(); r != nil {
+		metrics.Read(r.name)
+		return r.pool, true
+	}
+	metrics.Read(metrics.PrimaryTarget)
+	return p, false
+}
+
+// newPoolConfig returns the config of a pool of connections to the database, or to the read replica at host:port
+// if host is not empty
+func newPoolConfig(cfg Config, host, port string) (*pgxpool.Config, error) {
+	connString, err := cfg.connString(host, port, true)
+	if err != nil {
+		return nil, err
+	}
+	config, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxConns > 0 {
+		config.MaxConns = int32(cfg.MaxConns)
+	}
+	return config, nil
+}
+
 // NewPostgresStorage creates a new Storage DB
 func NewPostgresStorage(cfg Config) (*PostgresStorage, error) {
-	config, err := pgxpool.ParseConfig(fmt.Sprintf("postgres://%s:%s@%s:%s/%s?pool_max_conns=%d", cfg.User, cfg.Password, cfg.Host, cfg.Port, cfg.Name, cfg.MaxConns))
+	config, err := newPoolConfig(cfg, "", "")
 	if err != nil {
 		log.Errorf("Unable to parse DB config: %v\n", err)
 		return nil, err
@@ -42,7 +106,24 @@ func NewPostgresStorage(cfg Config) (*PostgresStorage, error) {
 		log.Errorf("Unable to connect to database: %v\n", err)
 		return nil, err
 	}
-	return &PostgresStorage{db}, nil
+	storage := &PostgresStorage{Pool: db, queryTimeout: cfg.QueryTimeout.Duration}
+	if len(cfg.ReadReplicas) > 0 {
+		storage.replicas, err = newReplicaSet(cfg)
+		if err != nil {
+			log.Errorf("Unable to connect to the read replicas: %v\n", err)
+			db.Close()
+			return nil, err
+		}
+	}
+	return storage, nil
+}
+
+// Close closes the connections to the database and to the read replicas
+func (p *PostgresStorage) Close() {
+	if p.replicas != nil {
+		p.replicas.close()
+	}
+	p.Pool.Close()
 }
 
 // Rollback rollbacks a db transaction.
@@ -72,7 +153,7 @@ func (p *PostgresStorage) GetLastBlock(ctx context.Context, networkID uint, dbTx
 	var block etherman.Block
 	const getLastBlockSQL = "SELECT id, block_num, block_hash, parent_hash, network_id, received_at FROM sync.block where network_id = $1 ORDER BY block_num DESC LIMIT 1"
 
-	e := p.getExecQuerier(dbTx)
+	e := p.getReadQuerier(ctx, dbTx)
 	err := e.QueryRow(ctx, getLastBlockSQL, networkID).Scan(&block.ID, &block.BlockNumber, &block.BlockHash, &block.ParentHash, &block.NetworkID, &block.ReceivedAt)
 
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -82,6 +163,27 @@ func (p *PostgresStorage) GetLastBlock(ctx context.Context, networkID uint, dbTx
 	return &block, err
 }
 
+// GetLastBlocks gets the latest block synced of every network.
+func (p *PostgresStorage) GetLastBlocks(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Block, error) {
+	const getLastBlocksSQL = `SELECT DISTINCT ON (network_id) id, block_num, block_hash, parent_hash, network_id, received_at
+		FROM sync.block WHERE network_id IS NOT NULL ORDER BY network_id ASC, block_num DESC`
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getLastBlocksSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	blocks := make([]*etherman.Block, 0)
+	for rows.Next() {
+		var block etherman.Block
+		err = rows.Scan(&block.ID, &block.BlockNumber, &block.BlockHash, &block.ParentHash, &block.NetworkID, &block.ReceivedAt)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, &block)
+	}
+	return blocks, rows.Err()
+}
+
 // AddBlock adds a new block to the storage.
 func (p *PostgresStorage) AddBlock(ctx context.Context, block *etherman.Block, dbTx pgx.Tx) (uint64, error) {
 	var blockID uint64
@@ -126,6 +228,31 @@ func (p *PostgresStorage) AddClaim(ctx context.Context, claim *etherman.Claim, d
 	return err
 }
 
+// AddDepositStats adds the deposit to the bridge volume statistics of the hour of its block.
+func (p *PostgresStorage) AddDepositStats(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) error {
+	return p.addBridgeStats(ctx, deposit.BlockID, deposit.OriginalNetwork, deposit.OriginalAddress, deposit.NetworkID, deposit.DestinationNetwork, 1, deposit.Amount, 0, big.NewInt(0), dbTx)
+}
+
+// AddClaimStats adds the claim to the bridge volume statistics of the hour of its block.
+func (p *PostgresStorage) AddClaimStats(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) error {
+	// The claims of the old bridge don't have a global index, so the ones in a rollup come from mainnet
+	var fromNetwork uint
+	if !claim.MainnetFlag && uint(claim.RollupIndex)+1 != claim.NetworkID {
+		fromNetwork = uint(claim.RollupIndex) + 1
+	}
+	return p.addBridgeStats(ctx, claim.BlockID, claim.OriginalNetwork, claim.OriginalAddress, fromNetwork, claim.NetworkID, 0, big.NewInt(0), 1, claim.Amount, dbTx)
+}
+
+func (p *PostgresStorage) addBridgeStats(ctx context.Context, blockID uint64, origNet uint, origAddr common.Address, fromNetwork, toNetwork uint, depositCnt uint64, depositAmount *big.Int, claimCnt uint64, claimAmount *big.Int, dbTx pgx.Tx) error {
+	const addBridgeStatsSQL = `INSERT INTO sync.bridge_stats (bucket, orig_net, orig_addr, from_net, to_net, deposit_cnt, deposit_amount, claim_cnt, claim_amount)
+		SELECT date_trunc('hour', received_at AT TIME ZONE 'UTC'), $2, $3, $4, $5, $6, $7::NUMERIC, $8, $9::NUMERIC FROM sync.block WHERE id = $1
+		ON CONFLICT (bucket, orig_net, orig_addr, from_net, to_net) DO UPDATE SET
+		deposit_cnt = sync.bridge_stats.deposit_cnt + EXCLUDED.deposit_cnt, deposit_amount = sync.bridge_stats.deposit_amount + EXCLUDED.deposit_amount,
+		claim_cnt = sync.bridge_stats.claim_cnt + EXCLUDED.claim_cnt, claim_amount = sync.bridge_stats.claim_amount + EXCLUDED.claim_amount`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, addBridgeStatsSQL, blockID, origNet, origAddr, fromNetwork, toNetwork, depositCnt, depositAmount.String(), claimCnt, claimAmount.String())
+	return err
+}
+
 // GetTokenMetadata gets the metadata of the dedicated token.
 func (p *PostgresStorage) GetTokenMetadata(ctx context.Context, networkID, destNet uint, originalTokenAddr common.Address, dbTx pgx.Tx) ([]byte, error) {
 	var metadata []byte
@@ -147,7 +274,7 @@ func (p *PostgresStorage) AddTokenWrapped(ctx context.Context, tokenWrapped *eth
 		// ref: https://github.com/0xPolygonHermez/zkevm-bridge-service/issues/230
 		tokenMetadata = &etherman.TokenMetadata{}
 	} else {
-		tokenMetadata, err = getDecodedToken(metadata)
+		tokenMetadata, err = etherman.DecodeTokenMetadata(metadata)
 		if err != nil {
 			return err
 		}
@@ -162,11 +289,168 @@ func (p *PostgresStorage) AddTokenWrapped(ctx context.Context, tokenWrapped *eth
 // Reset resets the state to a block for the given DB tx.
 func (p *PostgresStorage) Reset(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) error {
 	const resetSQL = "DELETE FROM sync.block WHERE block_num > $1 AND network_id = $2"
+	// The deposits and claims that are going to be removed are subtracted from the bridge volume statistics
+	const revertBridgeStatsSQL = `UPDATE sync.bridge_stats AS s SET deposit_cnt = s.deposit_cnt - r.deposit_cnt, deposit_amount = s.deposit_amount - r.deposit_amount,
+		claim_cnt = s.claim_cnt - r.claim_cnt, claim_amount = s.claim_amount - r.claim_amount
+		FROM (
+			SELECT bucket, orig_net, orig_addr, from_net, to_net, SUM(deposit_cnt) AS deposit_cnt, SUM(deposit_amount) AS deposit_amount, SUM(claim_cnt) AS claim_cnt, SUM(claim_amount) AS claim_amount
+			FROM (
+				SELECT date_trunc('hour', b.received_at AT TIME ZONE 'UTC') AS bucket, d.orig_net, d.orig_addr, d.network_id AS from_net, d.dest_net AS to_net,
+					1 AS deposit_cnt, d.amount::NUMERIC AS deposit_amount, 0 AS claim_cnt, 0 AS claim_amount
+				FROM sync.deposit AS d INNER JOIN sync.block AS b ON d.block_id = b.id
+				WHERE b.block_num > $1 AND b.network_id = $2
+				UNION ALL
+				SELECT date_trunc('hour', b.received_at AT TIME ZONE 'UTC'), c.orig_net, c.orig_addr, CASE WHEN c.mainnet_flag OR c.rollup_index + 1 = c.network_id THEN 0 ELSE c.rollup_index + 1 END, c.network_id,
+					0, 0, 1, c.amount::NUMERIC
+				FROM sync.claim AS c INNER JOIN sync.block AS b ON c.block_id = b.id
+				WHERE b.block_num > $1 AND b.network_id = $2
+			) AS events
+			GROUP BY bucket, orig_net, orig_addr, from_net, to_net
+		) AS r
+		WHERE s.bucket = r.bucket AND s.orig_net = r.orig_net AND s.orig_addr = r.orig_addr AND s.from_net = r.from_net AND s.to_net = r.to_net`
+	const deleteEmptyBridgeStatsSQL = "DELETE FROM sync.bridge_stats WHERE deposit_cnt = 0 AND claim_cnt = 0"
 	e := p.getExecQuerier(dbTx)
+	if _, err := e.Exec(ctx, revertBridgeStatsSQL, blockNumber, networkID); err != nil {
+		return err
+	}
+	if _, err := e.Exec(ctx, deleteEmptyBridgeStatsSQL); err != nil {
+		return err
+	}
 	_, err := e.Exec(ctx, resetSQL, blockNumber, networkID)
 	return err
 }
 
+// AddReorg stores a reorg in the journal together with the deposits, claims and global exit roots
+// that are going to be removed when the state is reset to the fork block. It must be called
+// before Reset and using the same DB tx.
+func (p *PostgresStorage) AddReorg(ctx context.Context, reorg *etherman.Reorg, dbTx pgx.Tx) error {
+	const addReorgSQL = "INSERT INTO sync.reorg (network_id, fork_block_num, depth, old_block_hash, new_block_hash, detected_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id"
+	const addReorgDepositsSQL = `INSERT INTO sync.reorg_deposit (reorg_id, leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_num, deposit_cnt, tx_hash, metadata)
+		SELECT $1, d.leaf_type, d.network_id, d.orig_net, d.orig_addr, d.amount, d.dest_net, d.dest_addr, b.block_num, d.deposit_cnt, d.tx_hash, d.metadata
+		FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id
+		WHERE b.network_id = $2 AND b.block_num > $3
+		RETURNING reorg_id, leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_num, deposit_cnt, tx_hash, metadata`
+	const addReorgClaimsSQL = `INSERT INTO sync.reorg_claim (reorg_id, network_id, index, orig_net, orig_addr, amount, dest_addr, block_num, tx_hash, rollup_index, mainnet_flag)
+		SELECT $1, c.network_id, c.index, c.orig_net, c.orig_addr, c.amount, c.dest_addr, b.block_num, c.tx_hash, c.rollup_index, c.mainnet_flag
+		FROM sync.claim as c INNER JOIN sync.block as b ON c.network_id = b.network_id AND c.block_id = b.id
+		WHERE b.network_id = $2 AND b.block_num > $3
+		RETURNING reorg_id, network_id, index, orig_net, orig_addr, amount, dest_addr, block_num, tx_hash, rollup_index, mainnet_flag`
+	const addReorgExitRootsSQL = `INSERT INTO sync.reorg_exit_root (reorg_id, block_num, global_exit_root, exit_roots)
+		SELECT $1, b.block_num, ger.global_exit_root, ger.exit_roots
+		FROM sync.exit_root as ger INNER JOIN sync.block as b ON ger.block_id = b.id
+		WHERE b.network_id = $2 AND b.block_num > $3
+		RETURNING reorg_id, block_num, global_exit_root, exit_roots`
+
+	e := p.getExecQuerier(dbTx)
+	err := e.QueryRow(ctx, addReorgSQL, reorg.NetworkID, reorg.ForkBlockNumber, reorg.Depth, reorg.OldBlockHash, reorg.NewBlockHash, reorg.DetectedAt).Scan(&reorg.ID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := e.Query(ctx, addReorgDepositsSQL, reorg.ID, reorg.NetworkID, reorg.ForkBlockNumber)
+	if err != nil {
+		return err
+	}
+	deposits, err := scanReorgDeposits(rows)
+	if err != nil {
+		return err
+	}
+	reorg.Deposits = deposits[reorg.ID]
+
+	rows, err = e.Query(ctx, addReorgClaimsSQL, reorg.ID, reorg.NetworkID, reorg.ForkBlockNumber)
+	if err != nil {
+		return err
+	}
+	claims, err := scanReorgClaims(rows)
+	if err != nil {
+		return err
+	}
+	reorg.Claims = claims[reorg.ID]
+
+	rows, err = e.Query(ctx, addReorgExitRootsSQL, reorg.ID, reorg.NetworkID, reorg.ForkBlockNumber)
+	if err != nil {
+		return err
+	}
+	gers, err := scanReorgExitRoots(rows)
+	if err != nil {
+		return err
+	}
+	reorg.GlobalExitRoots = gers[reorg.ID]
+	return nil
+}
+
+// GetReorgs gets the reorg journal of a network, the most recent first.
+func (p *PostgresStorage) GetReorgs(ctx context.Context, networkID uint, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Reorg, error) {
+	const getReorgsSQL = "SELECT id, network_id, fork_block_num, depth, old_block_hash, new_block_hash, detected_at FROM sync.reorg WHERE network_id = $1 ORDER BY id DESC LIMIT $2 OFFSET $3"
+	const getReorgDepositsSQL = "SELECT reorg_id, leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_num, deposit_cnt, tx_hash, metadata FROM sync.reorg_deposit WHERE reorg_id = ANY($1) ORDER BY reorg_id, deposit_cnt ASC"
+	const getReorgClaimsSQL = "SELECT reorg_id, network_id, index, orig_net, orig_addr, amount, dest_addr, block_num, tx_hash, rollup_index, mainnet_flag FROM sync.reorg_claim WHERE reorg_id = ANY($1) ORDER BY reorg_id, block_num ASC, index ASC"
+	const getReorgExitRootsSQL = "SELECT reorg_id, block_num, global_exit_root, exit_roots FROM sync.reorg_exit_root WHERE reorg_id = ANY($1) ORDER BY reorg_id, block_num ASC"
+
+	e := p.getReadQuerier(ctx, dbTx)
+	rows, err := e.Query(ctx, getReorgsSQL, networkID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	reorgs := make([]*etherman.Reorg, 0)
+	ids := make([]uint64, 0)
+	for rows.Next() {
+		var reorg etherman.Reorg
+		err = rows.Scan(&reorg.ID, &reorg.NetworkID, &reorg.ForkBlockNumber, &reorg.Depth, &reorg.OldBlockHash, &reorg.NewBlockHash, &reorg.DetectedAt)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		reorgs = append(reorgs, &reorg)
+		ids = append(ids, reorg.ID)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(reorgs) == 0 {
+		return reorgs, nil
+	}
+
+	rows, err = e.Query(ctx, getReorgDepositsSQL, ids)
+	if err != nil {
+		return nil, err
+	}
+	deposits, err := scanReorgDeposits(rows)
+	if err != nil {
+		return nil, err
+	}
+	rows, err = e.Query(ctx, getReorgClaimsSQL, ids)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := scanReorgClaims(rows)
+	if err != nil {
+		return nil, err
+	}
+	rows, err = e.Query(ctx, getReorgExitRootsSQL, ids)
+	if err != nil {
+		return nil, err
+	}
+	gers, err := scanReorgExitRoots(rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, reorg := range reorgs {
+		reorg.Deposits = deposits[reorg.ID]
+		reorg.Claims = claims[reorg.ID]
+		reorg.GlobalExitRoots = gers[reorg.ID]
+	}
+	return reorgs, nil
+}
+
+// GetReorgCount gets the number of reorgs stored in the journal for a network.
+func (p *PostgresStorage) GetReorgCount(ctx context.Context, networkID uint, dbTx pgx.Tx) (uint64, error) {
+	const getReorgCountSQL = "SELECT COUNT(*) FROM sync.reorg WHERE network_id = $1"
+	var reorgCount uint64
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getReorgCountSQL, networkID).Scan(&reorgCount)
+	return reorgCount, err
+}
+
 // GetPreviousBlock gets the offset previous L1 block respect to latest.
 func (p *PostgresStorage) GetPreviousBlock(ctx context.Context, networkID uint, offset uint64, dbTx pgx.Tx) (*etherman.Block, error) {
 	var block etherman.Block
@@ -198,13 +482,17 @@ func (p *PostgresStorage) AddTrustedGlobalExitRoot(ctx context.Context, trustedE
 }
 
 // GetClaim gets a specific claim from the storage.
-func (p *PostgresStorage) GetClaim(ctx context.Context, depositCount, networkID uint, dbTx pgx.Tx) (*etherman.Claim, error) {
+// The deposit is identified by its deposit count and its origin network, which is taken from the global index of the claim.
+// The claims stored before LxLy don't have the mainnet flag, but a network only claims the deposits of other networks, so a
+// claim from the same rollup index as the network is a claim of a mainnet deposit.
+func (p *PostgresStorage) GetClaim(ctx context.Context, depositCount, origNetworkID, networkID uint, dbTx pgx.Tx) (*etherman.Claim, error) {
 	var (
 		claim  etherman.Claim
 		amount string
 	)
-	const getClaimSQL = "SELECT index, orig_net, orig_addr, amount, dest_addr, block_id, network_id, tx_hash, rollup_index, mainnet_flag FROM sync.claim WHERE index = $1 AND network_id = $2"
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getClaimSQL, depositCount, networkID).Scan(&claim.Index, &claim.OriginalNetwork, &claim.OriginalAddress, &amount, &claim.DestinationAddress, &claim.BlockID, &claim.NetworkID, &claim.TxHash, &claim.RollupIndex, &claim.MainnetFlag)
+	const getClaimSQL = `SELECT index, orig_net, orig_addr, amount, dest_addr, block_id, network_id, tx_hash, rollup_index, mainnet_flag FROM sync.claim
+		WHERE index = $1 AND network_id = $3 AND $2 = CASE WHEN mainnet_flag OR rollup_index + 1 = network_id THEN 0 ELSE rollup_index + 1 END`
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getClaimSQL, depositCount, origNetworkID, networkID).Scan(&claim.Index, &claim.OriginalNetwork, &claim.OriginalAddress, &amount, &claim.DestinationAddress, &claim.BlockID, &claim.NetworkID, &claim.TxHash, &claim.RollupIndex, &claim.MainnetFlag)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, gerror.ErrStorageNotFound
 	}
@@ -212,18 +500,27 @@ func (p *PostgresStorage) GetClaim(ctx context.Context, depositCount, networkID
 	return &claim, err
 }
 
-// GetDeposit gets a specific deposit from the storage.
+// GetDeposit gets a specific deposit from the storage, with the hash of the tx that claimed it.
 func (p *PostgresStorage) GetDeposit(ctx context.Context, depositCounterUser uint, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error) {
 	var (
-		deposit etherman.Deposit
-		amount  string
+		deposit     etherman.Deposit
+		amount      string
+		claimTxHash []byte
 	)
-	const getDepositSQL = "SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id WHERE d.network_id = $1 AND deposit_cnt = $2"
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getDepositSQL, networkID, depositCounterUser).Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim)
+	const getDepositSQL = `SELECT d.leaf_type, d.orig_net, d.orig_addr, d.amount, d.dest_net, d.dest_addr, d.deposit_cnt, d.block_id, b.block_num, d.network_id, d.tx_hash, d.metadata, d.ready_for_claim, b.received_at, c.tx_hash
+		FROM sync.deposit AS d INNER JOIN sync.block AS b ON d.network_id = b.network_id AND d.block_id = b.id
+		LEFT JOIN sync.claim AS c ON c.network_id = d.dest_net AND c.index = d.deposit_cnt
+			AND d.network_id = CASE WHEN c.mainnet_flag OR c.rollup_index + 1 = c.network_id THEN 0 ELSE c.rollup_index + 1 END
+		WHERE d.network_id = $1 AND d.deposit_cnt = $2`
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getDepositSQL, networkID, depositCounterUser).Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim, &deposit.ReceivedAt, &claimTxHash)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, gerror.ErrStorageNotFound
 	}
 	deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+	if claimTxHash != nil {
+		txHash := common.BytesToHash(claimTxHash)
+		deposit.ClaimTxHash = &txHash
+	}
 
 	return &deposit, err
 }
@@ -244,7 +541,7 @@ func (p *PostgresStorage) GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pg
 		exitRoots [][]byte
 	)
 	const getLatestL1SyncedExitRootSQL = "SELECT block_id, global_exit_root, exit_roots FROM sync.exit_root WHERE block_id > 0 ORDER BY id DESC LIMIT 1"
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getLatestL1SyncedExitRootSQL).Scan(&ger.BlockID, &ger.GlobalExitRoot, pq.Array(&exitRoots))
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getLatestL1SyncedExitRootSQL).Scan(&ger.BlockID, &ger.GlobalExitRoot, pq.Array(&exitRoots))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return &ger, gerror.ErrStorageNotFound
@@ -262,7 +559,7 @@ func (p *PostgresStorage) GetLatestTrustedExitRoot(ctx context.Context, dbTx pgx
 		exitRoots [][]byte
 	)
 	const getLatestTrustedExitRootSQL = "SELECT global_exit_root, exit_roots FROM sync.exit_root WHERE block_id = 0 ORDER BY id DESC LIMIT 1"
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getLatestTrustedExitRootSQL).Scan(&ger.GlobalExitRoot, pq.Array(&exitRoots))
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getLatestTrustedExitRootSQL).Scan(&ger.GlobalExitRoot, pq.Array(&exitRoots))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, gerror.ErrStorageNotFound
@@ -293,7 +590,7 @@ func (p *PostgresStorage) GetTokenWrapped(ctx context.Context, originalNetwork u
 				return nil, err
 			}
 		} else {
-			tokenMetadata, err = getDecodedToken(metadata)
+			tokenMetadata, err = etherman.DecodeTokenMetadata(metadata)
 			if err != nil {
 				return nil, err
 			}
@@ -308,15 +605,69 @@ func (p *PostgresStorage) GetTokenWrapped(ctx context.Context, originalNetwork u
 	return &token, err
 }
 
+// GetTokensWrapped gets the wrapped tokens. If networkID is nil, the wrapped tokens of all the networks are returned.
+func (p *PostgresStorage) GetTokensWrapped(ctx context.Context, networkID *uint, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.TokenWrapped, error) {
+	const getTokensWrappedSQL = `SELECT network_id, orig_net, orig_token_addr, wrapped_token_addr, block_id, name, symbol, decimals FROM sync.token_wrapped
+		WHERE $1::INTEGER IS NULL OR network_id = $1 ORDER BY block_id DESC, network_id ASC, orig_net ASC, orig_token_addr ASC LIMIT $2 OFFSET $3`
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getTokensWrappedSQL, networkID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	tokens := make([]*etherman.TokenWrapped, 0)
+	for rows.Next() {
+		var token etherman.TokenWrapped
+		err = rows.Scan(&token.NetworkID, &token.OriginalNetwork, &token.OriginalTokenAddress, &token.WrappedTokenAddress, &token.BlockID, &token.Name, &token.Symbol, &token.Decimals)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, &token)
+	}
+	return tokens, rows.Err()
+}
+
+// GetTokenWrappedCount gets the number of wrapped tokens. If networkID is nil, the wrapped tokens of all the networks are counted.
+func (p *PostgresStorage) GetTokenWrappedCount(ctx context.Context, networkID *uint, dbTx pgx.Tx) (uint64, error) {
+	const getTokenWrappedCountSQL = "SELECT COUNT(*) FROM sync.token_wrapped WHERE $1::INTEGER IS NULL OR network_id = $1"
+	var count uint64
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getTokenWrappedCountSQL, networkID).Scan(&count)
+	return count, err
+}
+
+// GetTokenWrappedByAddress gets the wrapped token from its address in the network where it was created.
+func (p *PostgresStorage) GetTokenWrappedByAddress(ctx context.Context, wrappedTokenAddress common.Address, networkID uint, dbTx pgx.Tx) (*etherman.TokenWrapped, error) {
+	const getTokenWrappedByAddressSQL = "SELECT network_id, orig_net, orig_token_addr, wrapped_token_addr, block_id, name, symbol, decimals FROM sync.token_wrapped WHERE wrapped_token_addr = $1 AND network_id = $2"
+	var token etherman.TokenWrapped
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getTokenWrappedByAddressSQL, wrappedTokenAddress, networkID).Scan(&token.NetworkID, &token.OriginalNetwork,
+		&token.OriginalTokenAddress, &token.WrappedTokenAddress, &token.BlockID, &token.Name, &token.Symbol, &token.Decimals)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return &token, err
+}
+
+// GetNativeTokenMetadata gets the metadata of a token of the network from the metadata of its deposits.
+func (p *PostgresStorage) GetNativeTokenMetadata(ctx context.Context, tokenAddress common.Address, networkID uint, dbTx pgx.Tx) (*etherman.TokenMetadata, error) {
+	const getNativeTokenMetadataSQL = "SELECT metadata FROM sync.deposit WHERE network_id = $1 AND orig_net = $1 AND orig_addr = $2 AND length(metadata) > 0 LIMIT 1"
+	var metadata []byte
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getNativeTokenMetadataSQL, networkID, tokenAddress).Scan(&metadata)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, gerror.ErrStorageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return etherman.DecodeTokenMetadata(metadata)
+}
+
 // GetDepositCountByRoot gets the deposit count by the root.
 func (p *PostgresStorage) GetDepositCountByRoot(ctx context.Context, root []byte, network uint8, dbTx pgx.Tx) (uint, error) {
 	var depositCount uint
 	const getDepositCountByRootSQL = "SELECT sync.deposit.deposit_cnt FROM mt.root INNER JOIN sync.deposit ON sync.deposit.id = mt.root.deposit_id WHERE mt.root.root = $1 AND mt.root.network = $2"
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getDepositCountByRootSQL, root, network).Scan(&depositCount)
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getDepositCountByRootSQL, root, network).Scan(&depositCount)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return 0, gerror.ErrStorageNotFound
 	}
-	return depositCount, nil
+	return depositCount, err
 }
 
 // CheckIfRootExists checks that the root exists on the db.
@@ -337,7 +688,7 @@ func (p *PostgresStorage) CheckIfRootExists(ctx context.Context, root []byte, ne
 func (p *PostgresStorage) GetRoot(ctx context.Context, depositCnt uint, network uint, dbTx pgx.Tx) ([]byte, error) {
 	var root []byte
 	const getRootByDepositCntSQL = "SELECT root FROM mt.root inner join sync.deposit on mt.root.deposit_id = sync.deposit.id WHERE sync.deposit.deposit_cnt = $1 AND network = $2"
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getRootByDepositCntSQL, depositCnt, network).Scan(&root)
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getRootByDepositCntSQL, depositCnt, network).Scan(&root)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, gerror.ErrStorageNotFound
 	}
@@ -355,7 +706,7 @@ func (p *PostgresStorage) SetRoot(ctx context.Context, root []byte, depositID ui
 func (p *PostgresStorage) Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error) {
 	const getValueByKeySQL = "SELECT value FROM mt.rht WHERE key = $1"
 	var data [][]byte
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getValueByKeySQL, key).Scan(pq.Array(&data))
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getValueByKeySQL, key).Scan(pq.Array(&data))
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, gerror.ErrStorageNotFound
 	}
@@ -377,6 +728,206 @@ func (p *PostgresStorage) BulkSet(ctx context.Context, rows [][]interface{}, dbT
 	return err
 }
 
+// IterateNodes calls fn for each node of the merkle trees.
+func (p *PostgresStorage) IterateNodes(ctx context.Context, fn func(key []byte, value [][]byte) error, dbTx pgx.Tx) error {
+	const getNodesSQL = "SELECT key, value FROM mt.rht"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getNodesSQL)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			key   []byte
+			value [][]byte
+		)
+		err = rows.Scan(&key, pq.Array(&value))
+		if err != nil {
+			return err
+		}
+		err = fn(key, value)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// GetExitRootDepositCount gets the deposit count of the root of the network included in a global exit root synced from L1.
+// The offset is the position of the global exit root from the latest one. The local exit roots of the rollups are
+// taken from the leaves of the rollup exit root.
+func (p *PostgresStorage) GetExitRootDepositCount(ctx context.Context, network uint, offset uint, dbTx pgx.Tx) (uint, error) {
+	const getExitRootDepositCountSQL = `WITH ger AS (
+			SELECT exit_roots FROM sync.exit_root WHERE block_id > 0 ORDER BY id DESC LIMIT 1 OFFSET $2
+		)
+		SELECT d.deposit_cnt FROM ger
+			LEFT JOIN mt.rollup_exit re ON $1 > 0 AND re.root = ger.exit_roots[2] AND re.rollup_id = $1 AND re.invalidated_by IS NULL
+			INNER JOIN mt.root r ON r.network = $1 AND r.root = CASE WHEN $1 = 0 THEN ger.exit_roots[1] ELSE re.leaf END
+			INNER JOIN sync.deposit d ON d.id = r.deposit_id`
+	var depositCnt uint
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getExitRootDepositCountSQL, network, offset).Scan(&depositCnt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, gerror.ErrStorageNotFound
+	}
+	return depositCnt, err
+}
+
+// GetFirstDepositCountSince gets the count of the first deposit of the network whose block was received since the given time.
+func (p *PostgresStorage) GetFirstDepositCountSince(ctx context.Context, network uint, since time.Time, dbTx pgx.Tx) (uint, error) {
+	const getFirstDepositCountSinceSQL = `SELECT MIN(d.deposit_cnt) FROM sync.deposit d
+		INNER JOIN sync.block b ON b.id = d.block_id AND b.network_id = d.network_id
+		WHERE d.network_id = $1 AND b.received_at >= $2`
+	var depositCnt *uint
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getFirstDepositCountSinceSQL, network, since.UTC()).Scan(&depositCnt)
+	if err != nil {
+		return 0, err
+	}
+	if depositCnt == nil {
+		return 0, gerror.ErrStorageNotFound
+	}
+	return *depositCnt, nil
+}
+
+// GetPrunedDepositCount gets the number of deposits of the network whose historical merkle tree nodes are pruned.
+func (p *PostgresStorage) GetPrunedDepositCount(ctx context.Context, network uint, dbTx pgx.Tx) (uint, error) {
+	const getPrunedDepositCountSQL = "SELECT deposit_cnt FROM mt.prune_state WHERE network = $1"
+	var depositCnt uint
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getPrunedDepositCountSQL, network).Scan(&depositCnt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	return depositCnt, err
+}
+
+// PruneMerkleTree removes the nodes with the given keys stored by the deposit of the network, together with the root
+// of the deposit, and records the deposit as pruned. It returns the number of removed nodes.
+func (p *PostgresStorage) PruneMerkleTree(ctx context.Context, network uint, depositCount uint, keys [][]byte, dbTx pgx.Tx) (int64, error) {
+	const (
+		deleteNodesSQL = `DELETE FROM mt.rht WHERE key = ANY($3)
+			AND deposit_id = (SELECT id FROM sync.deposit WHERE network_id = $1 AND deposit_cnt = $2)`
+		deleteRootSQL = `DELETE FROM mt.root WHERE network = $1
+			AND deposit_id = (SELECT id FROM sync.deposit WHERE network_id = $1 AND deposit_cnt = $2)`
+		setPrunedDepositCountSQL = `INSERT INTO mt.prune_state (network, deposit_cnt) VALUES ($1, $2)
+			ON CONFLICT (network) DO UPDATE SET deposit_cnt = EXCLUDED.deposit_cnt`
+	)
+	e := p.getExecQuerier(dbTx)
+	res, err := e.Exec(ctx, deleteNodesSQL, network, depositCount, pq.Array(keys))
+	if err != nil {
+		return 0, err
+	}
+	if _, err = e.Exec(ctx, deleteRootSQL, network, depositCount); err != nil {
+		return 0, err
+	}
+	if _, err = e.Exec(ctx, setPrunedDepositCountSQL, network, depositCount+1); err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
+
+// IterateDepositRoots calls fn for each deposit of the network in the order of the deposit count, with its id and
+// the root of the merkle tree stored for it. The root is nil if it's not stored, like the pruned ones. The deposits
+// are read in pages, so fn can use the db transaction.
+func (p *PostgresStorage) IterateDepositRoots(ctx context.Context, networkID uint, fn func(depositID uint64, deposit *etherman.Deposit, root []byte) error, dbTx pgx.Tx) error {
+	const (
+		pageSize               = 10000
+		iterateDepositRootsSQL = `
+		SELECT d.id, d.leaf_type, d.orig_net, d.orig_addr, d.amount, d.dest_net, d.dest_addr, d.deposit_cnt, d.metadata, r.root
+		FROM sync.deposit d LEFT JOIN mt.root r ON r.deposit_id = d.id AND r.network = d.network_id
+		WHERE d.network_id = $1 AND d.deposit_cnt >= $2 ORDER BY d.deposit_cnt ASC LIMIT $3`
+	)
+	type depositRoot struct {
+		id      uint64
+		deposit etherman.Deposit
+		root    []byte
+	}
+	var from uint
+	for {
+		rows, err := p.getExecQuerier(dbTx).Query(ctx, iterateDepositRootsSQL, networkID, from, pageSize)
+		if err != nil {
+			return err
+		}
+		page := make([]depositRoot, 0, pageSize)
+		for rows.Next() {
+			var (
+				d      depositRoot
+				amount string
+			)
+			err := rows.Scan(&d.id, &d.deposit.LeafType, &d.deposit.OriginalNetwork, &d.deposit.OriginalAddress, &amount, &d.deposit.DestinationNetwork,
+				&d.deposit.DestinationAddress, &d.deposit.DepositCount, &d.deposit.Metadata, &d.root)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+			d.deposit.NetworkID = networkID
+			d.deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+			page = append(page, d)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		for i := range page {
+			if err := fn(page[i].id, &page[i].deposit, page[i].root); err != nil {
+				return err
+			}
+		}
+		if len(page) < pageSize {
+			return nil
+		}
+		from = page[len(page)-1].deposit.DepositCount + 1
+	}
+}
+
+// DeleteMerkleTreeFrom deletes the nodes and roots stored by the deposits of the network since the given deposit count.
+func (p *PostgresStorage) DeleteMerkleTreeFrom(ctx context.Context, networkID uint, depositCount uint, dbTx pgx.Tx) error {
+	const (
+		deleteNodesSQL = "DELETE FROM mt.rht WHERE deposit_id IN (SELECT id FROM sync.deposit WHERE network_id = $1 AND deposit_cnt >= $2)"
+		deleteRootsSQL = "DELETE FROM mt.root WHERE network = $1 AND deposit_id IN (SELECT id FROM sync.deposit WHERE network_id = $1 AND deposit_cnt >= $2)"
+	)
+	e := p.getExecQuerier(dbTx)
+	if _, err := e.Exec(ctx, deleteNodesSQL, networkID, depositCount); err != nil {
+		return err
+	}
+	_, err := e.Exec(ctx, deleteRootsSQL, networkID, depositCount)
+	return err
+}
+
+// IterateRollupExitRoots calls fn for each root of the rollup exit tree in the order it was stored, with its leaves
+// ordered by rollup id. The invalidated leaves are included, because they were the leaves of the root.
+func (p *PostgresStorage) IterateRollupExitRoots(ctx context.Context, fn func(root common.Hash, leaves []etherman.RollupExitLeaf) error, dbTx pgx.Tx) error {
+	const getLeavesSQL = "SELECT id, leaf, rollup_id, root, block_id FROM mt.rollup_exit ORDER BY id ASC"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getLeavesSQL)
+	if err != nil {
+		return err
+	}
+	var (
+		roots  []common.Hash
+		leaves = make(map[common.Hash][]etherman.RollupExitLeaf)
+	)
+	for rows.Next() {
+		var leaf etherman.RollupExitLeaf
+		if err := rows.Scan(&leaf.ID, &leaf.Leaf, &leaf.RollupId, &leaf.Root, &leaf.BlockID); err != nil {
+			rows.Close()
+			return err
+		}
+		if _, ok := leaves[leaf.Root]; !ok {
+			roots = append(roots, leaf.Root)
+		}
+		leaves[leaf.Root] = append(leaves[leaf.Root], leaf)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, root := range roots {
+		sort.SliceStable(leaves[root], func(i, j int) bool { return leaves[root][i].RollupId < leaves[root][j].RollupId })
+		if err := fn(root, leaves[root]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // AddRollupExitLeaves iinserts multiple entries into the db.
 func (p *PostgresStorage) AddRollupExitLeaves(ctx context.Context, rows [][]interface{}, dbTx pgx.Tx) error {
 	_, err := p.getExecQuerier(dbTx).CopyFrom(ctx, pgx.Identifier{"mt", "rollup_exit"}, []string{"leaf", "rollup_id", "root", "block_id"}, pgx.CopyFromRows(rows))
@@ -385,8 +936,8 @@ func (p *PostgresStorage) AddRollupExitLeaves(ctx context.Context, rows [][]inte
 
 // GetRollupExitLeavesByRoot gets the leaves of the rollupExitTree given a root
 func (p *PostgresStorage) GetRollupExitLeavesByRoot(ctx context.Context, root common.Hash, dbTx pgx.Tx) ([]etherman.RollupExitLeaf, error) {
-	const getLeavesSQL = "SELECT id, leaf, rollup_id, root, block_id FROM mt.rollup_exit WHERE root = $1 ORDER BY rollup_id ASC"
-	rows, err := p.getExecQuerier(dbTx).Query(ctx, getLeavesSQL, root)
+	const getLeavesSQL = "SELECT id, leaf, rollup_id, root, block_id FROM mt.rollup_exit WHERE root = $1 AND invalidated_by IS NULL ORDER BY rollup_id ASC"
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getLeavesSQL, root)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, gerror.ErrStorageNotFound
 	} else if err != nil {
@@ -407,7 +958,7 @@ func (p *PostgresStorage) GetRollupExitLeavesByRoot(ctx context.Context, root co
 
 // IsRollupExitRoot checks if db contains the root
 func (p *PostgresStorage) IsRollupExitRoot(ctx context.Context, root common.Hash, dbTx pgx.Tx) (bool, error) {
-	const getLeavesSQL = "SELECT count(*) FROM mt.rollup_exit WHERE root = $1"
+	const getLeavesSQL = "SELECT count(*) FROM mt.rollup_exit WHERE root = $1 AND invalidated_by IS NULL"
 	var count int
 	err := p.getExecQuerier(dbTx).QueryRow(ctx, getLeavesSQL, root).Scan(&count)
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -437,6 +988,17 @@ func (p *PostgresStorage) IsLxLyActivated(ctx context.Context, dbTx pgx.Tx) (boo
 	return false, nil
 }
 
+// GetLatestRollupExitRoot gets the latest root of the rollupExitTree that is not invalidated.
+func (p *PostgresStorage) GetLatestRollupExitRoot(ctx context.Context, dbTx pgx.Tx) (common.Hash, error) {
+	const getLatestRollupExitRootSQL = "SELECT root FROM mt.rollup_exit WHERE invalidated_by IS NULL ORDER BY id DESC LIMIT 1"
+	var root common.Hash
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getLatestRollupExitRootSQL).Scan(&root)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return root, gerror.ErrStorageNotFound
+	}
+	return root, err
+}
+
 // GetLatestRollupExitLeaves gets the latest leaves of the rollupExitTree
 func (p *PostgresStorage) GetLatestRollupExitLeaves(ctx context.Context, dbTx pgx.Tx) ([]etherman.RollupExitLeaf, error) {
 	const getLeavesSQL = `SELECT distinct re.id, re.leaf, re.rollup_id, re.root, re.block_id
@@ -444,6 +1006,7 @@ func (p *PostgresStorage) GetLatestRollupExitLeaves(ctx context.Context, dbTx pg
 		INNER JOIN
 			(SELECT distinct rollup_id, MAX(id) AS maxid
 			FROM mt.rollup_exit
+			WHERE invalidated_by IS NULL
 			GROUP BY rollup_id) groupedre
 		ON re.id = groupedre.maxid
 		ORDER BY rollup_id asc;
@@ -465,6 +1028,113 @@ func (p *PostgresStorage) GetLatestRollupExitLeaves(ctx context.Context, dbTx pg
 	return leaves, nil
 }
 
+// AddPendingStateChange adds a consolidation or an override of the pending state of a rollup.
+func (p *PostgresStorage) AddPendingStateChange(ctx context.Context, pendingState *etherman.PendingStateChange, dbTx pgx.Tx) error {
+	const addPendingStateChangeSQL = `INSERT INTO sync.pending_state_change (block_id, event, rollup_id, batch_num, state_root, exit_root, aggregator, pending_state_num, tx_hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`
+	return p.getExecQuerier(dbTx).QueryRow(ctx, addPendingStateChangeSQL, pendingState.BlockID, string(pendingState.Event), pendingState.RollupID, pendingState.BatchNumber,
+		pendingState.StateRoot, pendingState.ExitRoot, pendingState.Aggregator, pendingState.PendingStateNum, pendingState.TxHash).Scan(&pendingState.ID)
+}
+
+// InvalidateRollupExitLeaves invalidates the rollupExitTree roots that include a pending state discarded by the override.
+// The pending states of the rollup are the leaves that appeared after its previous pending state change. Leaves matching
+// the overridden exit root are kept. The override must be stored before. It returns the number of invalidated leaves.
+func (p *PostgresStorage) InvalidateRollupExitLeaves(ctx context.Context, override *etherman.PendingStateChange, dbTx pgx.Tx) (int64, error) {
+	const invalidateRollupExitLeavesSQL = `WITH consolidated AS (
+			SELECT coalesce(MAX(b.block_num), 0) AS block_num
+			FROM sync.pending_state_change psc INNER JOIN sync.block b ON psc.block_id = b.id
+			WHERE psc.rollup_id = $2 AND psc.id < $1
+		), pending AS (
+			SELECT re.leaf
+			FROM mt.rollup_exit re INNER JOIN sync.block b ON re.block_id = b.id
+			WHERE re.rollup_id = $2 AND re.leaf <> $3 AND re.invalidated_by IS NULL
+			GROUP BY re.leaf
+			HAVING MIN(b.block_num) > (SELECT block_num FROM consolidated)
+		)
+		UPDATE mt.rollup_exit SET invalidated_by = $1
+		WHERE invalidated_by IS NULL AND root IN
+			(SELECT root FROM mt.rollup_exit WHERE rollup_id = $2 AND leaf IN (SELECT leaf FROM pending))`
+	res, err := p.getExecQuerier(dbTx).Exec(ctx, invalidateRollupExitLeavesSQL, override.ID, override.RollupID, override.ExitRoot)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected(), nil
+}
+
+// AddEmergencyState adds an activation or deactivation of the emergency state.
+func (p *PostgresStorage) AddEmergencyState(ctx context.Context, emergencyState *etherman.EmergencyState, dbTx pgx.Tx) error {
+	const addEmergencyStateSQL = "INSERT INTO sync.emergency_state (block_id, network_id, address, activated, tx_hash) VALUES ($1, $2, $3, $4, $5)"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, addEmergencyStateSQL, emergencyState.BlockID, emergencyState.NetworkID, emergencyState.Address, emergencyState.Activated, emergencyState.TxHash)
+	return err
+}
+
+// GetEmergencyState gets the latest change of the emergency state in the network.
+func (p *PostgresStorage) GetEmergencyState(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.EmergencyState, error) {
+	const getEmergencyStateSQL = `SELECT es.block_id, b.block_num, es.network_id, es.address, es.activated, es.tx_hash
+		FROM sync.emergency_state es INNER JOIN sync.block b ON es.block_id = b.id
+		WHERE es.network_id = $1 ORDER BY b.block_num DESC, es.id DESC LIMIT 1`
+	var emergencyState etherman.EmergencyState
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getEmergencyStateSQL, networkID).Scan(&emergencyState.BlockID, &emergencyState.BlockNumber,
+		&emergencyState.NetworkID, &emergencyState.Address, &emergencyState.Activated, &emergencyState.TxHash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return &emergencyState, err
+}
+
+// AddRollup adds a rollup registered in the rollupManager.
+func (p *PostgresStorage) AddRollup(ctx context.Context, rollup *etherman.Rollup, dbTx pgx.Tx) error {
+	const addRollupSQL = `INSERT INTO sync.rollup (rollup_id, block_id, chain_id, rollup_address, gas_token_address, rollup_type_id, fork_id, rollup_compatibility_id, tx_hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, addRollupSQL, rollup.RollupID, rollup.BlockID, rollup.ChainID, rollup.RollupAddress, rollup.GasTokenAddress,
+		rollup.RollupTypeID, rollup.ForkID, rollup.RollupCompatibilityID, rollup.TxHash)
+	return err
+}
+
+// AddRollupUpdate adds an upgrade of a rollup to a new rollup type.
+func (p *PostgresStorage) AddRollupUpdate(ctx context.Context, update *etherman.RollupUpdate, dbTx pgx.Tx) error {
+	const addRollupUpdateSQL = "INSERT INTO sync.rollup_update (block_id, rollup_id, rollup_type_id, last_verified_batch, tx_hash) VALUES ($1, $2, $3, $4, $5)"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, addRollupUpdateSQL, update.BlockID, update.RollupID, update.RollupTypeID, update.LastVerifiedBatchBeforeUpgrade, update.TxHash)
+	return err
+}
+
+// AddObsoleteRollupType adds a rollup type made obsolete in the rollupManager.
+func (p *PostgresStorage) AddObsoleteRollupType(ctx context.Context, obsolete *etherman.ObsoleteRollupType, dbTx pgx.Tx) error {
+	const addObsoleteRollupTypeSQL = "INSERT INTO sync.obsolete_rollup_type (block_id, rollup_type_id, tx_hash) VALUES ($1, $2, $3)"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, addObsoleteRollupTypeSQL, obsolete.BlockID, obsolete.RollupTypeID, obsolete.TxHash)
+	return err
+}
+
+// GetRollups gets the rollups registered in the rollupManager with the rollup type of their latest upgrade.
+func (p *PostgresStorage) GetRollups(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Rollup, error) {
+	const getRollupsSQL = `SELECT r.rollup_id, r.block_id, b.block_num, r.chain_id, r.rollup_address, r.gas_token_address, coalesce(ru.rollup_type_id, r.rollup_type_id),
+			r.fork_id, r.rollup_compatibility_id, r.tx_hash, coalesce(ru.last_verified_batch, 0),
+			EXISTS (SELECT 1 FROM sync.obsolete_rollup_type ort WHERE ort.rollup_type_id = coalesce(ru.rollup_type_id, r.rollup_type_id))
+		FROM sync.rollup r INNER JOIN sync.block b ON r.block_id = b.id
+		LEFT JOIN LATERAL (
+			SELECT u.rollup_type_id, u.last_verified_batch
+			FROM sync.rollup_update u INNER JOIN sync.block ub ON u.block_id = ub.id
+			WHERE u.rollup_id = r.rollup_id ORDER BY ub.block_num DESC, u.id DESC LIMIT 1
+		) ru ON true
+		ORDER BY r.rollup_id ASC`
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getRollupsSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	rollups := make([]*etherman.Rollup, 0)
+	for rows.Next() {
+		var rollup etherman.Rollup
+		err = rows.Scan(&rollup.RollupID, &rollup.BlockID, &rollup.BlockNumber, &rollup.ChainID, &rollup.RollupAddress, &rollup.GasTokenAddress,
+			&rollup.RollupTypeID, &rollup.ForkID, &rollup.RollupCompatibilityID, &rollup.TxHash, &rollup.LastVerifiedBatchBeforeUpgrade, &rollup.RollupTypeObsolete)
+		if err != nil {
+			return nil, err
+		}
+		rollups = append(rollups, &rollup)
+	}
+	return rollups, rows.Err()
+}
+
 // GetLastDepositCount gets the last deposit count from the merkle tree.
 func (p *PostgresStorage) GetLastDepositCount(ctx context.Context, network uint, dbTx pgx.Tx) (uint, error) {
 	var depositCnt int64
@@ -483,7 +1153,7 @@ func (p *PostgresStorage) GetLastDepositCount(ctx context.Context, network uint,
 func (p *PostgresStorage) GetClaimCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error) {
 	const getClaimCountSQL = "SELECT COUNT(*) FROM sync.claim WHERE dest_addr = $1"
 	var claimCount uint64
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getClaimCountSQL, common.FromHex(destAddr)).Scan(&claimCount)
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getClaimCountSQL, common.FromHex(destAddr)).Scan(&claimCount)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return 0, gerror.ErrStorageNotFound
 	}
@@ -493,7 +1163,7 @@ func (p *PostgresStorage) GetClaimCount(ctx context.Context, destAddr string, db
 // GetClaims gets the claim list which be smaller than index.
 func (p *PostgresStorage) GetClaims(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Claim, error) {
 	const getClaimsSQL = "SELECT index, orig_net, orig_addr, amount, dest_addr, block_id, network_id, tx_hash, rollup_index, mainnet_flag FROM sync.claim WHERE dest_addr = $1 ORDER BY block_id DESC LIMIT $2 OFFSET $3"
-	rows, err := p.getExecQuerier(dbTx).Query(ctx, getClaimsSQL, common.FromHex(destAddr), limit, offset)
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getClaimsSQL, common.FromHex(destAddr), limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -514,83 +1184,185 @@ func (p *PostgresStorage) GetClaims(ctx context.Context, destAddr string, limit
 	return claims, nil
 }
 
-// GetDeposits gets the deposit list which be smaller than depositCount.
+// GetDeposits gets the deposits to the destination address, with the hash of the tx that claimed each one.
+// A claim is joined by its destination network, its deposit count and the origin network derived from its global index, as GetClaim looks it up.
 func (p *PostgresStorage) GetDeposits(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
-	const getDepositsSQL = "SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim FROM sync.deposit as d INNER JOIN sync.block as b ON d.network_id = b.network_id AND d.block_id = b.id WHERE dest_addr = $1 ORDER BY d.block_id DESC, d.deposit_cnt DESC LIMIT $2 OFFSET $3"
-	rows, err := p.getExecQuerier(dbTx).Query(ctx, getDepositsSQL, common.FromHex(destAddr), limit, offset)
+	const getDepositsSQL = `SELECT d.leaf_type, d.orig_net, d.orig_addr, d.amount, d.dest_net, d.dest_addr, d.deposit_cnt, d.block_id, b.block_num, d.network_id, d.tx_hash, d.metadata, d.ready_for_claim, b.received_at, c.tx_hash
+		FROM sync.deposit AS d INNER JOIN sync.block AS b ON d.network_id = b.network_id AND d.block_id = b.id
+		LEFT JOIN sync.claim AS c ON c.network_id = d.dest_net AND c.index = d.deposit_cnt
+			AND d.network_id = CASE WHEN c.mainnet_flag OR c.rollup_index + 1 = c.network_id THEN 0 ELSE c.rollup_index + 1 END
+		WHERE d.dest_addr = $1 ORDER BY d.block_id DESC, d.deposit_cnt DESC LIMIT $2 OFFSET $3`
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getDepositsSQL, common.FromHex(destAddr), limit, offset)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	deposits := make([]*etherman.Deposit, 0, len(rows.RawValues()))
 
 	for rows.Next() {
 		var (
-			deposit etherman.Deposit
-			amount  string
+			deposit     etherman.Deposit
+			amount      string
+			claimTxHash []byte
 		)
-		err = rows.Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim)
+		err = rows.Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim, &deposit.ReceivedAt, &claimTxHash)
 		if err != nil {
 			return nil, err
 		}
 		deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		if claimTxHash != nil {
+			txHash := common.BytesToHash(claimTxHash)
+			deposit.ClaimTxHash = &txHash
+		}
 		deposits = append(deposits, &deposit)
 	}
 
-	return deposits, nil
+	return deposits, rows.Err()
 }
 
 // GetDepositCount gets the deposit count for the destination address.
 func (p *PostgresStorage) GetDepositCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error) {
 	const getDepositCountSQL = "SELECT COUNT(*) FROM sync.deposit WHERE dest_addr = $1"
 	var depositCount uint64
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getDepositCountSQL, common.FromHex(destAddr)).Scan(&depositCount)
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, getDepositCountSQL, common.FromHex(destAddr)).Scan(&depositCount)
 	return depositCount, err
 }
 
-// UpdateBlocksForTesting updates the hash of blocks.
-func (p *PostgresStorage) UpdateBlocksForTesting(ctx context.Context, networkID uint, blockNum uint64, dbTx pgx.Tx) error {
-	const updateBlocksSQL = "UPDATE sync.block SET block_hash = SUBSTRING(block_hash FROM 1 FOR LENGTH(block_hash)-1) || '\x61' WHERE network_id = $1 AND block_num >= $2"
-	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateBlocksSQL, networkID, blockNum)
-	return err
-}
-
-// UpdateL1DepositsStatus updates the ready_for_claim status of L1 deposits.
-func (p *PostgresStorage) UpdateL1DepositsStatus(ctx context.Context, exitRoot []byte, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
-	const updateDepositsStatusSQL = `UPDATE sync.deposit SET ready_for_claim = true 
-		WHERE deposit_cnt <=
-			(SELECT sync.deposit.deposit_cnt FROM mt.root INNER JOIN sync.deposit ON sync.deposit.id = mt.root.deposit_id WHERE mt.root.root = $1 AND mt.root.network = 0) 
-			AND network_id = 0 AND ready_for_claim = false
-			RETURNING leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, network_id, tx_hash, metadata, ready_for_claim;`
-	rows, err := p.getExecQuerier(dbTx).Query(ctx, updateDepositsStatusSQL, exitRoot)
+// GetPendingClaims gets the deposits to the destination address that are ready for claim but not claimed yet.
+// The claim of a deposit is looked up by its destination network, its deposit count and the origin network derived from its global index, as GetClaim does.
+func (p *PostgresStorage) GetPendingClaims(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	const getPendingClaimsSQL = `SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, ready_for_claim, b.received_at
+		FROM sync.deposit AS d INNER JOIN sync.block AS b ON d.network_id = b.network_id AND d.block_id = b.id
+		WHERE d.dest_addr = $1 AND d.ready_for_claim AND NOT EXISTS (
+			SELECT 1 FROM sync.claim AS c WHERE c.network_id = d.dest_net AND c.index = d.deposit_cnt
+			AND d.network_id = CASE WHEN c.mainnet_flag OR c.rollup_index + 1 = c.network_id THEN 0 ELSE c.rollup_index + 1 END
+		)
+		ORDER BY d.block_id DESC, d.deposit_cnt DESC LIMIT $2 OFFSET $3`
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getPendingClaimsSQL, common.FromHex(destAddr), limit, offset)
 	if err != nil {
 		return nil, err
 	}
-
-	deposits := make([]*etherman.Deposit, 0, len(rows.RawValues()))
+	defer rows.Close()
+	deposits := make([]*etherman.Deposit, 0)
 	for rows.Next() {
 		var (
 			deposit etherman.Deposit
 			amount  string
 		)
-		err = rows.Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim)
+		err = rows.Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim, &deposit.ReceivedAt)
 		if err != nil {
 			return nil, err
 		}
 		deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
 		deposits = append(deposits, &deposit)
 	}
-	return deposits, nil
+	return deposits, rows.Err()
+}
+
+// GetPendingClaimTotals gets the value of each origin token that is ready for claim by the destination address but not claimed yet.
+// The value of the message deposits is accounted as ether.
+func (p *PostgresStorage) GetPendingClaimTotals(ctx context.Context, destAddr string, dbTx pgx.Tx) ([]*etherman.PendingClaimTotal, error) {
+	const getPendingClaimTotalsSQL = `SELECT CASE WHEN d.leaf_type = 1 THEN 0 ELSE d.orig_net END, CASE WHEN d.leaf_type = 1 THEN $2::BYTEA ELSE d.orig_addr END,
+			COUNT(*), SUM(d.amount::NUMERIC)::TEXT
+		FROM sync.deposit AS d
+		WHERE d.dest_addr = $1 AND d.ready_for_claim AND NOT EXISTS (
+			SELECT 1 FROM sync.claim AS c WHERE c.network_id = d.dest_net AND c.index = d.deposit_cnt
+			AND d.network_id = CASE WHEN c.mainnet_flag OR c.rollup_index + 1 = c.network_id THEN 0 ELSE c.rollup_index + 1 END
+		)
+		GROUP BY 1, 2 ORDER BY 1 ASC, 2 ASC`
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getPendingClaimTotalsSQL, common.FromHex(destAddr), common.Address{})
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	totals := make([]*etherman.PendingClaimTotal, 0)
+	for rows.Next() {
+		var (
+			total  etherman.PendingClaimTotal
+			amount string
+		)
+		err = rows.Scan(&total.OriginalNetwork, &total.OriginalAddress, &total.Count, &amount)
+		if err != nil {
+			return nil, err
+		}
+		total.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		totals = append(totals, &total)
+	}
+	return totals, rows.Err()
+}
+
+// UpdateBlocksForTesting updates the hash of blocks.
+func (p *PostgresStorage) UpdateBlocksForTesting(ctx context.Context, networkID uint, blockNum uint64, dbTx pgx.Tx) error {
+	const updateBlocksSQL = "UPDATE sync.block SET block_hash = SUBSTRING(block_hash FROM 1 FOR LENGTH(block_hash)-1) || '\x61' WHERE network_id = $1 AND block_num >= $2"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateBlocksSQL, networkID, blockNum)
+	return err
+}
+
+// GetClaimableDelay gets the median delay between the block of a deposit and the block of the first exit root synced from L1
+// that covers it, for the latest deposits of the network that are ready for claim. The deposits of L1 are covered by the mainnet
+// exit root of a global exit root and the deposits of a rollup by a verified rollup exit leaf.
+func (p *PostgresStorage) GetClaimableDelay(ctx context.Context, networkID uint, limit uint, dbTx pgx.Tx) (time.Duration, error) {
+	const getClaimableDelaySQL = `WITH latest AS (
+			SELECT d.deposit_cnt, b.received_at FROM sync.deposit d INNER JOIN sync.block b ON d.block_id = b.id
+			WHERE d.network_id = $1 AND d.ready_for_claim ORDER BY d.deposit_cnt DESC LIMIT $2
+		)
+		SELECT EXTRACT(EPOCH FROM percentile_cont(0.5) WITHIN GROUP (ORDER BY covered.received_at - latest.received_at))::FLOAT8
+		FROM latest CROSS JOIN LATERAL (%s) covered`
+	// The mainnet exit root is the first element of the exit roots
+	const mainnetCoveredSQL = `SELECT b.received_at FROM sync.exit_root ge
+		INNER JOIN sync.block b ON ge.block_id = b.id
+		INNER JOIN mt.root r ON r.root = ge.exit_roots[1] AND r.network = $1
+		INNER JOIN sync.deposit rd ON rd.id = r.deposit_id
+		WHERE ge.block_id > 0 AND rd.deposit_cnt >= latest.deposit_cnt
+		ORDER BY b.block_num ASC LIMIT 1`
+	const rollupCoveredSQL = `SELECT b.received_at FROM mt.rollup_exit re
+		INNER JOIN sync.block b ON re.block_id = b.id
+		INNER JOIN mt.root r ON r.root = re.leaf AND r.network = $1
+		INNER JOIN sync.deposit rd ON rd.id = r.deposit_id
+		WHERE re.rollup_id = $1 AND re.invalidated_by IS NULL AND rd.deposit_cnt >= latest.deposit_cnt
+		ORDER BY re.id ASC LIMIT 1`
+	coveredSQL := rollupCoveredSQL
+	if networkID == 0 {
+		coveredSQL = mainnetCoveredSQL
+	}
+	var seconds *float64
+	err := p.getReadQuerier(ctx, dbTx).QueryRow(ctx, fmt.Sprintf(getClaimableDelaySQL, coveredSQL), networkID, limit).Scan(&seconds)
+	if err != nil {
+		return 0, err
+	}
+	if seconds == nil {
+		return 0, gerror.ErrStorageNotFound
+	}
+	return time.Duration(*seconds * float64(time.Second)), nil
+}
+
+// UpdateL1DepositsStatus updates the ready_for_claim status of the L1 deposits to the destination network.
+func (p *PostgresStorage) UpdateL1DepositsStatus(ctx context.Context, exitRoot []byte, destNetworkID uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	const updateDepositsStatusSQL = `UPDATE sync.deposit SET ready_for_claim = true 
+		WHERE deposit_cnt <=
+			(SELECT sync.deposit.deposit_cnt FROM mt.root INNER JOIN sync.deposit ON sync.deposit.id = mt.root.deposit_id WHERE mt.root.root = $1 AND mt.root.network = 0) 
+			AND network_id = 0 AND dest_net = $2 AND ready_for_claim = false
+			RETURNING leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, (SELECT block_num FROM sync.block WHERE id = block_id), network_id, tx_hash, metadata, ready_for_claim;`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, updateDepositsStatusSQL, exitRoot, destNetworkID)
+	if err != nil {
+		return nil, err
+	}
+	return scanUpdatedDeposits(rows)
 }
 
 // UpdateL2DepositsStatus updates the ready_for_claim status of L2 deposits.
-func (p *PostgresStorage) UpdateL2DepositsStatus(ctx context.Context, exitRoot []byte, rollupID, networkID uint, dbTx pgx.Tx) error {
+func (p *PostgresStorage) UpdateL2DepositsStatus(ctx context.Context, exitRoot []byte, rollupID, networkID uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
 	const updateDepositsStatusSQL = `UPDATE sync.deposit SET ready_for_claim = true
 		WHERE deposit_cnt <=
-		(SELECT sync.deposit.deposit_cnt FROM mt.root INNER JOIN sync.deposit ON sync.deposit.id = mt.root.deposit_id WHERE mt.root.root = (select leaf from mt.rollup_exit where root = $1 and rollup_id = $2) AND mt.root.network = $3)
-			AND network_id = $3 AND ready_for_claim = false;`
-	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateDepositsStatusSQL, exitRoot, rollupID, networkID)
-	return err
+		(SELECT sync.deposit.deposit_cnt FROM mt.root INNER JOIN sync.deposit ON sync.deposit.id = mt.root.deposit_id WHERE mt.root.root = (select leaf from mt.rollup_exit where root = $1 and rollup_id = $2 and invalidated_by IS NULL) AND mt.root.network = $3)
+			AND network_id = $3 AND ready_for_claim = false
+			RETURNING leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, (SELECT block_num FROM sync.block WHERE id = block_id), network_id, tx_hash, metadata, ready_for_claim;`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, updateDepositsStatusSQL, exitRoot, rollupID, networkID)
+	if err != nil {
+		return nil, err
+	}
+	return scanUpdatedDeposits(rows)
 }
 
 // AddClaimTx adds a claim monitored transaction to the storage.
@@ -657,3 +1429,210 @@ func (p *PostgresStorage) UpdateDepositsStatusForTesting(ctx context.Context, db
 	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateDepositsStatusSQL)
 	return err
 }
+
+// GetBridgeStats gets the bridge volume statistics between from (inclusive) and to (exclusive), grouped by interval ("hour" or "day").
+// If origNet and origAddr are not nil, only the statistics of that origin token are returned.
+func (p *PostgresStorage) GetBridgeStats(ctx context.Context, interval string, from, to time.Time, origNet *uint, origAddr *common.Address, dbTx pgx.Tx) ([]*etherman.BridgeStats, error) {
+	const getBridgeStatsSQL = `SELECT date_trunc($1, bucket) AS period, orig_net, orig_addr, from_net, to_net, SUM(deposit_cnt)::BIGINT, SUM(deposit_amount)::TEXT, SUM(claim_cnt)::BIGINT, SUM(claim_amount)::TEXT
+		FROM sync.bridge_stats WHERE bucket >= $2 AND bucket < $3 AND ($4::INTEGER IS NULL OR orig_net = $4) AND ($5::BYTEA IS NULL OR orig_addr = $5)
+		GROUP BY period, orig_net, orig_addr, from_net, to_net ORDER BY period ASC, orig_net ASC, orig_addr ASC, from_net ASC, to_net ASC`
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getBridgeStatsSQL, interval, from.UTC(), to.UTC(), origNet, origAddr)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	stats := make([]*etherman.BridgeStats, 0)
+	for rows.Next() {
+		var (
+			s                          etherman.BridgeStats
+			depositAmount, claimAmount string
+		)
+		err = rows.Scan(&s.Bucket, &s.OriginalNetwork, &s.OriginalAddress, &s.FromNetwork, &s.ToNetwork, &s.DepositCount, &depositAmount, &s.ClaimCount, &claimAmount)
+		if err != nil {
+			return nil, err
+		}
+		s.DepositAmount, _ = new(big.Int).SetString(depositAmount, 10) //nolint:gomnd
+		s.ClaimAmount, _ = new(big.Int).SetString(claimAmount, 10)     //nolint:gomnd
+		stats = append(stats, &s)
+	}
+	return stats, rows.Err()
+}
+
+// GetUnclaimedStats gets the value of each origin token and direction that is deposited but not claimed yet.
+// If origNet and origAddr are not nil, only the value of that origin token is returned.
+func (p *PostgresStorage) GetUnclaimedStats(ctx context.Context, origNet *uint, origAddr *common.Address, dbTx pgx.Tx) ([]*etherman.UnclaimedStats, error) {
+	const getUnclaimedStatsSQL = `SELECT orig_net, orig_addr, from_net, to_net, GREATEST(SUM(deposit_cnt) - SUM(claim_cnt), 0)::BIGINT, GREATEST(SUM(deposit_amount) - SUM(claim_amount), 0)::TEXT
+		FROM sync.bridge_stats WHERE ($1::INTEGER IS NULL OR orig_net = $1) AND ($2::BYTEA IS NULL OR orig_addr = $2)
+		GROUP BY orig_net, orig_addr, from_net, to_net HAVING SUM(deposit_amount) > SUM(claim_amount)
+		ORDER BY orig_net ASC, orig_addr ASC, from_net ASC, to_net ASC`
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getUnclaimedStatsSQL, origNet, origAddr)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	stats := make([]*etherman.UnclaimedStats, 0)
+	for rows.Next() {
+		var (
+			s      etherman.UnclaimedStats
+			amount string
+		)
+		err = rows.Scan(&s.OriginalNetwork, &s.OriginalAddress, &s.FromNetwork, &s.ToNetwork, &s.Count, &amount)
+		if err != nil {
+			return nil, err
+		}
+		s.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		stats = append(stats, &s)
+	}
+	return stats, rows.Err()
+}
+
+// GetTokenBalances gets the amount of each origin token deposited from the network and claimed in it up to the block number.
+// The value of the message deposits is accounted as ether.
+func (p *PostgresStorage) GetTokenBalances(ctx context.Context, networkID uint, blockNumber uint64, dbTx pgx.Tx) ([]*etherman.TokenBalance, error) {
+	const getTokenBalancesSQL = `SELECT t.orig_net, t.orig_addr, w.wrapped_token_addr, SUM(t.deposited)::TEXT, SUM(t.claimed)::TEXT
+		FROM (
+			SELECT CASE WHEN d.leaf_type = 1 THEN 0 ELSE d.orig_net END AS orig_net, CASE WHEN d.leaf_type = 1 THEN $3::BYTEA ELSE d.orig_addr END AS orig_addr,
+				d.amount::NUMERIC AS deposited, 0 AS claimed
+			FROM sync.deposit AS d INNER JOIN sync.block AS b ON d.block_id = b.id
+			WHERE d.network_id = $1 AND b.block_num <= $2
+			UNION ALL
+			SELECT CASE WHEN d.leaf_type = 1 THEN 0 ELSE c.orig_net END, CASE WHEN d.leaf_type = 1 THEN $3::BYTEA ELSE c.orig_addr END,
+				0, c.amount::NUMERIC
+			FROM sync.claim AS c INNER JOIN sync.block AS b ON c.block_id = b.id
+			LEFT JOIN sync.deposit AS d ON d.network_id = CASE WHEN c.mainnet_flag OR c.rollup_index + 1 = c.network_id THEN 0 ELSE c.rollup_index + 1 END AND d.deposit_cnt = c.index
+			WHERE c.network_id = $1 AND b.block_num <= $2
+		) AS t
+		LEFT JOIN sync.token_wrapped AS w ON w.network_id = $1 AND w.orig_net = t.orig_net AND w.orig_token_addr = t.orig_addr
+		GROUP BY t.orig_net, t.orig_addr, w.wrapped_token_addr ORDER BY t.orig_net ASC, t.orig_addr ASC`
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getTokenBalancesSQL, networkID, blockNumber, common.Address{})
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	balances := make([]*etherman.TokenBalance, 0)
+	for rows.Next() {
+		var (
+			balance            etherman.TokenBalance
+			wrappedAddress     []byte
+			deposited, claimed string
+		)
+		err = rows.Scan(&balance.OriginalNetwork, &balance.OriginalAddress, &wrappedAddress, &deposited, &claimed)
+		if err != nil {
+			return nil, err
+		}
+		if len(wrappedAddress) > 0 {
+			address := common.BytesToAddress(wrappedAddress)
+			balance.WrappedAddress = &address
+		}
+		balance.Deposited, _ = new(big.Int).SetString(deposited, 10) //nolint:gomnd
+		balance.Claimed, _ = new(big.Int).SetString(claimed, 10)     //nolint:gomnd
+		balances = append(balances, &balance)
+	}
+	return balances, rows.Err()
+}
+
+// GetInconsistentClaims gets the claims that don't match a single synced deposit of their global index: the claims
+// of a global index that is claimed more than once, the claims whose fields differ from the deposit ones and the
+// claims whose deposit is not synced although the source network is synced beyond it.
+func (p *PostgresStorage) GetInconsistentClaims(ctx context.Context, dbTx pgx.Tx) ([]*etherman.InconsistentClaim, error) {
+	const getInconsistentClaimsSQL = `WITH claims AS (
+			SELECT c.*, CASE WHEN c.mainnet_flag OR c.rollup_index + 1 = c.network_id THEN 0 ELSE c.rollup_index + 1 END AS source_net,
+				COUNT(*) OVER (PARTITION BY CASE WHEN c.mainnet_flag OR c.rollup_index + 1 = c.network_id THEN 0 ELSE c.rollup_index + 1 END, c.index) AS claim_cnt
+			FROM sync.claim AS c
+		), last_deposits AS (
+			SELECT network_id, MAX(deposit_cnt) AS deposit_cnt FROM sync.deposit GROUP BY network_id
+		)
+		SELECT c.network_id, c.index, c.orig_net, c.orig_addr, c.amount, c.dest_addr, cb.block_num, c.block_id, c.tx_hash, c.rollup_index, c.mainnet_flag, c.claim_cnt,
+			d.id IS NOT NULL, COALESCE(d.leaf_type, 0), COALESCE(d.network_id, 0), COALESCE(d.orig_net, 0), COALESCE(d.orig_addr, ''::BYTEA), COALESCE(d.amount, '0'),
+			COALESCE(d.dest_net, 0), COALESCE(d.dest_addr, ''::BYTEA), COALESCE(db.block_num, 0), COALESCE(d.block_id, 0), COALESCE(d.deposit_cnt, 0), COALESCE(d.tx_hash, ''::BYTEA), COALESCE(d.metadata, ''::BYTEA)
+		FROM claims AS c INNER JOIN sync.block AS cb ON c.block_id = cb.id
+		LEFT JOIN sync.deposit AS d ON d.network_id = c.source_net AND d.deposit_cnt = c.index
+		LEFT JOIN sync.block AS db ON d.block_id = db.id
+		LEFT JOIN last_deposits AS l ON l.network_id = c.source_net
+		WHERE c.claim_cnt > 1
+			OR (d.id IS NULL AND c.index <= l.deposit_cnt)
+			OR (d.id IS NOT NULL AND (d.orig_net != c.orig_net OR d.orig_addr != c.orig_addr OR d.amount::NUMERIC != c.amount::NUMERIC OR d.dest_addr != c.dest_addr OR d.dest_net != c.network_id))
+		ORDER BY c.network_id ASC, c.source_net ASC, c.index ASC`
+	rows, err := p.getReadQuerier(ctx, dbTx).Query(ctx, getInconsistentClaimsSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	claims := make([]*etherman.InconsistentClaim, 0)
+	for rows.Next() {
+		var (
+			inconsistent                                    etherman.InconsistentClaim
+			deposit                                         etherman.Deposit
+			found                                           bool
+			claimAmount, depositAmount                      string
+			depositOrigAddr, depositDestAddr, depositTxHash []byte
+		)
+		err = rows.Scan(&inconsistent.Claim.NetworkID, &inconsistent.Claim.Index, &inconsistent.Claim.OriginalNetwork, &inconsistent.Claim.OriginalAddress, &claimAmount,
+			&inconsistent.Claim.DestinationAddress, &inconsistent.Claim.BlockNumber, &inconsistent.Claim.BlockID, &inconsistent.Claim.TxHash, &inconsistent.Claim.RollupIndex,
+			&inconsistent.Claim.MainnetFlag, &inconsistent.ClaimCount, &found, &deposit.LeafType, &deposit.NetworkID, &deposit.OriginalNetwork, &depositOrigAddr, &depositAmount,
+			&deposit.DestinationNetwork, &depositDestAddr, &deposit.BlockNumber, &deposit.BlockID, &deposit.DepositCount, &depositTxHash, &deposit.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		inconsistent.Claim.Amount, _ = new(big.Int).SetString(claimAmount, 10) //nolint:gomnd
+		if found {
+			deposit.OriginalAddress = common.BytesToAddress(depositOrigAddr)
+			deposit.DestinationAddress = common.BytesToAddress(depositDestAddr)
+			deposit.TxHash = common.BytesToHash(depositTxHash)
+			deposit.Amount, _ = new(big.Int).SetString(depositAmount, 10) //nolint:gomnd
+			inconsistent.Deposit = &deposit
+		}
+		claims = append(claims, &inconsistent)
+	}
+	return claims, rows.Err()
+}
+
+// AddWebhookEvents adds events to the webhook outbox. They are ready to be delivered right away.
+func (p *PostgresStorage) AddWebhookEvents(ctx context.Context, events []*etherman.WebhookEvent, dbTx pgx.Tx) error {
+	const addWebhookEventSQL = "INSERT INTO sync.webhook_event (event_type, payload, created_at, next_attempt_at) VALUES ($1, $2, $3, $3) RETURNING id"
+	e := p.getExecQuerier(dbTx)
+	for _, event := range events {
+		event.CreatedAt = time.Now().UTC()
+		event.NextAttemptAt = event.CreatedAt
+		err := e.QueryRow(ctx, addWebhookEventSQL, event.Type, event.Payload, event.CreatedAt).Scan(&event.ID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPendingWebhookEvents gets the oldest events of the webhook outbox whose next attempt is due.
+func (p *PostgresStorage) GetPendingWebhookEvents(ctx context.Context, now time.Time, limit uint, dbTx pgx.Tx) ([]*etherman.WebhookEvent, error) {
+	const getPendingWebhookEventsSQL = `SELECT id, event_type, payload, created_at, attempts, next_attempt_at, COALESCE(last_error, '') FROM sync.webhook_event
+		WHERE next_attempt_at <= $1 ORDER BY id ASC LIMIT $2`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getPendingWebhookEventsSQL, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	events := make([]*etherman.WebhookEvent, 0)
+	for rows.Next() {
+		var event etherman.WebhookEvent
+		err = rows.Scan(&event.ID, &event.Type, &event.Payload, &event.CreatedAt, &event.Attempts, &event.NextAttemptAt, &event.LastError)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+	return events, rows.Err()
+}
+
+// UpdateWebhookEvent updates the delivery attempts of an event of the webhook outbox.
+func (p *PostgresStorage) UpdateWebhookEvent(ctx context.Context, event *etherman.WebhookEvent, dbTx pgx.Tx) error {
+	const updateWebhookEventSQL = "UPDATE sync.webhook_event SET attempts = $2, next_attempt_at = $3, last_error = $4 WHERE id = $1"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateWebhookEventSQL, event.ID, event.Attempts, event.NextAttemptAt, event.LastError)
+	return err
+}
+
+// DeleteWebhookEvent removes a delivered event from the webhook outbox.
+func (p *PostgresStorage) DeleteWebhookEvent(ctx context.Context, id uint64, dbTx pgx.Tx) error {
+	const deleteWebhookEventSQL = "DELETE FROM sync.webhook_event WHERE id = $1"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, deleteWebhookEventSQL, id)
+	return err
+}
@@ -1,9 +1,14 @@
 package pgstorage
 
 import (
+	"bytes"
 	"context"
+	"math/big"
 	"testing"
+	"time"
 
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -145,3 +150,715 @@ func TestIsRollupExitRoot(t *testing.T) {
 
 	assert.Equal(t, true, exist)
 }
+
+func TestReorgJournal(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 1, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(2, 2, decode('5C7832','hex'), decode('5C7831','hex'), 1, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.deposit
+	(leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata)
+	VALUES(0, 1, 0, decode('0000000000000000000000000000000000000000','hex'), '100', 0, decode('C949254D682D8C9AD5682521675B8F43B102AEC4','hex'), 1, 0, decode('C2D6575EA98EB55E36B5AC6E11196800362594458A4B3143DB50E4995CB2422E','hex'), decode('','hex'));
+	INSERT INTO sync.deposit
+	(leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata)
+	VALUES(0, 1, 0, decode('0000000000000000000000000000000000000000','hex'), '200', 0, decode('C949254D682D8C9AD5682521675B8F43B102AEC4','hex'), 2, 1, decode('C2D6575EA98EB55E36B5AC6E11196800362594458A4B3143DB50E4995CB2422F','hex'), decode('','hex'));
+	INSERT INTO sync.claim
+	(network_id, index, orig_net, orig_addr, amount, dest_addr, block_id, tx_hash, rollup_index, mainnet_flag)
+	VALUES(1, 3, 0, decode('0000000000000000000000000000000000000000','hex'), '300', decode('14567C0DCF79C20FE1A21E36EC975D1775A1905C','hex'), 2, decode('A9505DB7D7EDD08947F12F2B1F7898148FFB43D80BCB977B78161EF14173D575','hex'), 0, true);
+	`
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	_, err = store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	reorg := &etherman.Reorg{
+		NetworkID:       1,
+		ForkBlockNumber: 1,
+		Depth:           1,
+		OldBlockHash:    common.HexToHash("0x5C7832"),
+		NewBlockHash:    common.HexToHash("0x5C7833"),
+		DetectedAt:      time.Now().UTC(),
+	}
+	dbTx, err := store.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	err = store.AddReorg(ctx, reorg, dbTx)
+	require.NoError(t, err)
+	err = store.Reset(ctx, reorg.ForkBlockNumber, reorg.NetworkID, dbTx)
+	require.NoError(t, err)
+	require.NoError(t, store.Commit(ctx, dbTx))
+
+	assert.Equal(t, uint64(1), reorg.ID)
+	require.Len(t, reorg.Deposits, 1)
+	assert.Equal(t, uint(1), reorg.Deposits[0].DepositCount)
+	assert.Equal(t, "200", reorg.Deposits[0].Amount.String())
+	require.Len(t, reorg.Claims, 1)
+	assert.Equal(t, uint(3), reorg.Claims[0].Index)
+	assert.Len(t, reorg.GlobalExitRoots, 0)
+
+	count, err := store.GetReorgCount(ctx, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), count)
+	reorgs, err := store.GetReorgs(ctx, 1, 10, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, reorgs, 1)
+	assert.Equal(t, reorg.OldBlockHash, reorgs[0].OldBlockHash)
+	assert.Equal(t, reorg.NewBlockHash, reorgs[0].NewBlockHash)
+	require.Len(t, reorgs[0].Deposits, 1)
+	assert.Equal(t, uint64(2), reorgs[0].Deposits[0].BlockNumber)
+	require.Len(t, reorgs[0].Claims, 1)
+	assert.Equal(t, "300", reorgs[0].Claims[0].Amount.String())
+
+	// The deposit of the fork block is still stored
+	deposit, err := store.GetDeposit(ctx, 0, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "100", deposit.Amount.String())
+}
+
+func TestInvalidateRollupExitLeaves(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(2, 2, decode('5C7832','hex'), decode('5C7831','hex'), 0, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(3, 3, decode('5C7833','hex'), decode('5C7832','hex'), 0, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(4, 4, decode('5C7834','hex'), decode('5C7833','hex'), 0, '1970-01-01 01:00:00.000');
+
+	INSERT INTO sync.pending_state_change
+	(block_id, event, rollup_id, batch_num, state_root, exit_root, tx_hash)
+	VALUES(1, 'trustedVerifyBatches', 1, 1, decode('5C7831','hex'), decode('A4BFA0908DC7B06D98DA4309F859023D6947561BC19BC00D77F763DEA1A0B9F5','hex'), decode('5C7831','hex'));
+
+	INSERT INTO mt.rollup_exit
+	(leaf, rollup_id, root, block_id)
+	VALUES(decode('A4BFA0908DC7B06D98DA4309F859023D6947561BC19BC00D77F763DEA1A0B9F5','hex'), 1, decode('42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25721','hex'), 1);
+	INSERT INTO mt.rollup_exit
+	(leaf, rollup_id, root, block_id)
+	VALUES(decode('315FEE1AA202BF4A6BD0FDE560C89BE90B6E6E2AAF92DC5E8D118209ABC3410F','hex'), 2, decode('42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25721','hex'), 1);
+
+	INSERT INTO mt.rollup_exit
+	(leaf, rollup_id, root, block_id)
+	VALUES(decode('A4BFA0908DC7B06D98DA4309F859023D6947561BC19BC00D77F763DEA1A0B9F6','hex'), 1, decode('42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25722','hex'), 2);
+	INSERT INTO mt.rollup_exit
+	(leaf, rollup_id, root, block_id)
+	VALUES(decode('315FEE1AA202BF4A6BD0FDE560C89BE90B6E6E2AAF92DC5E8D118209ABC3410F','hex'), 2, decode('42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25722','hex'), 2);
+
+	INSERT INTO mt.rollup_exit
+	(leaf, rollup_id, root, block_id)
+	VALUES(decode('A4BFA0908DC7B06D98DA4309F859023D6947561BC19BC00D77F763DEA1A0B9F6','hex'), 1, decode('42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25723','hex'), 3);
+	INSERT INTO mt.rollup_exit
+	(leaf, rollup_id, root, block_id)
+	VALUES(decode('315FEE1AA202BF4A6BD0FDE560C89BE90B6E6E2AAF92DC5E8D118209ABC34110','hex'), 2, decode('42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25723','hex'), 3);
+	`
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	_, err = store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	override := &etherman.PendingStateChange{
+		BlockID:     4,
+		BlockNumber: 4,
+		Event:       etherman.OverridePendingStateEvent,
+		RollupID:    1,
+		BatchNumber: 3,
+		ExitRoot:    common.HexToHash("0xA4BFA0908DC7B06D98DA4309F859023D6947561BC19BC00D77F763DEA1A0B9F7"),
+		TxHash:      common.HexToHash("0x5C7834"),
+	}
+	err = store.AddPendingStateChange(ctx, override, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), override.ID)
+
+	// The pending state of the rollup 1 was added in the block 2, so the roots of the blocks 2 and 3 are invalidated
+	invalidated, err := store.InvalidateRollupExitLeaves(ctx, override, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), invalidated)
+
+	exist, err := store.IsRollupExitRoot(ctx, common.HexToHash("0x42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25723"), nil)
+	require.NoError(t, err)
+	assert.False(t, exist)
+
+	leaves, err := store.GetLatestRollupExitLeaves(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, leaves, 2)
+	assert.Equal(t, "0xa4bfa0908dc7b06d98da4309f859023d6947561bc19bc00d77f763dea1a0b9f5", leaves[0].Leaf.String())
+	assert.Equal(t, "0x42d3339fe8eb57770953423f20a029e778a707e8d58aaf110b40d5eb4dd25721", leaves[0].Root.String())
+	root, err := store.GetLatestRollupExitRoot(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "0x42d3339fe8eb57770953423f20a029e778a707e8d58aaf110b40d5eb4dd25721", root.String())
+
+	// Reorging the override makes the roots valid again
+	_, err = store.Exec(ctx, "DELETE FROM sync.block WHERE id = 4")
+	require.NoError(t, err)
+	exist, err = store.IsRollupExitRoot(ctx, common.HexToHash("0x42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25723"), nil)
+	require.NoError(t, err)
+	assert.True(t, exist)
+	root, err = store.GetLatestRollupExitRoot(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "0x42d3339fe8eb57770953423f20a029e778a707e8d58aaf110b40d5eb4dd25723", root.String())
+}
+
+func TestEmergencyState(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(2, 2, decode('5C7832','hex'), decode('5C7831','hex'), 0, '1970-01-01 01:00:00.000');
+	`
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	_, err = store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	_, err = store.GetEmergencyState(ctx, 0, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	bridgeAddr := common.HexToAddress("0x14567C0DCF79C20FE1A21E36EC975D1775A1905C")
+	err = store.AddEmergencyState(ctx, &etherman.EmergencyState{BlockID: 1, NetworkID: 0, Address: bridgeAddr, Activated: true, TxHash: common.HexToHash("0x5C7831")}, nil)
+	require.NoError(t, err)
+	emergencyState, err := store.GetEmergencyState(ctx, 0, nil)
+	require.NoError(t, err)
+	assert.True(t, emergencyState.Activated)
+	assert.Equal(t, uint64(1), emergencyState.BlockNumber)
+	assert.Equal(t, bridgeAddr, emergencyState.Address)
+
+	err = store.AddEmergencyState(ctx, &etherman.EmergencyState{BlockID: 2, NetworkID: 0, Address: bridgeAddr, Activated: false, TxHash: common.HexToHash("0x5C7832")}, nil)
+	require.NoError(t, err)
+	emergencyState, err = store.GetEmergencyState(ctx, 0, nil)
+	require.NoError(t, err)
+	assert.False(t, emergencyState.Activated)
+	assert.Equal(t, uint64(2), emergencyState.BlockNumber)
+
+	_, err = store.GetEmergencyState(ctx, 1, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+}
+
+func TestGetLastBlocks(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(2, 2, decode('5C7832','hex'), decode('5C7831','hex'), 0, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(3, 7, decode('5C7833','hex'), decode('5C7830','hex'), 1, '1970-01-01 01:00:00.000');
+	`
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	_, err = store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	blocks, err := store.GetLastBlocks(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	assert.Equal(t, uint(0), blocks[0].NetworkID)
+	assert.Equal(t, uint64(2), blocks[0].BlockNumber)
+	assert.Equal(t, uint(1), blocks[1].NetworkID)
+	assert.Equal(t, uint64(7), blocks[1].BlockNumber)
+}
+
+func TestRollups(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(2, 2, decode('5C7832','hex'), decode('5C7831','hex'), 0, '1970-01-01 01:00:00.000');
+	`
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	_, err = store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	rollups, err := store.GetRollups(ctx, nil)
+	require.NoError(t, err)
+	assert.Len(t, rollups, 0)
+
+	existing := &etherman.Rollup{BlockID: 1, RollupID: 1, ChainID: 1101, RollupAddress: common.HexToAddress("0x1"), ForkID: 6, RollupCompatibilityID: 0, TxHash: common.HexToHash("0x5C7831")}
+	err = store.AddRollup(ctx, existing, nil)
+	require.NoError(t, err)
+	created := &etherman.Rollup{BlockID: 2, RollupID: 2, ChainID: 1102, RollupAddress: common.HexToAddress("0x2"), GasTokenAddress: common.HexToAddress("0x3"), RollupTypeID: 1, TxHash: common.HexToHash("0x5C7832")}
+	err = store.AddRollup(ctx, created, nil)
+	require.NoError(t, err)
+
+	rollups, err = store.GetRollups(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, rollups, 2)
+	existing.BlockNumber, created.BlockNumber = 1, 2
+	assert.Equal(t, existing, rollups[0])
+	assert.Equal(t, created, rollups[1])
+
+	// The existing rollup is upgraded to the rollup type 1 and then the rollup type 1 is made obsolete
+	err = store.AddRollupUpdate(ctx, &etherman.RollupUpdate{BlockID: 2, RollupID: 1, RollupTypeID: 1, LastVerifiedBatchBeforeUpgrade: 10, TxHash: common.HexToHash("0x5C7832")}, nil)
+	require.NoError(t, err)
+	err = store.AddObsoleteRollupType(ctx, &etherman.ObsoleteRollupType{BlockID: 2, RollupTypeID: 1, TxHash: common.HexToHash("0x5C7832")}, nil)
+	require.NoError(t, err)
+	rollups, err = store.GetRollups(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, rollups, 2)
+	assert.Equal(t, uint(1), rollups[0].RollupTypeID)
+	assert.Equal(t, uint64(10), rollups[0].LastVerifiedBatchBeforeUpgrade)
+	assert.True(t, rollups[0].RollupTypeObsolete)
+	assert.True(t, rollups[1].RollupTypeObsolete)
+
+	// The rollups, upgrades and obsolete rollup types of a reorged block are removed
+	_, err = store.Exec(ctx, "DELETE FROM sync.block WHERE id = 2")
+	require.NoError(t, err)
+	rollups, err = store.GetRollups(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, rollups, 1)
+	assert.Equal(t, existing, rollups[0])
+}
+
+func TestGetClaimableDelay(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(2, 2, decode('5C7832','hex'), decode('5C7831','hex'), 0, '1970-01-01 01:01:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(3, 3, decode('5C7833','hex'), decode('5C7832','hex'), 0, '1970-01-01 01:30:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(4, 1, decode('5C7834','hex'), decode('5C7830','hex'), 1, '1970-01-01 01:00:00.000');
+
+	INSERT INTO sync.deposit
+	(id, leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, ready_for_claim)
+	VALUES(1, 0, 0, 0, decode('0000000000000000000000000000000000000000','hex'), '100', 1, decode('C949254D682D8C9AD5682521675B8F43B102AEC4','hex'), 1, 0, decode('5C7831','hex'), decode('','hex'), true);
+	INSERT INTO sync.deposit
+	(id, leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, ready_for_claim)
+	VALUES(2, 0, 1, 0, decode('0000000000000000000000000000000000000000','hex'), '100', 0, decode('C949254D682D8C9AD5682521675B8F43B102AEC4','hex'), 4, 0, decode('5C7834','hex'), decode('','hex'), true);
+
+	INSERT INTO mt.root (root, deposit_cnt, network, deposit_id)
+	VALUES(decode('A4BFA0908DC7B06D98DA4309F859023D6947561BC19BC00D77F763DEA1A0B9F5','hex'), 1, 0, 1);
+	INSERT INTO mt.root (root, deposit_cnt, network, deposit_id)
+	VALUES(decode('315FEE1AA202BF4A6BD0FDE560C89BE90B6E6E2AAF92DC5E8D118209ABC3410F','hex'), 1, 1, 2);
+
+	INSERT INTO sync.exit_root (block_id, global_exit_root, exit_roots)
+	VALUES(2, decode('5C7832','hex'), ARRAY[decode('A4BFA0908DC7B06D98DA4309F859023D6947561BC19BC00D77F763DEA1A0B9F5','hex'), decode('42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25721','hex')]);
+	INSERT INTO mt.rollup_exit (leaf, rollup_id, root, block_id)
+	VALUES(decode('315FEE1AA202BF4A6BD0FDE560C89BE90B6E6E2AAF92DC5E8D118209ABC3410F','hex'), 1, decode('42D3339FE8EB57770953423F20A029E778A707E8D58AAF110B40D5EB4DD25721','hex'), 3);
+	`
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	_, err = store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	// The L1 deposit is covered by the global exit root of the next block
+	delay, err := store.GetClaimableDelay(ctx, 0, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, delay)
+
+	// The L2 deposit is covered by the rollup exit leaf verified later
+	delay, err = store.GetClaimableDelay(ctx, 1, 100, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, delay)
+
+	_, err = store.GetClaimableDelay(ctx, 2, 100, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+}
+
+func TestBridgeStats(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '2023-10-01 10:10:00+00');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(2, 2, decode('5C7832','hex'), decode('5C7831','hex'), 0, '2023-10-01 10:50:00+00');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(3, 3, decode('5C7833','hex'), decode('5C7832','hex'), 0, '2023-10-01 12:10:00+00');
+	`
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	_, err = store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	token := common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F")
+	for i, blockID := range []uint64{1, 2, 3} {
+		deposit := &etherman.Deposit{NetworkID: 0, OriginalAddress: token, Amount: big.NewInt(100), DestinationNetwork: 1, BlockID: blockID, DepositCount: uint(i), Metadata: []byte{}}
+		_, err = store.AddDeposit(ctx, deposit, nil)
+		require.NoError(t, err)
+		require.NoError(t, store.AddDepositStats(ctx, deposit, nil))
+	}
+	// The claim of a deposit from the rollup 1
+	claim := &etherman.Claim{NetworkID: 0, Index: 0, OriginalAddress: token, Amount: big.NewInt(30), BlockID: 2, RollupIndex: 0}
+	require.NoError(t, store.AddClaim(ctx, claim, nil))
+	require.NoError(t, store.AddClaimStats(ctx, claim, nil))
+
+	from := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	stats, err := store.GetBridgeStats(ctx, "hour", from, from.Add(24*time.Hour), nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, stats, 3)
+	assert.Equal(t, from.Add(10*time.Hour), stats[0].Bucket)
+	assert.Equal(t, uint(0), stats[0].FromNetwork)
+	assert.Equal(t, uint64(2), stats[0].DepositCount)
+	assert.Equal(t, big.NewInt(200), stats[0].DepositAmount)
+	assert.Equal(t, uint(1), stats[1].FromNetwork)
+	assert.Equal(t, uint64(1), stats[1].ClaimCount)
+	assert.Equal(t, big.NewInt(30), stats[1].ClaimAmount)
+	assert.Equal(t, from.Add(12*time.Hour), stats[2].Bucket)
+
+	stats, err = store.GetBridgeStats(ctx, "day", from, from.Add(24*time.Hour), nil, &token, nil)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	assert.Equal(t, from, stats[0].Bucket)
+	assert.Equal(t, uint64(3), stats[0].DepositCount)
+	assert.Equal(t, big.NewInt(300), stats[0].DepositAmount)
+
+	unclaimed, err := store.GetUnclaimedStats(ctx, nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, unclaimed, 1)
+	assert.Equal(t, uint(1), unclaimed[0].ToNetwork)
+	assert.Equal(t, uint64(3), unclaimed[0].Count)
+	assert.Equal(t, big.NewInt(300), unclaimed[0].Amount)
+
+	// The deposits and claims of the reorged blocks are removed from the stats
+	require.NoError(t, store.Reset(ctx, 1, 0, nil))
+	stats, err = store.GetBridgeStats(ctx, "hour", from, from.Add(24*time.Hour), nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, uint64(1), stats[0].DepositCount)
+	assert.Equal(t, big.NewInt(100), stats[0].DepositAmount)
+	unclaimed, err = store.GetUnclaimedStats(ctx, nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, unclaimed, 1)
+	assert.Equal(t, big.NewInt(100), unclaimed[0].Amount)
+}
+
+func TestGetTokenBalances(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 1, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(2, 2, decode('5C7832','hex'), decode('5C7831','hex'), 1, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(3, 1, decode('5C7833','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+	`
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	_, err = store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	token := common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F")
+	wrapped := common.HexToAddress("0x187Bd40226A7073b49163b1f6c2b73d8F2aa8478")
+	// The token bridged from L1 to the rollup is wrapped there
+	require.NoError(t, store.AddTokenWrapped(ctx, &etherman.TokenWrapped{NetworkID: 1, OriginalNetwork: 0, OriginalTokenAddress: token, WrappedTokenAddress: wrapped, BlockID: 1}, nil))
+	l1Deposit := &etherman.Deposit{NetworkID: 0, OriginalAddress: token, Amount: big.NewInt(100), DestinationNetwork: 1, BlockID: 3, DepositCount: 0, Metadata: []byte{}}
+	_, err = store.AddDeposit(ctx, l1Deposit, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.AddClaim(ctx, &etherman.Claim{NetworkID: 1, Index: 0, OriginalAddress: token, Amount: big.NewInt(100), BlockID: 1, MainnetFlag: true}, nil))
+	deposits := []*etherman.Deposit{
+		{NetworkID: 1, OriginalAddress: token, Amount: big.NewInt(30), DestinationNetwork: 0, BlockID: 1, DepositCount: 0, Metadata: []byte{}},
+		// The value of the messages is ether
+		{LeafType: 1, NetworkID: 1, OriginalNetwork: 1, OriginalAddress: common.HexToAddress("0x1"), Amount: big.NewInt(5), DestinationNetwork: 0, BlockID: 1, DepositCount: 1, Metadata: []byte{}},
+		// Not counted before the block 2
+		{NetworkID: 1, OriginalAddress: token, Amount: big.NewInt(10), DestinationNetwork: 0, BlockID: 2, DepositCount: 2, Metadata: []byte{}},
+	}
+	for _, deposit := range deposits {
+		_, err = store.AddDeposit(ctx, deposit, nil)
+		require.NoError(t, err)
+	}
+
+	balances, err := store.GetTokenBalances(ctx, 1, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, balances, 2)
+	assert.Equal(t, common.Address{}, balances[0].OriginalAddress)
+	assert.Nil(t, balances[0].WrappedAddress)
+	assert.Equal(t, big.NewInt(5), balances[0].Deposited)
+	assert.Equal(t, big.NewInt(0), balances[0].Claimed)
+	assert.Equal(t, token, balances[1].OriginalAddress)
+	assert.Equal(t, &wrapped, balances[1].WrappedAddress)
+	assert.Equal(t, big.NewInt(30), balances[1].Deposited)
+	assert.Equal(t, big.NewInt(100), balances[1].Claimed)
+
+	balances, err = store.GetTokenBalances(ctx, 1, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, balances, 2)
+	assert.Equal(t, big.NewInt(40), balances[1].Deposited)
+}
+
+func TestGetInconsistentClaims(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(2, 1, decode('5C7832','hex'), decode('5C7830','hex'), 1, '1970-01-01 01:00:00.000');
+	`
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	_, err = store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	token := common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F")
+	destAddr := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	// The deposit 2 is missing
+	for _, depositCnt := range []uint{0, 1, 3} {
+		_, err = store.AddDeposit(ctx, &etherman.Deposit{NetworkID: 0, OriginalAddress: token, Amount: big.NewInt(100), DestinationNetwork: 1, DestinationAddress: destAddr, BlockID: 1, DepositCount: depositCnt, Metadata: []byte{}}, nil)
+		require.NoError(t, err)
+	}
+	claims := []*etherman.Claim{
+		// Matches the deposit
+		{NetworkID: 1, Index: 0, OriginalAddress: token, Amount: big.NewInt(100), DestinationAddress: destAddr, BlockID: 2, MainnetFlag: true, TxHash: common.HexToHash("0x1")},
+		// Claims more than deposited
+		{NetworkID: 1, Index: 1, OriginalAddress: token, Amount: big.NewInt(1000), DestinationAddress: destAddr, BlockID: 2, MainnetFlag: true, TxHash: common.HexToHash("0x2")},
+		// Claims twice a deposit that doesn't exist
+		{NetworkID: 1, Index: 2, OriginalAddress: token, Amount: big.NewInt(100), DestinationAddress: destAddr, BlockID: 2, MainnetFlag: true, TxHash: common.HexToHash("0x3")},
+		{NetworkID: 1, Index: 2, OriginalAddress: token, Amount: big.NewInt(100), DestinationAddress: destAddr, BlockID: 2, MainnetFlag: true, TxHash: common.HexToHash("0x4")},
+		// The deposit is not synced yet
+		{NetworkID: 1, Index: 5, OriginalAddress: token, Amount: big.NewInt(100), DestinationAddress: destAddr, BlockID: 2, MainnetFlag: true, TxHash: common.HexToHash("0x5")},
+	}
+	for _, claim := range claims {
+		require.NoError(t, store.AddClaim(ctx, claim, nil))
+	}
+
+	inconsistentClaims, err := store.GetInconsistentClaims(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, inconsistentClaims, 3)
+	assert.Equal(t, uint(1), inconsistentClaims[0].Claim.Index)
+	assert.Equal(t, big.NewInt(1000), inconsistentClaims[0].Claim.Amount)
+	assert.Equal(t, uint64(1), inconsistentClaims[0].ClaimCount)
+	require.NotNil(t, inconsistentClaims[0].Deposit)
+	assert.Equal(t, big.NewInt(100), inconsistentClaims[0].Deposit.Amount)
+	assert.Equal(t, destAddr, inconsistentClaims[0].Deposit.DestinationAddress)
+	for _, inconsistent := range inconsistentClaims[1:] {
+		assert.Equal(t, uint(2), inconsistent.Claim.Index)
+		assert.Equal(t, uint64(2), inconsistent.ClaimCount)
+		assert.Nil(t, inconsistent.Deposit)
+	}
+}
+
+func TestPendingClaims(t *testing.T) {
+	data := `INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(1, 1, decode('5C7831','hex'), decode('5C7830','hex'), 0, '1970-01-01 01:00:00.000');
+	INSERT INTO sync.block
+	(id, block_num, block_hash, parent_hash, network_id, received_at)
+	VALUES(2, 1, decode('5C7832','hex'), decode('5C7830','hex'), 1, '1970-01-01 01:00:00.000');
+	`
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	_, err = store.Exec(ctx, data)
+	require.NoError(t, err)
+
+	token := common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F")
+	destAddr := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	deposits := []*etherman.Deposit{
+		{NetworkID: 0, OriginalAddress: token, Amount: big.NewInt(100), DestinationNetwork: 1, DestinationAddress: destAddr, BlockID: 1, DepositCount: 0, Metadata: []byte{}},
+		{NetworkID: 0, OriginalAddress: token, Amount: big.NewInt(200), DestinationNetwork: 1, DestinationAddress: destAddr, BlockID: 1, DepositCount: 1, Metadata: []byte{}},
+		// The value of the messages is ether
+		{LeafType: 1, NetworkID: 0, OriginalAddress: common.HexToAddress("0x1"), Amount: big.NewInt(5), DestinationNetwork: 1, DestinationAddress: destAddr, BlockID: 1, DepositCount: 2, Metadata: []byte{}},
+		// Not ready for claim
+		{NetworkID: 0, OriginalAddress: token, Amount: big.NewInt(400), DestinationNetwork: 1, DestinationAddress: destAddr, BlockID: 1, DepositCount: 3, Metadata: []byte{}},
+	}
+	for _, deposit := range deposits {
+		_, err = store.AddDeposit(ctx, deposit, nil)
+		require.NoError(t, err)
+	}
+	_, err = store.Exec(ctx, "UPDATE sync.deposit SET ready_for_claim = true WHERE deposit_cnt < 3")
+	require.NoError(t, err)
+	claimTxHash := common.HexToHash("0x1")
+	require.NoError(t, store.AddClaim(ctx, &etherman.Claim{NetworkID: 1, Index: 1, OriginalAddress: token, Amount: big.NewInt(200), DestinationAddress: destAddr, BlockID: 2, MainnetFlag: true, TxHash: claimTxHash}, nil))
+
+	// The deposits are returned with the tx that claimed them
+	userDeposits, err := store.GetDeposits(ctx, destAddr.String(), 10, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, userDeposits, 4)
+	assert.Nil(t, userDeposits[3].ClaimTxHash)
+	assert.Equal(t, &claimTxHash, userDeposits[2].ClaimTxHash)
+	deposit, err := store.GetDeposit(ctx, 1, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &claimTxHash, deposit.ClaimTxHash)
+	deposit, err = store.GetDeposit(ctx, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Nil(t, deposit.ClaimTxHash)
+
+	pending, err := store.GetPendingClaims(ctx, destAddr.String(), 10, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, uint(2), pending[0].DepositCount)
+	assert.Equal(t, uint(0), pending[1].DepositCount)
+	assert.True(t, pending[1].ReadyForClaim)
+	assert.Equal(t, big.NewInt(100), pending[1].Amount)
+
+	totals, err := store.GetPendingClaimTotals(ctx, destAddr.String(), nil)
+	require.NoError(t, err)
+	require.Len(t, totals, 2)
+	assert.Equal(t, common.Address{}, totals[0].OriginalAddress)
+	assert.Equal(t, uint64(1), totals[0].Count)
+	assert.Equal(t, big.NewInt(5), totals[0].Amount)
+	assert.Equal(t, token, totals[1].OriginalAddress)
+	assert.Equal(t, uint64(1), totals[1].Count)
+	assert.Equal(t, big.NewInt(100), totals[1].Amount)
+}
+
+func TestWebhookEvents(t *testing.T) {
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+
+	events := []*etherman.WebhookEvent{
+		{Type: "deposit.indexed", Payload: []byte(`{"deposit_cnt": 1}`)},
+		{Type: "deposit.reorged", Payload: []byte(`{"deposit_cnt": 1}`)},
+	}
+	require.NoError(t, store.AddWebhookEvents(ctx, events, nil))
+	require.Equal(t, uint64(1), events[0].ID)
+	require.Equal(t, uint64(2), events[1].ID)
+
+	pending, err := store.GetPendingWebhookEvents(ctx, time.Now(), 10, nil)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, etherman.WebhookEventType("deposit.indexed"), pending[0].Type)
+	assert.JSONEq(t, `{"deposit_cnt": 1}`, string(pending[0].Payload))
+	assert.Equal(t, uint(0), pending[0].Attempts)
+
+	// The failed event is not pending until its next attempt
+	pending[0].Attempts = 1
+	pending[0].NextAttemptAt = time.Now().Add(time.Hour)
+	pending[0].LastError = "unexpected status code 500"
+	require.NoError(t, store.UpdateWebhookEvent(ctx, pending[0], nil))
+	require.NoError(t, store.DeleteWebhookEvent(ctx, 2, nil))
+	pending, err = store.GetPendingWebhookEvents(ctx, time.Now(), 10, nil)
+	require.NoError(t, err)
+	require.Len(t, pending, 0)
+
+	pending, err = store.GetPendingWebhookEvents(ctx, time.Now().Add(2*time.Hour), 10, nil)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, uint(1), pending[0].Attempts)
+	assert.Equal(t, "unexpected status code 500", pending[0].LastError)
+}
+
+func TestSnapshotTables(t *testing.T) {
+	dbCfg := NewConfigFromEnv()
+	ctx := context.Background()
+	err := InitOrReset(dbCfg)
+	require.NoError(t, err)
+	store, err := NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+
+	blockID, err := store.AddBlock(ctx, &etherman.Block{BlockNumber: 1, BlockHash: common.HexToHash("0x1"), ReceivedAt: time.Now()}, nil)
+	require.NoError(t, err)
+	depositID, err := store.AddDeposit(ctx, &etherman.Deposit{Amount: big.NewInt(1), BlockID: blockID, Metadata: []byte{}}, nil)
+	require.NoError(t, err)
+	require.NoError(t, store.SetRoot(ctx, common.HexToHash("0x2").Bytes(), depositID, 0, nil))
+	ranges, err := store.GetBlockRanges(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, ranges, 1)
+
+	dbTx, err := store.BeginSnapshotTransaction(ctx)
+	require.NoError(t, err)
+	migration, err := store.GetMigrationVersion(ctx, dbTx)
+	require.NoError(t, err)
+	tables, err := store.GetSnapshotTables(ctx, dbTx)
+	require.NoError(t, err)
+	contents := make(map[string][]byte)
+	columns := make(map[string][]string)
+	for _, table := range tables {
+		columns[table], err = store.GetTableColumns(ctx, table, dbTx)
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		_, err = store.CopyTableTo(ctx, table, columns[table], &buf, dbTx)
+		require.NoError(t, err)
+		contents[table] = buf.Bytes()
+	}
+	require.NoError(t, store.Rollback(ctx, dbTx))
+	store.Close()
+
+	require.NoError(t, InitOrReset(dbCfg))
+	store, err = NewPostgresStorage(dbCfg)
+	require.NoError(t, err)
+	defer store.Close()
+	dbTx, err = store.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+	restoredMigration, err := store.GetMigrationVersion(ctx, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, migration, restoredMigration)
+	for _, table := range tables {
+		_, err = store.CopyTableFrom(ctx, table, columns[table], bytes.NewReader(contents[table]), dbTx)
+		require.NoError(t, err)
+		require.NoError(t, store.ResetSequences(ctx, table, columns[table], dbTx))
+	}
+	require.NoError(t, store.Commit(ctx, dbTx))
+
+	restoredRanges, err := store.GetBlockRanges(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, ranges, restoredRanges)
+	var roots int
+	err = store.IterateDepositRoots(ctx, 0, func(_ uint64, deposit *etherman.Deposit, root []byte) error {
+		require.Equal(t, common.HexToHash("0x2").Bytes(), root)
+		roots++
+		return nil
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, roots)
+	// The sequences continue after the restored ids
+	nextBlockID, err := store.AddBlock(ctx, &etherman.Block{BlockNumber: 2, BlockHash: common.HexToHash("0x3"), ReceivedAt: time.Now()}, nil)
+	require.NoError(t, err)
+	require.Equal(t, blockID+1, nextBlockID)
+}
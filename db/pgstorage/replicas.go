@@ -0,0 +1,184 @@
+package pgstorage
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/metrics"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+const (
+	// defaultMaxReplicaLag is the max lag of the read replicas if it's not configured
+	defaultMaxReplicaLag = 5 * time.Second
+	// defaultReplicaCheckInterval is the interval of the lag checks if it's not configured
+	defaultReplicaCheckInterval = time.Second
+)
+
+// getReplicaLagSQL gets the seconds since the last transaction replayed by the replica. An idle replica that
+// has replayed all the received WAL isn't lagging, although its last replayed transaction may be old.
+const getReplicaLagSQL = `
+	SELECT CASE WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) END`
+
+type consistentReadKey struct{}
+
+// WithConsistentRead returns a context whose reads are served by the primary database instead of the read replicas.
+// It's meant for the reads that must see the latest writes or must be consistent with each other, like the ones
+// that build a claim proof.
+func WithConsistentRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, consistentReadKey{}, true)
+}
+
+func isConsistentRead(ctx context.Context) bool {
+	consistent, _ := ctx.Value(consistentReadKey{}).(bool)
+	return consistent
+}
+
+type readSessionKey struct{}
+
+// readSession is the database that serves the reads of a context, picked on the first read
+type readSession struct {
+	once    sync.Once
+	querier execQuerier
+	replica bool
+}
+
+// WithReadSession returns a context whose reads are all served by the same database, either the primary or
+// a read replica. It's meant for the reads that must be consistent with each other but can be served by a
+// replica, like the total count and the page of a list.
+func WithReadSession(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readSessionKey{}, &readSession{})
+}
+
+func getReadSession(ctx context.Context) *readSession {
+	session, _ := ctx.Value(readSessionKey{}).(*readSession)
+	return session
+}
+
+// IsReplicaRead returns true if the reads of the read session of the context were served by a read replica,
+// so they may miss the latest writes of the primary.
+func IsReplicaRead(ctx context.Context) bool {
+	session := getReadSession(ctx)
+	return session != nil && session.replica
+}
+
+type replica struct {
+	name string
+	pool *pgxpool.Pool
+	// available is true if the latest lag check succeeded and the lag was below the max
+	available atomic.Bool
+}
+
+// replicaSet balances the reads between the available read replicas. The lag of the replicas is checked
+// periodically and the replicas whose lag is over the max are skipped until they catch up.
+type replicaSet struct {
+	replicas      []*replica
+	maxLag        time.Duration
+	checkInterval time.Duration
+	next          atomic.Uint64
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
+}
+
+func newReplicaSet(cfg Config) (*replicaSet, error) {
+	set := &replicaSet{
+		maxLag:        cfg.MaxReplicaLag.Duration,
+		checkInterval: cfg.ReplicaCheckInterval.Duration,
+	}
+	if set.maxLag <= 0 {
+		set.maxLag = defaultMaxReplicaLag
+	}
+	if set.checkInterval <= 0 {
+		set.checkInterval = defaultReplicaCheckInterval
+	}
+	for _, replicaCfg := range cfg.ReadReplicas {
+		config, err := newPoolConfig(cfg, replicaCfg.Host, replicaCfg.Port)
+		if err != nil {
+			set.close()
+			return nil, err
+		}
+		// A replica that is down when the service starts is skipped until it's reachable
+		config.LazyConnect = true
+		pool, err := pgxpool.ConnectConfig(context.Background(), config)
+		if err != nil {
+			set.close()
+			return nil, err
+		}
+		set.replicas = append(set.replicas, &replica{name: net.JoinHostPort(replicaCfg.Host, replicaCfg.Port), pool: pool})
+	}
+	set.checkLags(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
+	set.cancel = cancel
+	set.wg.Add(1)
+	go func() {
+		defer set.wg.Done()
+		ticker := time.NewTicker(set.checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				set.checkLags(ctx)
+			}
+		}
+	}()
+	return set, nil
+}
+
+// checkLags updates the availability of the replicas from their lag
+func (s *replicaSet) checkLags(ctx context.Context) {
+	for _, r := range s.replicas {
+		checkCtx, cancel := context.WithTimeout(ctx, s.checkInterval)
+		var seconds float64
+		err := r.pool.QueryRow(checkCtx, getReplicaLagSQL).Scan(&seconds)
+		cancel()
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Warnf("error checking the lag of the read replica %s: %v", r.name, err)
+				metrics.ReplicaCheckError(r.name)
+			}
+			r.available.Store(false)
+			continue
+		}
+		metrics.ReplicaLag(r.name, seconds)
+		lag := time.Duration(seconds * float64(time.Second))
+		available := lag <= s.maxLag
+		if r.available.Swap(available) != available {
+			if available {
+				log.Infof("read replica %s is available, lag: %s", r.name, lag)
+			} else {
+				log.Warnf("read replica %s lags %s behind the primary, its reads are routed to other databases", r.name, lag)
+			}
+		}
+	}
+}
+
+// pick gets the next available replica in round robin. It returns nil if no replica is available.
+func (s *replicaSet) pick() *replica {
+	available := make([]*replica, 0, len(s.replicas))
+	for _, r := range s.replicas {
+		if r.available.Load() {
+			available = append(available, r)
+		}
+	}
+	if len(available) == 0 {
+		return nil
+	}
+	return available[s.next.Add(1)%uint64(len(available))]
+}
+
+func (s *replicaSet) close() {
+	if s.cancel != nil {
+		s.cancel()
+		s.wg.Wait()
+	}
+	for _, r := range s.replicas {
+		r.pool.Close()
+	}
+}
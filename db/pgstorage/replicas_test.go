@@ -0,0 +1,45 @@
+package pgstorage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplicaSetPick(t *testing.T) {
+	set := &replicaSet{replicas: []*replica{{name: "a"}, {name: "b"}, {name: "c"}}}
+	require.Nil(t, set.pick())
+
+	set.replicas[0].available.Store(true)
+	set.replicas[2].available.Store(true)
+	picked := make(map[string]int)
+	for i := 0; i < 10; i++ {
+		picked[set.pick().name]++
+	}
+	require.Equal(t, map[string]int{"a": 5, "c": 5}, picked)
+
+	// Without replicas and for consistent reads, the primary is used
+	p := &PostgresStorage{}
+	require.Equal(t, p, p.getReadQuerier(context.Background(), nil))
+	p.replicas = set
+	require.Equal(t, p, p.getReadQuerier(WithConsistentRead(context.Background()), nil))
+
+	// The reads of a read session are served by the replica picked for the first one
+	for _, r := range set.replicas {
+		r.pool = new(pgxpool.Pool)
+	}
+	ctx := WithReadSession(context.Background())
+	require.False(t, IsReplicaRead(ctx))
+	first := p.getReadQuerier(ctx, nil)
+	require.True(t, IsReplicaRead(ctx))
+	require.NotSame(t, p, first)
+	for i := 0; i < 3; i++ {
+		require.Same(t, first, p.getReadQuerier(ctx, nil))
+	}
+	require.NotSame(t, first, p.getReadQuerier(context.Background(), nil))
+	// The consistent reads of a read session are served by the primary
+	require.Equal(t, p, p.getReadQuerier(WithConsistentRead(ctx), nil))
+	require.Same(t, first, p.getReadQuerier(ctx, nil))
+}
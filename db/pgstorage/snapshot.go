@@ -0,0 +1,203 @@
+package pgstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/jackc/pgx/v4"
+)
+
+// snapshotTables are the tables of the snapshots in the order they are restored, so the referenced rows are restored first
+var snapshotTables = []string{
+	"sync.block",
+	"sync.exit_root",
+	"sync.batch",
+	"sync.verified_batch",
+	"sync.forced_batch",
+	"sync.deposit",
+	"sync.claim",
+	"sync.token_wrapped",
+	"sync.reorg",
+	"sync.reorg_deposit",
+	"sync.reorg_claim",
+	"sync.reorg_exit_root",
+	"sync.pending_state_change",
+	"sync.emergency_state",
+	"sync.rollup",
+	"sync.bridge_stats",
+	"mt.root",
+	"mt.rht",
+	"mt.rollup_exit",
+	"mt.prune_state",
+}
+
+// snapshotExcludedTables are the tables of the state of the instance itself, like the claim txs sent with its
+// account or the webhook events pending to be delivered, which must not be restored in other instances.
+var snapshotExcludedTables = []string{
+	"sync.monitored_txs",
+	"sync.webhook_event",
+}
+
+// snapshotFilters skip the rows inserted by the migrations, which already exist in the restored database
+var snapshotFilters = map[string]string{
+	"sync.block": "id > 0",
+}
+
+// BeginSnapshotTransaction starts a read only transaction block whose reads see the same state of the database.
+func (p *PostgresStorage) BeginSnapshotTransaction(ctx context.Context) (pgx.Tx, error) {
+	return p.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+}
+
+// GetMigrationVersion gets the id of the last migration applied to the database.
+func (p *PostgresStorage) GetMigrationVersion(ctx context.Context, dbTx pgx.Tx) (string, error) {
+	const getMigrationVersionSQL = "SELECT id FROM public.gorp_migrations ORDER BY id DESC LIMIT 1"
+	var version string
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getMigrationVersionSQL).Scan(&version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", gerror.ErrStorageNotFound
+	}
+	return version, err
+}
+
+// GetSnapshotTables gets the tables of the snapshots in the order they are restored. It fails if the database has a
+// table that is neither in the snapshots nor excluded from them, so a new table isn't left out silently.
+func (p *PostgresStorage) GetSnapshotTables(ctx context.Context, dbTx pgx.Tx) ([]string, error) {
+	const getTablesSQL = "SELECT table_schema || '.' || table_name FROM information_schema.tables WHERE table_schema IN ('sync', 'mt') AND table_type = 'BASE TABLE'"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getTablesSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	known := make(map[string]bool)
+	for _, table := range append(snapshotTables, snapshotExcludedTables...) {
+		known[table] = true
+	}
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		if !known[table] {
+			return nil, fmt.Errorf("table %s is not known by the snapshots", table)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return snapshotTables, nil
+}
+
+// GetTableColumns gets the columns of the table in their order.
+func (p *PostgresStorage) GetTableColumns(ctx context.Context, table string, dbTx pgx.Tx) ([]string, error) {
+	const getColumnsSQL = "SELECT column_name FROM information_schema.columns WHERE table_schema || '.' || table_name = $1 ORDER BY ordinal_position"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getColumnsSQL, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return columns, nil
+}
+
+// CopyTableTo writes the rows of the table in the text format of COPY. It requires a db transaction.
+func (p *PostgresStorage) CopyTableTo(ctx context.Context, table string, columns []string, w io.Writer, dbTx pgx.Tx) (int64, error) {
+	if dbTx == nil {
+		return 0, gerror.ErrNilDBTransaction
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s", sanitizeColumns(columns), sanitizeTable(table))
+	if filter, ok := snapshotFilters[table]; ok {
+		query += " WHERE " + filter
+	}
+	tag, err := dbTx.Conn().PgConn().CopyTo(ctx, w, fmt.Sprintf("COPY (%s) TO STDOUT", query))
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// CopyTableFrom inserts the rows read in the text format of COPY into the table. It requires a db transaction.
+func (p *PostgresStorage) CopyTableFrom(ctx context.Context, table string, columns []string, r io.Reader, dbTx pgx.Tx) (int64, error) {
+	if dbTx == nil {
+		return 0, gerror.ErrNilDBTransaction
+	}
+	tag, err := dbTx.Conn().PgConn().CopyFrom(ctx, r, fmt.Sprintf("COPY %s (%s) FROM STDIN", sanitizeTable(table), sanitizeColumns(columns)))
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ResetSequences sets the sequences of the serial columns of the table after their max value, so the rows inserted
+// after the copied ones don't reuse their ids.
+func (p *PostgresStorage) ResetSequences(ctx context.Context, table string, columns []string, dbTx pgx.Tx) error {
+	const getSequenceSQL = "SELECT pg_get_serial_sequence($1, $2)"
+	e := p.getExecQuerier(dbTx)
+	for _, column := range columns {
+		var sequence *string
+		if err := e.QueryRow(ctx, getSequenceSQL, table, column).Scan(&sequence); err != nil {
+			return err
+		}
+		if sequence == nil {
+			continue
+		}
+		resetSequenceSQL := fmt.Sprintf("SELECT setval($1, COALESCE((SELECT MAX(%s) FROM %s), 0) + 1, false)", pgx.Identifier{column}.Sanitize(), sanitizeTable(table))
+		if _, err := e.Exec(ctx, resetSequenceSQL, *sequence); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBlockRanges gets the first and last synced blocks of each network.
+func (p *PostgresStorage) GetBlockRanges(ctx context.Context, dbTx pgx.Tx) ([]etherman.BlockRange, error) {
+	const getBlockRangesSQL = `
+		SELECT f.network_id, f.block_num, f.block_hash, l.block_num, l.block_hash
+		FROM (SELECT DISTINCT ON (network_id) network_id, block_num, block_hash FROM sync.block WHERE id > 0 ORDER BY network_id, block_num ASC) f
+		INNER JOIN (SELECT DISTINCT ON (network_id) network_id, block_num, block_hash FROM sync.block WHERE id > 0 ORDER BY network_id, block_num DESC) l
+		ON f.network_id = l.network_id
+		ORDER BY f.network_id ASC`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getBlockRangesSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ranges []etherman.BlockRange
+	for rows.Next() {
+		var r etherman.BlockRange
+		if err := rows.Scan(&r.NetworkID, &r.FirstBlockNumber, &r.FirstBlockHash, &r.LastBlockNumber, &r.LastBlockHash); err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, rows.Err()
+}
+
+func sanitizeTable(table string) string {
+	return pgx.Identifier(strings.Split(table, ".")).Sanitize()
+}
+
+func sanitizeColumns(columns []string) string {
+	sanitized := make([]string, len(columns))
+	for i, column := range columns {
+		sanitized[i] = pgx.Identifier{column}.Sanitize()
+	}
+	return strings.Join(sanitized, ", ")
+}
@@ -0,0 +1,73 @@
+package pgstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+// timeoutQuerier cancels the queries that take longer than the timeout. The rows of a query
+// must be read before the timeout too.
+type timeoutQuerier struct {
+	execQuerier
+	timeout time.Duration
+}
+
+func (q *timeoutQuerier) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	ctx, cancel := context.WithTimeout(ctx, q.timeout)
+	defer cancel()
+	return q.execQuerier.Exec(ctx, sql, arguments...)
+}
+
+func (q *timeoutQuerier) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	ctx, cancel := context.WithTimeout(ctx, q.timeout)
+	rows, err := q.execQuerier.Query(ctx, sql, args...)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &timeoutRows{Rows: rows, cancel: cancel}, nil
+}
+
+func (q *timeoutQuerier) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	ctx, cancel := context.WithTimeout(ctx, q.timeout)
+	return &timeoutRow{row: q.execQuerier.QueryRow(ctx, sql, args...), cancel: cancel}
+}
+
+func (q *timeoutQuerier) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, q.timeout)
+	defer cancel()
+	return q.execQuerier.CopyFrom(ctx, tableName, columnNames, rowSrc)
+}
+
+// timeoutRows releases the context of the query once all the rows are read or the rows are closed
+type timeoutRows struct {
+	pgx.Rows
+	cancel context.CancelFunc
+}
+
+func (r *timeoutRows) Next() bool {
+	if r.Rows.Next() {
+		return true
+	}
+	r.cancel()
+	return false
+}
+
+func (r *timeoutRows) Close() {
+	r.Rows.Close()
+	r.cancel()
+}
+
+// timeoutRow releases the context of the query once the row is scanned
+type timeoutRow struct {
+	row    pgx.Row
+	cancel context.CancelFunc
+}
+
+func (r *timeoutRow) Scan(dest ...interface{}) error {
+	defer r.cancel()
+	return r.row.Scan(dest...)
+}
@@ -0,0 +1,62 @@
+package pgstorage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// ctxQuerier records the context of the latest query
+type ctxQuerier struct {
+	execQuerier
+	ctx context.Context
+}
+
+func (q *ctxQuerier) Exec(ctx context.Context, _ string, _ ...interface{}) (pgconn.CommandTag, error) {
+	q.ctx = ctx
+	return nil, ctx.Err()
+}
+
+func (q *ctxQuerier) QueryRow(ctx context.Context, _ string, _ ...interface{}) pgx.Row {
+	q.ctx = ctx
+	return ctxRow{ctx: ctx}
+}
+
+type ctxRow struct {
+	ctx context.Context
+}
+
+func (r ctxRow) Scan(_ ...interface{}) error {
+	return r.ctx.Err()
+}
+
+func TestTimeoutQuerier(t *testing.T) {
+	p := &PostgresStorage{queryTimeout: time.Minute}
+	e, ok := p.getReadQuerier(context.Background(), nil).(*timeoutQuerier)
+	require.True(t, ok)
+	require.Equal(t, p, e.execQuerier)
+
+	recorder := &ctxQuerier{}
+	e.execQuerier = recorder
+	_, err := e.Exec(context.Background(), "")
+	require.NoError(t, err)
+	deadline, ok := recorder.ctx.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+	require.ErrorIs(t, recorder.ctx.Err(), context.Canceled)
+
+	// The context of a row is released once it's scanned
+	row := e.QueryRow(context.Background(), "")
+	require.NoError(t, recorder.ctx.Err())
+	require.NoError(t, row.Scan())
+	require.ErrorIs(t, recorder.ctx.Err(), context.Canceled)
+
+	e.timeout = time.Nanosecond
+	row = e.QueryRow(context.Background(), "")
+	time.Sleep(time.Millisecond)
+	require.ErrorIs(t, row.Scan(), context.DeadlineExceeded)
+}
@@ -1,15 +1,18 @@
 package pgstorage
 
 import (
+	"database/sql"
+	"math/big"
 	"os"
 	"strconv"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-node/log"
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gobuffalo/packr/v2"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/stdlib"
+	"github.com/lib/pq"
 	migrate "github.com/rubenv/sql-migrate"
 )
 
@@ -23,13 +26,30 @@ func RunMigrationsDown(cfg Config) error {
 	return runMigrations(cfg, migrate.Down)
 }
 
+// openDB opens a database/sql handle to the database, with up to MaxConns connections
+func openDB(cfg Config) (*sql.DB, error) {
+	connString, err := cfg.connString("", "", false)
+	if err != nil {
+		return nil, err
+	}
+	c, err := pgx.ParseConfig(connString)
+	if err != nil {
+		return nil, err
+	}
+	db := stdlib.OpenDB(*c)
+	if cfg.MaxConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxConns)
+	}
+	return db, nil
+}
+
 // ResetDB.
 func ResetDB(cfg Config) error {
-	c, err := pgx.ParseConfig("postgres://" + cfg.User + ":" + cfg.Password + "@" + cfg.Host + ":" + cfg.Port + "/" + cfg.Name)
+	db, err := openDB(cfg)
 	if err != nil {
 		return err
 	}
-	db := stdlib.OpenDB(*c)
+	defer db.Close()
 	_, err = db.Exec("DROP SCHEMA IF EXISTS mt CASCADE;")
 	if err != nil {
 		return err
@@ -48,11 +68,11 @@ func ResetDB(cfg Config) error {
 // runMigrations will execute pending migrations if needed to keep
 // the database updated with the latest changes in either direction of up or down.
 func runMigrations(cfg Config, direction migrate.MigrationDirection) error {
-	c, err := pgx.ParseConfig("postgres://" + cfg.User + ":" + cfg.Password + "@" + cfg.Host + ":" + cfg.Port + "/" + cfg.Name)
+	db, err := openDB(cfg)
 	if err != nil {
 		return err
 	}
-	db := stdlib.OpenDB(*c)
+	defer db.Close()
 
 	var migrations = &migrate.PackrMigrationSource{Box: packr.New("hermez-db-migrations", "./migrations")}
 	nMigrations, err := migrate.Exec(db, "postgres", migrations, direction)
@@ -101,28 +121,82 @@ func getEnv(key string, defaultValue string) string {
 	return defaultValue
 }
 
-// nolint
-var (
-	String, _ = abi.NewType("string", "", nil)
-	Uint8, _  = abi.NewType("uint8", "", nil)
-)
+func scanUpdatedDeposits(rows pgx.Rows) ([]*etherman.Deposit, error) {
+	defer rows.Close()
+	deposits := make([]*etherman.Deposit, 0)
+	for rows.Next() {
+		var (
+			deposit etherman.Deposit
+			amount  string
+		)
+		err := rows.Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &deposit.ReadyForClaim)
+		if err != nil {
+			return nil, err
+		}
+		deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		deposits = append(deposits, &deposit)
+	}
+	return deposits, rows.Err()
+}
 
-func getDecodedToken(metadata []byte) (*etherman.TokenMetadata, error) {
-	//nolint
-	args := abi.Arguments{
-		{"name", String, false},
-		{"symbol", String, false},
-		{"decimals", Uint8, false},
+// scanReorgDeposits reads rows starting with the reorg_id column and groups the deposits by reorg.
+func scanReorgDeposits(rows pgx.Rows) (map[uint64][]etherman.Deposit, error) {
+	defer rows.Close()
+	deposits := make(map[uint64][]etherman.Deposit)
+	for rows.Next() {
+		var (
+			reorgID uint64
+			deposit etherman.Deposit
+			amount  string
+		)
+		err := rows.Scan(&reorgID, &deposit.LeafType, &deposit.NetworkID, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.BlockNumber, &deposit.DepositCount, &deposit.TxHash, &deposit.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		deposits[reorgID] = append(deposits[reorgID], deposit)
 	}
-	token := make(map[string]interface{})
-	err := args.UnpackIntoMap(token, metadata)
-	if err != nil {
-		return nil, err
+	return deposits, rows.Err()
+}
+
+// scanReorgClaims reads rows starting with the reorg_id column and groups the claims by reorg.
+func scanReorgClaims(rows pgx.Rows) (map[uint64][]etherman.Claim, error) {
+	defer rows.Close()
+	claims := make(map[uint64][]etherman.Claim)
+	for rows.Next() {
+		var (
+			reorgID uint64
+			claim   etherman.Claim
+			amount  string
+		)
+		err := rows.Scan(&reorgID, &claim.NetworkID, &claim.Index, &claim.OriginalNetwork, &claim.OriginalAddress, &amount, &claim.DestinationAddress, &claim.BlockNumber, &claim.TxHash, &claim.RollupIndex, &claim.MainnetFlag)
+		if err != nil {
+			return nil, err
+		}
+		claim.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		claims[reorgID] = append(claims[reorgID], claim)
 	}
+	return claims, rows.Err()
+}
 
-	return &etherman.TokenMetadata{
-		Name:     token["name"].(string),
-		Symbol:   token["symbol"].(string),
-		Decimals: token["decimals"].(uint8),
-	}, nil
+// scanReorgExitRoots reads rows starting with the reorg_id column and groups the global exit roots by reorg.
+func scanReorgExitRoots(rows pgx.Rows) (map[uint64][]etherman.GlobalExitRoot, error) {
+	defer rows.Close()
+	gers := make(map[uint64][]etherman.GlobalExitRoot)
+	for rows.Next() {
+		var (
+			reorgID   uint64
+			ger       etherman.GlobalExitRoot
+			exitRoots [][]byte
+		)
+		err := rows.Scan(&reorgID, &ger.BlockNumber, &ger.GlobalExitRoot, pq.Array(&exitRoots))
+		if err != nil {
+			return nil, err
+		}
+		for _, exitRoot := range exitRoots {
+			ger.ExitRoots = append(ger.ExitRoots, common.BytesToHash(exitRoot))
+		}
+		gers[reorgID] = append(gers[reorgID], ger)
+	}
+	return gers, rows.Err()
 }
@@ -1,6 +1,10 @@
 package db
 
 import (
+	"sync"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/kvstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/memstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 )
@@ -8,31 +12,68 @@ import (
 // Storage interface
 type Storage interface{}
 
+var (
+	memoryStoragesLock sync.Mutex
+	// memoryStorages keeps the in-memory storages by name, so the configs with the same name share the data
+	memoryStorages = make(map[string]*memstorage.MemoryStorage)
+)
+
 // NewStorage creates a new Storage
 func NewStorage(cfg Config) (Storage, error) {
 	if cfg.Database == "postgres" {
-		pg, err := pgstorage.NewPostgresStorage(pgstorage.Config{
-			Name:     cfg.Name,
-			User:     cfg.User,
-			Password: cfg.Password,
-			Host:     cfg.Host,
-			Port:     cfg.Port,
-			MaxConns: cfg.MaxConns,
-		})
+		pg, err := pgstorage.NewPostgresStorage(cfg.postgresConfig())
 		return pg, err
+	} else if cfg.Database == "memory" {
+		memoryStoragesLock.Lock()
+		defer memoryStoragesLock.Unlock()
+		if _, ok := memoryStorages[cfg.Name]; !ok {
+			memoryStorages[cfg.Name] = memstorage.NewMemoryStorage()
+		}
+		return memoryStorages[cfg.Name], nil
 	}
 	return nil, gerror.ErrStorageNotRegister
 }
 
+// CloseStorage closes the connections of the storage
+func CloseStorage(storage Storage) {
+	switch s := storage.(type) {
+	case *pgstorage.PostgresStorage:
+		s.Close()
+	case *kvstorage.Storage:
+		s.PostgresStorage.Close()
+	}
+}
+
 // RunMigrations will execute pending migrations if needed to keep
 // the database updated with the latest changes
 func RunMigrations(cfg Config) error {
-	config := pgstorage.Config{
-		Name:     cfg.Name,
-		User:     cfg.User,
-		Password: cfg.Password,
-		Host:     cfg.Host,
-		Port:     cfg.Port,
+	if cfg.Database == "memory" {
+		return nil
+	}
+	return pgstorage.RunMigrationsUp(cfg.postgresConfig())
+}
+
+// postgresConfig returns the config of the postgres storage. The same connection options are used
+// by the storage and the migrations.
+func (cfg Config) postgresConfig() pgstorage.Config {
+	return pgstorage.Config{
+		URL:                  cfg.URL,
+		Name:                 cfg.Name,
+		User:                 cfg.User,
+		Password:             cfg.Password,
+		Host:                 cfg.Host,
+		Port:                 cfg.Port,
+		MaxConns:             cfg.MaxConns,
+		SSLMode:              cfg.SSLMode,
+		SSLRootCert:          cfg.SSLRootCert,
+		SSLCert:              cfg.SSLCert,
+		SSLKey:               cfg.SSLKey,
+		ApplicationName:      cfg.ApplicationName,
+		ConnectTimeout:       cfg.ConnectTimeout,
+		StatementTimeout:     cfg.StatementTimeout,
+		QueryTimeout:         cfg.QueryTimeout,
+		ReadReplicas:         cfg.ReadReplicas,
+		MaxReplicaLag:        cfg.MaxReplicaLag,
+		ReplicaCheckInterval: cfg.ReplicaCheckInterval,
 	}
-	return pgstorage.RunMigrationsUp(config)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -333,7 +334,7 @@ func TestBSStorage(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, count, uint64(1))
 
-	rClaim, err := pg.GetClaim(ctx, 1, 0, tx)
+	rClaim, err := pg.GetClaim(ctx, 1, 0, 0, tx)
 	require.NoError(t, err)
 	require.Equal(t, rClaim.DestinationAddress, claim.DestinationAddress)
 	require.Equal(t, rClaim.NetworkID, claim.NetworkID)
@@ -341,9 +342,27 @@ func TestBSStorage(t *testing.T) {
 	require.Equal(t, rClaim.RollupIndex, claim.RollupIndex)
 	require.Equal(t, rClaim.MainnetFlag, claim.MainnetFlag)
 
+	// Claims of deposits with the same deposit count from different rollups
+	for _, rollupIndex := range []uint64{0, 1} {
+		rollupClaim := *claim
+		rollupClaim.MainnetFlag = false
+		rollupClaim.RollupIndex = rollupIndex
+		rollupClaim.TxHash = common.BigToHash(new(big.Int).SetUint64(rollupIndex))
+		require.NoError(t, pg.AddClaim(ctx, &rollupClaim, tx))
+	}
+	rClaim, err = pg.GetClaim(ctx, 1, 2, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), rClaim.RollupIndex)
+	require.False(t, rClaim.MainnetFlag)
+	rClaim, err = pg.GetClaim(ctx, 1, 1, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), rClaim.RollupIndex)
+	_, err = pg.GetClaim(ctx, 1, 3, 0, tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
 	rClaims, err := pg.GetClaims(ctx, claim.DestinationAddress.String(), 10, 0, tx)
 	require.NoError(t, err)
-	require.Equal(t, len(rClaims), 1)
+	require.Equal(t, len(rClaims), 3)
 
 	wrappedToken := &etherman.TokenWrapped{
 		OriginalNetwork:      0,
@@ -367,6 +386,34 @@ func TestBSStorage(t *testing.T) {
 	require.Equal(t, wt.TokenMetadata.Symbol, "COA")
 	require.Equal(t, wt.TokenMetadata.Decimals, uint8(12))
 
+	wt, err = pg.GetTokenWrappedByAddress(ctx, wrappedToken.WrappedTokenAddress, wrappedToken.NetworkID, tx)
+	require.NoError(t, err)
+	require.Equal(t, wt.OriginalTokenAddress, wrappedToken.OriginalTokenAddress)
+	require.Equal(t, wt.TokenMetadata.Symbol, "COA")
+	_, err = pg.GetTokenWrappedByAddress(ctx, wrappedToken.WrappedTokenAddress, 0, tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	networkID := uint(1)
+	wts, err := pg.GetTokensWrapped(ctx, &networkID, 10, 0, tx)
+	require.NoError(t, err)
+	require.Len(t, wts, 1)
+	wts, err = pg.GetTokensWrapped(ctx, nil, 10, 0, tx)
+	require.NoError(t, err)
+	require.Len(t, wts, 1)
+	count, err = pg.GetTokenWrappedCount(ctx, nil, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	networkID = 0
+	count, err = pg.GetTokenWrappedCount(ctx, &networkID, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), count)
+
+	nativeMetadata, err := pg.GetNativeTokenMetadata(ctx, deposit.OriginalAddress, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, nativeMetadata.Name, "CoinA")
+	_, err = pg.GetNativeTokenMetadata(ctx, deposit.OriginalAddress, 1, tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
 	require.NoError(t, tx.Commit(ctx))
 }
 
@@ -1,7 +1,18 @@
 package etherman
 
+import "github.com/ethereum/go-ethereum/common"
+
 // Config represents the configuration of the etherman
 type Config struct {
 	L1URL  string   `mapstructure:"L1URL"`
 	L2URLs []string `mapstructure:"L2URLs"`
+	// L2Rollups are the rollups that are synced as soon as they are registered in the rollupManager, without restarting the service
+	L2Rollups []RollupConfig `mapstructure:"L2Rollups"`
+}
+
+// RollupConfig is the L2 node of a rollup that is not registered in the rollupManager yet
+type RollupConfig struct {
+	RollupID      uint           `mapstructure:"RollupID"`
+	URL           string         `mapstructure:"URL"`
+	BridgeAddress common.Address `mapstructure:"BridgeAddress"`
 }
@@ -7,12 +7,14 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman/smartcontracts/erc20"
 	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/oldpolygonzkevmbridge"
 	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/polygonrollupmanager"
 	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/polygonzkevmbridge"
 	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/polygonzkevmglobalexitroot"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -105,6 +107,16 @@ const (
 	VerifyBatchOrder EventOrder = "VerifyBatch"
 	// ActivateEtrogOrder identifies the event to activate etrog
 	ActivateEtrogOrder EventOrder = "etrog"
+	// PendingStateOrder identifies a ConsolidatePendingState or OverridePendingState event
+	PendingStateOrder EventOrder = "PendingState"
+	// EmergencyStateOrder identifies an EmergencyStateActivated or EmergencyStateDeactivated event
+	EmergencyStateOrder EventOrder = "EmergencyState"
+	// RollupOrder identifies a CreateNewRollup or AddExistingRollup event
+	RollupOrder EventOrder = "Rollup"
+	// UpdateRollupOrder identifies an UpdateRollup event
+	UpdateRollupOrder EventOrder = "UpdateRollup"
+	// ObsoleteRollupTypeOrder identifies an ObsoleteRollupType event
+	ObsoleteRollupTypeOrder EventOrder = "ObsoleteRollupType"
 )
 
 type ethClienter interface {
@@ -279,14 +291,12 @@ func (etherMan *Client) processEvent(ctx context.Context, vLog types.Log, blocks
 		log.Debug("SetTrustedAggregatorTimeout event detected. Ignoring...")
 		return nil
 	case overridePendingStateSignatureHash:
-		log.Debug("OverridePendingState event detected. Ignoring...")
-		return nil
+		return etherMan.overridePendingStateEvent(ctx, vLog, blocks, blocksOrder)
 	case proveNonDeterministicPendingStateSignatureHash:
 		log.Debug("ProveNonDeterministicPendingState event detected. Ignoring...")
 		return nil
 	case consolidatePendingStateSignatureHash:
-		log.Debug("ConsolidatePendingState event detected. Ignoring...")
-		return nil
+		return etherMan.consolidatePendingStateEvent(ctx, vLog, blocks, blocksOrder)
 	case verifyBatchesTrustedAggregatorSignatureHash:
 		return etherMan.verifyBatchesTrustedAggregatorEvent(ctx, vLog, blocks, blocksOrder)
 	case rollupManagerVerifyBatchesSignatureHash:
@@ -295,15 +305,13 @@ func (etherMan *Client) processEvent(ctx context.Context, vLog types.Log, blocks
 		log.Debug("OnSequenceBatches event detected. Ignoring...")
 		return nil
 	case updateRollupSignatureHash:
-		log.Debug("UpdateRollup event detected. Ignoring...")
-		return nil
+		return etherMan.updateRollupEvent(ctx, vLog, blocks, blocksOrder)
 	case addExistingRollupSignatureHash:
 		return etherMan.AddExistingRollupEvent(ctx, vLog, blocks, blocksOrder)
 	case createNewRollupSignatureHash:
 		return etherMan.createNewRollupEvent(ctx, vLog, blocks, blocksOrder)
 	case obsoleteRollupTypeSignatureHash:
-		log.Debug("ObsoleteRollupType event detected. Ignoring...")
-		return nil
+		return etherMan.obsoleteRollupTypeEvent(ctx, vLog, blocks, blocksOrder)
 	case addNewRollupTypeSignatureHash:
 		log.Debug("AddNewRollupType event detected. Ignoring...")
 		return nil
@@ -320,11 +328,11 @@ func (etherMan *Client) processEvent(ctx context.Context, vLog types.Log, blocks
 		log.Debug("RoleRevoked event detected. Ignoring...")
 		return nil
 	case emergencyStateActivatedSignatureHash:
-		log.Debug("EmergencyStateActivated event detected. Ignoring...")
-		return nil
+		log.Debug("EmergencyStateActivated event detected. Processing...")
+		return etherMan.emergencyStateEvent(ctx, vLog, blocks, blocksOrder, true)
 	case emergencyStateDeactivatedSignatureHash:
-		log.Debug("EmergencyStateDeactivated event detected. Ignoring...")
-		return nil
+		log.Debug("EmergencyStateDeactivated event detected. Processing...")
+		return etherMan.emergencyStateEvent(ctx, vLog, blocks, blocksOrder, false)
 	case oldVerifyBatchesTrustedAggregatorSignatureHash:
 		log.Debug("OldVerifyBatchesTrustedAggregator event detected. Ignoring...")
 		return nil
@@ -593,6 +601,54 @@ func (etherMan *Client) GetNetworkID(ctx context.Context) (uint, error) {
 	return uint(networkID), nil
 }
 
+// GetChainID returns the chain id of the network
+func (etherMan *Client) GetChainID(ctx context.Context) (uint64, error) {
+	client, ok := etherMan.EtherClient.(interface {
+		ChainID(ctx context.Context) (*big.Int, error)
+	})
+	if !ok {
+		return 0, fmt.Errorf("the ethereum client doesn't provide the chain id")
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return chainID.Uint64(), nil
+}
+
+// GetBalance returns the balance of the holder at the block number. The zero token address is the ether of the network.
+func (etherMan *Client) GetBalance(ctx context.Context, token, holder common.Address, blockNumber uint64) (*big.Int, error) {
+	if token == (common.Address{}) {
+		client, ok := etherMan.EtherClient.(ethereum.ChainStateReader)
+		if !ok {
+			return nil, fmt.Errorf("the ethereum client doesn't provide the balances")
+		}
+		return client.BalanceAt(ctx, holder, new(big.Int).SetUint64(blockNumber))
+	}
+	caller, err := etherMan.newERC20Caller(token)
+	if err != nil {
+		return nil, err
+	}
+	return caller.BalanceOf(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNumber)}, holder)
+}
+
+// GetTotalSupply returns the total supply of the ERC20 token at the block number
+func (etherMan *Client) GetTotalSupply(ctx context.Context, token common.Address, blockNumber uint64) (*big.Int, error) {
+	caller, err := etherMan.newERC20Caller(token)
+	if err != nil {
+		return nil, err
+	}
+	return caller.TotalSupply(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(blockNumber)})
+}
+
+func (etherMan *Client) newERC20Caller(token common.Address) (*erc20.Erc20Caller, error) {
+	client, ok := etherMan.EtherClient.(bind.ContractCaller)
+	if !ok {
+		return nil, fmt.Errorf("the ethereum client doesn't provide contract calls")
+	}
+	return erc20.NewErc20Caller(token, client)
+}
+
 func (etherMan *Client) verifyBatchesTrustedAggregatorEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
 	log.Debug("VerifyBatchesTrustedAggregator event detected. Processing...")
 	vb, err := etherMan.PolygonRollupManager.ParseVerifyBatchesTrustedAggregator(vLog)
@@ -600,7 +656,7 @@ func (etherMan *Client) verifyBatchesTrustedAggregatorEvent(ctx context.Context,
 		log.Error("error parsing verifyBatchesTrustedAggregator event. Error: ", err)
 		return err
 	}
-	return etherMan.verifyBatches(ctx, vLog, blocks, blocksOrder, uint(vb.RollupID), vb.NumBatch, vb.StateRoot, vb.ExitRoot, vb.Aggregator)
+	return etherMan.verifyBatches(ctx, vLog, blocks, blocksOrder, uint(vb.RollupID), vb.NumBatch, vb.StateRoot, vb.ExitRoot, vb.Aggregator, true)
 }
 
 func (etherMan *Client) verifyBatchesEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
@@ -610,10 +666,10 @@ func (etherMan *Client) verifyBatchesEvent(ctx context.Context, vLog types.Log,
 		log.Error("error parsing VerifyBatches event. Error: ", err)
 		return err
 	}
-	return etherMan.verifyBatches(ctx, vLog, blocks, blocksOrder, uint(vb.RollupID), vb.NumBatch, vb.StateRoot, vb.ExitRoot, vb.Aggregator)
+	return etherMan.verifyBatches(ctx, vLog, blocks, blocksOrder, uint(vb.RollupID), vb.NumBatch, vb.StateRoot, vb.ExitRoot, vb.Aggregator, false)
 }
 
-func (etherMan *Client) verifyBatches(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order, rollupID uint, batchNum uint64, stateRoot, localExitRoot common.Hash, aggregator common.Address) error {
+func (etherMan *Client) verifyBatches(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order, rollupID uint, batchNum uint64, stateRoot, localExitRoot common.Hash, aggregator common.Address, trusted bool) error {
 	var verifyBatch VerifiedBatch
 	verifyBatch.BlockNumber = vLog.BlockNumber
 	verifyBatch.BatchNumber = batchNum
@@ -622,6 +678,7 @@ func (etherMan *Client) verifyBatches(ctx context.Context, vLog types.Log, block
 	verifyBatch.TxHash = vLog.TxHash
 	verifyBatch.StateRoot = stateRoot
 	verifyBatch.Aggregator = aggregator
+	verifyBatch.Trusted = trusted
 
 	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
 		fullBlock, err := etherMan.EtherClient.BlockByHash(ctx, vLog.BlockHash)
@@ -645,6 +702,100 @@ func (etherMan *Client) verifyBatches(ctx context.Context, vLog types.Log, block
 	return nil
 }
 
+func (etherMan *Client) consolidatePendingStateEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("ConsolidatePendingState event detected. Processing...")
+	cps, err := etherMan.PolygonRollupManager.ParseConsolidatePendingState(vLog)
+	if err != nil {
+		log.Error("error parsing ConsolidatePendingState event. Error: ", err)
+		return err
+	}
+	pendingState := PendingStateChange{
+		Event:           ConsolidatePendingStateEvent,
+		RollupID:        uint(cps.RollupID),
+		BatchNumber:     cps.NumBatch,
+		StateRoot:       cps.StateRoot,
+		ExitRoot:        cps.ExitRoot,
+		PendingStateNum: cps.PendingStateNum,
+	}
+	return etherMan.pendingStateChange(ctx, vLog, blocks, blocksOrder, pendingState)
+}
+
+func (etherMan *Client) overridePendingStateEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("OverridePendingState event detected. Processing...")
+	ops, err := etherMan.PolygonRollupManager.ParseOverridePendingState(vLog)
+	if err != nil {
+		log.Error("error parsing OverridePendingState event. Error: ", err)
+		return err
+	}
+	pendingState := PendingStateChange{
+		Event:       OverridePendingStateEvent,
+		RollupID:    uint(ops.RollupID),
+		BatchNumber: ops.NumBatch,
+		StateRoot:   ops.StateRoot,
+		ExitRoot:    ops.ExitRoot,
+		Aggregator:  ops.Aggregator,
+	}
+	return etherMan.pendingStateChange(ctx, vLog, blocks, blocksOrder, pendingState)
+}
+
+func (etherMan *Client) pendingStateChange(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order, pendingState PendingStateChange) error {
+	pendingState.BlockNumber = vLog.BlockNumber
+	pendingState.TxHash = vLog.TxHash
+
+	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
+		fullBlock, err := etherMan.EtherClient.BlockByHash(ctx, vLog.BlockHash)
+		if err != nil {
+			return fmt.Errorf("error getting hashParent. BlockNumber: %d. Error: %v", vLog.BlockNumber, err)
+		}
+		block := prepareBlock(vLog, time.Unix(int64(fullBlock.Time()), 0), fullBlock)
+		block.PendingStateChanges = append(block.PendingStateChanges, pendingState)
+		*blocks = append(*blocks, block)
+	} else if (*blocks)[len(*blocks)-1].BlockHash == vLog.BlockHash && (*blocks)[len(*blocks)-1].BlockNumber == vLog.BlockNumber {
+		(*blocks)[len(*blocks)-1].PendingStateChanges = append((*blocks)[len(*blocks)-1].PendingStateChanges, pendingState)
+	} else {
+		log.Error("Error processing pending state event. BlockHash:", vLog.BlockHash, ". BlockNumber: ", vLog.BlockNumber)
+		return fmt.Errorf("error processing pending state event")
+	}
+	or := Order{
+		Name: PendingStateOrder,
+		Pos:  len((*blocks)[len(*blocks)-1].PendingStateChanges) - 1,
+	}
+	(*blocksOrder)[(*blocks)[len(*blocks)-1].BlockHash] = append((*blocksOrder)[(*blocks)[len(*blocks)-1].BlockHash], or)
+	return nil
+}
+
+// emergencyStateEvent processes the EmergencyStateActivated and EmergencyStateDeactivated events.
+// Both the bridge and the rollupManager emit them, so the address of the emitter is stored too.
+func (etherMan *Client) emergencyStateEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order, activated bool) error {
+	emergencyState := EmergencyState{
+		BlockNumber: vLog.BlockNumber,
+		Address:     vLog.Address,
+		Activated:   activated,
+		TxHash:      vLog.TxHash,
+	}
+
+	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
+		fullBlock, err := etherMan.EtherClient.BlockByHash(ctx, vLog.BlockHash)
+		if err != nil {
+			return fmt.Errorf("error getting hashParent. BlockNumber: %d. Error: %v", vLog.BlockNumber, err)
+		}
+		block := prepareBlock(vLog, time.Unix(int64(fullBlock.Time()), 0), fullBlock)
+		block.EmergencyStates = append(block.EmergencyStates, emergencyState)
+		*blocks = append(*blocks, block)
+	} else if (*blocks)[len(*blocks)-1].BlockHash == vLog.BlockHash && (*blocks)[len(*blocks)-1].BlockNumber == vLog.BlockNumber {
+		(*blocks)[len(*blocks)-1].EmergencyStates = append((*blocks)[len(*blocks)-1].EmergencyStates, emergencyState)
+	} else {
+		log.Error("Error processing emergency state event. BlockHash:", vLog.BlockHash, ". BlockNumber: ", vLog.BlockNumber)
+		return fmt.Errorf("error processing emergency state event")
+	}
+	or := Order{
+		Name: EmergencyStateOrder,
+		Pos:  len((*blocks)[len(*blocks)-1].EmergencyStates) - 1,
+	}
+	(*blocksOrder)[(*blocks)[len(*blocks)-1].BlockHash] = append((*blocksOrder)[(*blocks)[len(*blocks)-1].BlockHash], or)
+	return nil
+}
+
 func (etherMan *Client) GetRollupID() uint {
 	return uint(etherMan.RollupID)
 }
@@ -680,66 +831,142 @@ func GenerateGlobalIndex(mainnetFlag bool, rollupIndex uint, localExitRootIndex
 	return big.NewInt(0).SetBytes(globalIndexBytes)
 }
 
+// nolint
+var (
+	stringType, _ = abi.NewType("string", "", nil)
+	uint8Type, _  = abi.NewType("uint8", "", nil)
+)
+
+// DecodeTokenMetadata decodes the metadata of a deposit, that is the name, symbol and decimals of the token
+func DecodeTokenMetadata(metadata []byte) (*TokenMetadata, error) {
+	args := abi.Arguments{
+		{Name: "name", Type: stringType},
+		{Name: "symbol", Type: stringType},
+		{Name: "decimals", Type: uint8Type},
+	}
+	token := make(map[string]interface{})
+	err := args.UnpackIntoMap(token, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenMetadata{
+		Name:     token["name"].(string),
+		Symbol:   token["symbol"].(string),
+		Decimals: token["decimals"].(uint8),
+	}, nil
+}
+
 func (etherMan *Client) createNewRollupEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
 	log.Debug("CreateNewRollup event detected. Processing...")
 	rollup, err := etherMan.PolygonRollupManager.ParseCreateNewRollup(vLog)
 	if err != nil {
 		return err
 	}
-	if rollup.RollupID != etherMan.RollupID {
-		return nil
+	return etherMan.addRollup(ctx, vLog, blocks, blocksOrder, Rollup{
+		RollupID:        uint(rollup.RollupID),
+		ChainID:         rollup.ChainID,
+		RollupAddress:   rollup.RollupAddress,
+		GasTokenAddress: rollup.GasTokenAddress,
+		RollupTypeID:    uint(rollup.RollupTypeID),
+	})
+}
+
+func (etherMan *Client) AddExistingRollupEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("AddExistingRollup event detected. Processing...")
+	rollup, err := etherMan.PolygonRollupManager.ParseAddExistingRollup(vLog)
+	if err != nil {
+		return err
 	}
+	return etherMan.addRollup(ctx, vLog, blocks, blocksOrder, Rollup{
+		RollupID:              uint(rollup.RollupID),
+		ChainID:               rollup.ChainID,
+		RollupAddress:         rollup.RollupAddress,
+		ForkID:                rollup.ForkID,
+		RollupCompatibilityID: rollup.RollupCompatibilityID,
+	})
+}
 
-	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
-		fullBlock, err := etherMan.EtherClient.BlockByHash(ctx, vLog.BlockHash)
-		if err != nil {
-			return fmt.Errorf("error getting hashParent. BlockNumber: %d. Error: %v", vLog.BlockNumber, err)
-		}
-		block := prepareBlock(vLog, time.Unix(int64(fullBlock.Time()), 0), fullBlock)
-		block.ActivateEtrog = append(block.ActivateEtrog, true)
-		*blocks = append(*blocks, block)
-	} else if (*blocks)[len(*blocks)-1].BlockHash == vLog.BlockHash && (*blocks)[len(*blocks)-1].BlockNumber == vLog.BlockNumber {
-		(*blocks)[len(*blocks)-1].ActivateEtrog = append((*blocks)[len(*blocks)-1].ActivateEtrog, true)
-	} else {
-		log.Error("Error processing TokenWrapped event. BlockHash:", vLog.BlockHash, ". BlockNumber: ", vLog.BlockNumber)
-		return fmt.Errorf("error processing TokenWrapped event")
+// addRollup adds the rollup to the registry. When the rollup is the one configured, etrog is activated too.
+func (etherMan *Client) addRollup(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order, rollup Rollup) error {
+	rollup.BlockNumber = vLog.BlockNumber
+	rollup.TxHash = vLog.TxHash
+	block, err := etherMan.eventBlock(ctx, vLog, blocks)
+	if err != nil {
+		return err
 	}
-	or := Order{
+	block.Rollups = append(block.Rollups, rollup)
+	(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{
+		Name: RollupOrder,
+		Pos:  len(block.Rollups) - 1,
+	})
+	if rollup.RollupID != uint(etherMan.RollupID) {
+		return nil
+	}
+	block.ActivateEtrog = append(block.ActivateEtrog, true)
+	(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{
 		Name: ActivateEtrogOrder,
-		Pos:  len((*blocks)[len(*blocks)-1].ActivateEtrog) - 1,
+		Pos:  len(block.ActivateEtrog) - 1,
+	})
+	return nil
+}
+
+func (etherMan *Client) updateRollupEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("UpdateRollup event detected. Processing...")
+	update, err := etherMan.PolygonRollupManager.ParseUpdateRollup(vLog)
+	if err != nil {
+		log.Error("error parsing UpdateRollup event. Error: ", err)
+		return err
 	}
-	(*blocksOrder)[(*blocks)[len(*blocks)-1].BlockHash] = append((*blocksOrder)[(*blocks)[len(*blocks)-1].BlockHash], or)
+	block, err := etherMan.eventBlock(ctx, vLog, blocks)
+	if err != nil {
+		return err
+	}
+	block.RollupUpdates = append(block.RollupUpdates, RollupUpdate{
+		BlockNumber:                    vLog.BlockNumber,
+		RollupID:                       uint(update.RollupID),
+		RollupTypeID:                   uint(update.NewRollupTypeID),
+		LastVerifiedBatchBeforeUpgrade: update.LastVerifiedBatchBeforeUpgrade,
+		TxHash:                         vLog.TxHash,
+	})
+	(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{
+		Name: UpdateRollupOrder,
+		Pos:  len(block.RollupUpdates) - 1,
+	})
 	return nil
 }
 
-func (etherMan *Client) AddExistingRollupEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
-	log.Debug("AddExistingRollup event detected. Processing...")
-	rollup, err := etherMan.PolygonRollupManager.ParseAddExistingRollup(vLog)
+func (etherMan *Client) obsoleteRollupTypeEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("ObsoleteRollupType event detected. Processing...")
+	obsolete, err := etherMan.PolygonRollupManager.ParseObsoleteRollupType(vLog)
 	if err != nil {
+		log.Error("error parsing ObsoleteRollupType event. Error: ", err)
 		return err
 	}
-	if rollup.RollupID != etherMan.RollupID {
-		return nil
+	block, err := etherMan.eventBlock(ctx, vLog, blocks)
+	if err != nil {
+		return err
 	}
+	block.ObsoleteRollupTypes = append(block.ObsoleteRollupTypes, ObsoleteRollupType{
+		BlockNumber:  vLog.BlockNumber,
+		RollupTypeID: uint(obsolete.RollupTypeID),
+		TxHash:       vLog.TxHash,
+	})
+	(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{
+		Name: ObsoleteRollupTypeOrder,
+		Pos:  len(block.ObsoleteRollupTypes) - 1,
+	})
+	return nil
+}
 
+// eventBlock returns the block of the event, appending it to the blocks when it isn't the last one.
+func (etherMan *Client) eventBlock(ctx context.Context, vLog types.Log, blocks *[]Block) (*Block, error) {
 	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
 		fullBlock, err := etherMan.EtherClient.BlockByHash(ctx, vLog.BlockHash)
 		if err != nil {
-			return fmt.Errorf("error getting hashParent. BlockNumber: %d. Error: %v", vLog.BlockNumber, err)
+			return nil, fmt.Errorf("error getting hashParent. BlockNumber: %d. Error: %v", vLog.BlockNumber, err)
 		}
-		block := prepareBlock(vLog, time.Unix(int64(fullBlock.Time()), 0), fullBlock)
-		block.ActivateEtrog = append(block.ActivateEtrog, true)
-		*blocks = append(*blocks, block)
-	} else if (*blocks)[len(*blocks)-1].BlockHash == vLog.BlockHash && (*blocks)[len(*blocks)-1].BlockNumber == vLog.BlockNumber {
-		(*blocks)[len(*blocks)-1].ActivateEtrog = append((*blocks)[len(*blocks)-1].ActivateEtrog, true)
-	} else {
-		log.Error("Error processing TokenWrapped event. BlockHash:", vLog.BlockHash, ". BlockNumber: ", vLog.BlockNumber)
-		return fmt.Errorf("error processing TokenWrapped event")
-	}
-	or := Order{
-		Name: ActivateEtrogOrder,
-		Pos:  len((*blocks)[len(*blocks)-1].ActivateEtrog) - 1,
+		*blocks = append(*blocks, prepareBlock(vLog, time.Unix(int64(fullBlock.Time()), 0), fullBlock))
 	}
-	(*blocksOrder)[(*blocks)[len(*blocks)-1].BlockHash] = append((*blocksOrder)[(*blocks)[len(*blocks)-1].BlockHash], or)
-	return nil
+	return &(*blocks)[len(*blocks)-1], nil
 }
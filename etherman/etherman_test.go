@@ -257,3 +257,60 @@ func TestGenerateGlobalIndex(t *testing.T) {
 	}
 	assert.Equal(t, globalIndex, globalIndexGenerated)
 }
+
+func TestRollupTypeEvents(t *testing.T) {
+	// Set up testing environment
+	etherman, ethBackend, auth, _, _, _ := newTestingEnv()
+
+	ctx := context.Background()
+	initBlock, err := etherman.EtherClient.BlockByNumber(ctx, nil)
+	require.NoError(t, err)
+
+	rollupType, err := etherman.PolygonRollupManager.RollupTypeMap(&bind.CallOpts{}, 1)
+	require.NoError(t, err)
+	rollupData, err := etherman.PolygonRollupManager.RollupIDToRollupData(&bind.CallOpts{}, 1)
+	require.NoError(t, err)
+
+	// Upgrade the rollup to a new rollup type and make the old one obsolete
+	_, err = etherman.PolygonRollupManager.AddNewRollupType(auth, rollupType.ConsensusImplementation, rollupType.Verifier, 7, 0, rollupType.Genesis, "PolygonZkEvm Rollup") //nolint:gomnd
+	require.NoError(t, err)
+	ethBackend.Commit()
+	_, err = etherman.PolygonRollupManager.UpdateRollup(auth, rollupData.RollupContract, 2, []byte{}) //nolint:gomnd
+	require.NoError(t, err)
+	_, err = etherman.PolygonRollupManager.ObsoleteRollupType(auth, 1)
+	require.NoError(t, err)
+	ethBackend.Commit()
+
+	finalBlock, err := etherman.EtherClient.BlockByNumber(ctx, nil)
+	require.NoError(t, err)
+	finalBlockNumber := finalBlock.NumberU64()
+	blocks, order, err := etherman.GetRollupInfoByBlockRange(ctx, initBlock.NumberU64()+1, &finalBlockNumber)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	require.Len(t, blocks[0].RollupUpdates, 1)
+	assert.Equal(t, uint(1), blocks[0].RollupUpdates[0].RollupID)
+	assert.Equal(t, uint(2), blocks[0].RollupUpdates[0].RollupTypeID)
+	assert.Equal(t, finalBlockNumber, blocks[0].RollupUpdates[0].BlockNumber)
+	require.Len(t, blocks[0].ObsoleteRollupTypes, 1)
+	assert.Equal(t, uint(1), blocks[0].ObsoleteRollupTypes[0].RollupTypeID)
+	require.Len(t, order[blocks[0].BlockHash], 2)
+	assert.Equal(t, UpdateRollupOrder, order[blocks[0].BlockHash][0].Name)
+	assert.Equal(t, ObsoleteRollupTypeOrder, order[blocks[0].BlockHash][1].Name)
+}
+
+func TestERC20Calls(t *testing.T) {
+	// Set up testing environment
+	etherman, _, auth, polAddr, _, _ := newTestingEnv()
+
+	ctx := context.Background()
+	block, err := etherman.EtherClient.BlockByNumber(ctx, nil)
+	require.NoError(t, err)
+
+	supply, err := etherman.GetTotalSupply(ctx, polAddr, block.NumberU64())
+	require.NoError(t, err)
+	require.Positive(t, supply.Sign())
+	balance, err := etherman.GetBalance(ctx, polAddr, auth.From, block.NumberU64())
+	require.NoError(t, err)
+	require.Positive(t, balance.Sign())
+	require.True(t, balance.Cmp(supply) <= 0)
+}
@@ -20,7 +20,13 @@ type Block struct {
 	Tokens          []TokenWrapped
 	VerifiedBatches []VerifiedBatch
 	ActivateEtrog   []bool
-	ReceivedAt      time.Time
+	// PendingStateChanges are the consolidations and overrides of the rollups pending states
+	PendingStateChanges []PendingStateChange
+	EmergencyStates     []EmergencyState
+	Rollups             []Rollup
+	RollupUpdates       []RollupUpdate
+	ObsoleteRollupTypes []ObsoleteRollupType
+	ReceivedAt          time.Time
 }
 
 // GlobalExitRoot struct
@@ -47,6 +53,10 @@ type Deposit struct {
 	Metadata           []byte
 	// it is only used for the bridge service
 	ReadyForClaim bool
+	// ReceivedAt is the time of the block of the deposit. It is only used for the bridge service
+	ReceivedAt time.Time
+	// ClaimTxHash is the hash of the tx that claimed the deposit, if any. It is only used for the bridge service
+	ClaimTxHash *common.Hash
 }
 
 // Claim struct
@@ -90,6 +100,83 @@ type VerifiedBatch struct {
 	TxHash        common.Hash
 	StateRoot     common.Hash
 	Aggregator    common.Address
+	// Trusted is true when the batches are verified by the trusted aggregator, so no pending state is created
+	Trusted bool
+}
+
+// PendingStateEvent identifies the rollupManager event that changes the pending state of a rollup
+type PendingStateEvent string
+
+const (
+	// TrustedVerifyBatchesEvent identifies a VerifyBatchesTrustedAggregator event. It consolidates the state and removes the pending states
+	TrustedVerifyBatchesEvent PendingStateEvent = "trustedVerifyBatches"
+	// ConsolidatePendingStateEvent identifies a ConsolidatePendingState event
+	ConsolidatePendingStateEvent PendingStateEvent = "consolidatePendingState"
+	// OverridePendingStateEvent identifies an OverridePendingState event. The pending states are discarded
+	OverridePendingStateEvent PendingStateEvent = "overridePendingState"
+)
+
+// PendingStateChange struct. After any of these events, the state of the rollup is consolidated up to BatchNumber
+type PendingStateChange struct {
+	ID              uint64
+	BlockID         uint64
+	BlockNumber     uint64
+	Event           PendingStateEvent
+	RollupID        uint
+	BatchNumber     uint64
+	StateRoot       common.Hash
+	ExitRoot        common.Hash
+	Aggregator      common.Address
+	PendingStateNum uint64
+	TxHash          common.Hash
+}
+
+// EmergencyState struct
+type EmergencyState struct {
+	BlockID     uint64
+	BlockNumber uint64
+	NetworkID   uint
+	Address     common.Address
+	Activated   bool
+	TxHash      common.Hash
+}
+
+// Rollup is a rollup registered in the rollupManager. The rollups created from a rollup type
+// have a RollupTypeID, while the existing rollups that are added directly have a ForkID.
+// When the rollup is upgraded, RollupTypeID is the type of the latest upgrade.
+type Rollup struct {
+	BlockID               uint64
+	BlockNumber           uint64
+	RollupID              uint
+	ChainID               uint64
+	RollupAddress         common.Address
+	GasTokenAddress       common.Address
+	RollupTypeID          uint
+	ForkID                uint64
+	RollupCompatibilityID uint8
+	TxHash                common.Hash
+	// LastVerifiedBatchBeforeUpgrade is the last batch verified before the latest upgrade
+	LastVerifiedBatchBeforeUpgrade uint64
+	// RollupTypeObsolete is true when the rollup type of the rollup has been made obsolete
+	RollupTypeObsolete bool
+}
+
+// RollupUpdate is the upgrade of a rollup to a new rollup type
+type RollupUpdate struct {
+	BlockID                        uint64
+	BlockNumber                    uint64
+	RollupID                       uint
+	RollupTypeID                   uint
+	LastVerifiedBatchBeforeUpgrade uint64
+	TxHash                         common.Hash
+}
+
+// ObsoleteRollupType is a rollup type that can't be used anymore to create or upgrade rollups
+type ObsoleteRollupType struct {
+	BlockID      uint64
+	BlockNumber  uint64
+	RollupTypeID uint
+	TxHash       common.Hash
 }
 
 // RollupExitLeaf struct
@@ -100,3 +187,90 @@ type RollupExitLeaf struct {
 	RollupId uint
 	Root     common.Hash
 }
+
+// Reorg struct
+type Reorg struct {
+	ID              uint64
+	NetworkID       uint
+	ForkBlockNumber uint64
+	Depth           uint64
+	OldBlockHash    common.Hash
+	NewBlockHash    common.Hash
+	Deposits        []Deposit
+	Claims          []Claim
+	GlobalExitRoots []GlobalExitRoot
+	DetectedAt      time.Time
+}
+
+// BridgeStats is the bridge volume of an origin token from a network to another one during a time bucket
+type BridgeStats struct {
+	Bucket          time.Time
+	OriginalNetwork uint
+	OriginalAddress common.Address
+	FromNetwork     uint
+	ToNetwork       uint
+	DepositCount    uint64
+	DepositAmount   *big.Int
+	ClaimCount      uint64
+	ClaimAmount     *big.Int
+}
+
+// UnclaimedStats is the value of an origin token deposited from a network to another one that is not claimed yet
+type UnclaimedStats struct {
+	OriginalNetwork uint
+	OriginalAddress common.Address
+	FromNetwork     uint
+	ToNetwork       uint
+	Count           uint64
+	Amount          *big.Int
+}
+
+// PendingClaimTotal is the value of an origin token that is ready for claim by an address but not claimed yet.
+// The value of the message deposits is accounted as ether.
+type PendingClaimTotal struct {
+	OriginalNetwork uint
+	OriginalAddress common.Address
+	Count           uint64
+	Amount          *big.Int
+}
+
+// TokenBalance is the amount of an origin token deposited from a network and claimed in it. WrappedAddress
+// is the address of the token wrapped in the network, if any.
+type TokenBalance struct {
+	OriginalNetwork uint
+	OriginalAddress common.Address
+	WrappedAddress  *common.Address
+	Deposited       *big.Int
+	Claimed         *big.Int
+}
+
+// InconsistentClaim is a claim that doesn't match a single synced deposit of its global index. Deposit is nil when
+// the deposit is not synced although the source network is synced beyond it. ClaimCount is the number of claims of the global index.
+type InconsistentClaim struct {
+	Claim      Claim
+	Deposit    *Deposit
+	ClaimCount uint64
+}
+
+// BlockRange is the range of the synced blocks of a network
+type BlockRange struct {
+	NetworkID        uint
+	FirstBlockNumber uint64
+	FirstBlockHash   common.Hash
+	LastBlockNumber  uint64
+	LastBlockHash    common.Hash
+}
+
+// WebhookEventType is the type of a webhook event
+type WebhookEventType string
+
+// WebhookEvent is an event of the webhook outbox. Payload is the JSON data of the event.
+type WebhookEvent struct {
+	ID            uint64
+	Type          WebhookEventType
+	Payload       []byte
+	CreatedAt     time.Time
+	Attempts      uint
+	NextAttemptAt time.Time
+	LastError     string
+}
@@ -0,0 +1,159 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/monitor/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+)
+
+// ClaimFindingKind identifies why a claim doesn't match the synced deposits
+type ClaimFindingKind string
+
+const (
+	// UnknownDepositFinding is a claim whose deposit is not synced although the source network is synced beyond it
+	UnknownDepositFinding ClaimFindingKind = "unknown_deposit"
+	// MismatchFinding is a claim whose fields differ from the ones of the deposit of its global index
+	MismatchFinding ClaimFindingKind = "mismatch"
+	// DuplicateFinding is a claim of a global index that is claimed more than once
+	DuplicateFinding ClaimFindingKind = "duplicate"
+)
+
+// ClaimFinding is a claim that doesn't match the synced deposits. MismatchedFields are the
+// fields of the claim that differ from the deposit ones.
+type ClaimFinding struct {
+	Kind             ClaimFindingKind
+	Claim            etherman.Claim
+	Deposit          *etherman.Deposit
+	ClaimCount       uint64
+	MismatchedFields []string
+}
+
+func (f ClaimFinding) key() string {
+	return fmt.Sprintf("%s/%d/%t/%d/%d/%s", f.Kind, f.Claim.NetworkID, f.Claim.MainnetFlag, f.Claim.RollupIndex, f.Claim.Index, f.Claim.TxHash)
+}
+
+// ClaimFindingsTopic is the topic of the event bus where the claim checks are published.
+// The findings of all the networks are published in a single report.
+const ClaimFindingsTopic uint = 0
+
+// ClaimCheckReport is the result of a claim check
+type ClaimCheckReport struct {
+	CheckedAt time.Time
+	Findings  []ClaimFinding
+}
+
+// AlertHook is called once for each new claim finding
+type AlertHook func(ctx context.Context, finding ClaimFinding)
+
+// NewClaimFindings classifies the inconsistent claims. A claim may have several findings.
+func NewClaimFindings(claims []*etherman.InconsistentClaim) []ClaimFinding {
+	var findings []ClaimFinding
+	for _, c := range claims {
+		finding := ClaimFinding{Claim: c.Claim, Deposit: c.Deposit, ClaimCount: c.ClaimCount}
+		if c.ClaimCount > 1 {
+			finding.Kind = DuplicateFinding
+			findings = append(findings, finding)
+		}
+		if c.Deposit == nil {
+			finding.Kind = UnknownDepositFinding
+			findings = append(findings, finding)
+			continue
+		}
+		if fields := mismatchedFields(c.Claim, *c.Deposit); len(fields) > 0 {
+			finding.Kind = MismatchFinding
+			finding.MismatchedFields = fields
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+func mismatchedFields(claim etherman.Claim, deposit etherman.Deposit) []string {
+	var fields []string
+	if claim.OriginalNetwork != deposit.OriginalNetwork {
+		fields = append(fields, "orig_net")
+	}
+	if claim.OriginalAddress != deposit.OriginalAddress {
+		fields = append(fields, "orig_addr")
+	}
+	if claim.Amount.Cmp(deposit.Amount) != 0 {
+		fields = append(fields, "amount")
+	}
+	if claim.DestinationAddress != deposit.DestinationAddress {
+		fields = append(fields, "dest_addr")
+	}
+	if claim.NetworkID != deposit.DestinationNetwork {
+		fields = append(fields, "dest_net")
+	}
+	return fields
+}
+
+// ClaimChecker periodically cross-references the synced claims with the synced deposits using the
+// global index. The new findings are logged and notified to the alert hooks, as an early warning of exploits.
+// The reports of the checks are published in the ClaimFindingsTopic.
+type ClaimChecker struct {
+	cfg     Config
+	storage storageInterface
+	reports *eventbus.Bus[*ClaimCheckReport]
+	hooks   []AlertHook
+	alerted map[string]struct{}
+}
+
+// NewClaimChecker creates a new claim checker
+func NewClaimChecker(cfg Config, storage interface{}, reports *eventbus.Bus[*ClaimCheckReport], hooks ...AlertHook) *ClaimChecker {
+	return &ClaimChecker{
+		cfg:     cfg,
+		storage: storage.(storageInterface),
+		reports: reports,
+		hooks:   hooks,
+		alerted: make(map[string]struct{}),
+	}
+}
+
+// Start checks the claims every interval. It returns once the context is done.
+func (c *ClaimChecker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.cfg.ClaimsInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			err := c.check(ctx)
+			if err != nil {
+				log.Errorf("error checking the claims. Error: %v", err)
+			}
+		}
+	}
+}
+
+func (c *ClaimChecker) check(ctx context.Context) error {
+	claims, err := c.storage.GetInconsistentClaims(ctx, nil)
+	if err != nil {
+		return err
+	}
+	findings := NewClaimFindings(claims)
+	metrics.ClaimCheck(len(findings))
+	// The findings removed by a reorg can be alerted again if they reappear
+	alerted := make(map[string]struct{}, len(findings))
+	for _, finding := range findings {
+		key := finding.key()
+		alerted[key] = struct{}{}
+		if _, ok := c.alerted[key]; ok {
+			continue
+		}
+		log.Errorf("networkID: %d, %s claim detected. Index: %d, mainnetFlag: %t, rollupIndex: %d, txHash: %s, claims: %d, mismatched fields: %v",
+			finding.Claim.NetworkID, finding.Kind, finding.Claim.Index, finding.Claim.MainnetFlag, finding.Claim.RollupIndex, finding.Claim.TxHash, finding.ClaimCount, finding.MismatchedFields)
+		for _, hook := range c.hooks {
+			hook(ctx, finding)
+		}
+	}
+	c.alerted = alerted
+	c.reports.Publish(ClaimFindingsTopic, &ClaimCheckReport{CheckedAt: time.Now(), Findings: findings})
+	return nil
+}
@@ -0,0 +1,75 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimCheck(t *testing.T) {
+	ctx := context.Background()
+	deposit := &etherman.Deposit{
+		NetworkID:          0,
+		OriginalNetwork:    0,
+		OriginalAddress:    common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+		Amount:             big.NewInt(100),
+		DestinationNetwork: 1,
+		DestinationAddress: common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+		DepositCount:       1,
+	}
+	claim := etherman.Claim{
+		NetworkID:          1,
+		Index:              1,
+		OriginalNetwork:    0,
+		OriginalAddress:    deposit.OriginalAddress,
+		Amount:             big.NewInt(1000),
+		DestinationAddress: deposit.DestinationAddress,
+		MainnetFlag:        true,
+		TxHash:             common.HexToHash("0x1"),
+	}
+	unknown := etherman.Claim{NetworkID: 1, Index: 5, OriginalAddress: deposit.OriginalAddress, Amount: big.NewInt(1), MainnetFlag: true, TxHash: common.HexToHash("0x2")}
+	inconsistentClaims := []*etherman.InconsistentClaim{
+		{Claim: claim, Deposit: deposit, ClaimCount: 2},
+		{Claim: unknown, ClaimCount: 1},
+	}
+
+	findings := NewClaimFindings(inconsistentClaims)
+	require.Len(t, findings, 3)
+	require.Equal(t, DuplicateFinding, findings[0].Kind)
+	require.Equal(t, MismatchFinding, findings[1].Kind)
+	require.Equal(t, []string{"amount"}, findings[1].MismatchedFields)
+	require.Equal(t, UnknownDepositFinding, findings[2].Kind)
+	require.Nil(t, findings[2].Deposit)
+
+	storage := newStorageMock(t)
+	storage.On("GetInconsistentClaims", ctx, nil).Return(inconsistentClaims, nil).Twice()
+	storage.On("GetInconsistentClaims", ctx, nil).Return(inconsistentClaims[:1], nil).Once()
+	storage.On("GetInconsistentClaims", ctx, nil).Return(inconsistentClaims, nil).Once()
+	var alerts []ClaimFinding
+	reports := eventbus.New[*ClaimCheckReport](1)
+	checker := NewClaimChecker(Config{}, storage, reports, func(ctx context.Context, finding ClaimFinding) {
+		alerts = append(alerts, finding)
+	})
+	// Each finding is alerted once
+	require.NoError(t, checker.check(ctx))
+	require.Len(t, alerts, 3)
+	require.NoError(t, checker.check(ctx))
+	require.Len(t, alerts, 3)
+	// The latest check is published with all its findings, including the ones already alerted
+	report, ok := reports.Latest(ClaimFindingsTopic)
+	require.True(t, ok)
+	require.Equal(t, findings, report.Findings)
+	// A finding that disappears, for instance because of a reorg, is alerted again if it reappears
+	require.NoError(t, checker.check(ctx))
+	require.Len(t, alerts, 3)
+	report, _ = reports.Latest(ClaimFindingsTopic)
+	require.Len(t, report.Findings, 2)
+	require.NoError(t, checker.check(ctx))
+	require.Len(t, alerts, 4)
+	require.Equal(t, UnknownDepositFinding, alerts[3].Kind)
+}
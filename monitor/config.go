@@ -0,0 +1,17 @@
+package monitor
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+)
+
+// Config represents the configuration of the bridge monitors
+type Config struct {
+	// SolvencyEnabled whether to enable the solvency checker
+	SolvencyEnabled bool `mapstructure:"SolvencyEnabled"`
+	// SolvencyInterval is the delay interval between the solvency checks
+	SolvencyInterval types.Duration `mapstructure:"SolvencyInterval"`
+	// ClaimsEnabled whether to enable the checker of the claims without a matching deposit
+	ClaimsEnabled bool `mapstructure:"ClaimsEnabled"`
+	// ClaimsInterval is the delay interval between the claims checks
+	ClaimsInterval types.Duration `mapstructure:"ClaimsInterval"`
+}
@@ -0,0 +1,21 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+type storageInterface interface {
+	GetLastBlock(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.Block, error)
+	GetTokenBalances(ctx context.Context, networkID uint, blockNumber uint64, dbTx pgx.Tx) ([]*etherman.TokenBalance, error)
+	GetInconsistentClaims(ctx context.Context, dbTx pgx.Tx) ([]*etherman.InconsistentClaim, error)
+}
+
+type ethermanInterface interface {
+	GetBalance(ctx context.Context, token, holder common.Address, blockNumber uint64) (*big.Int, error)
+	GetTotalSupply(ctx context.Context, token common.Address, blockNumber uint64) (*big.Int, error)
+}
@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Prefix for the metrics of the monitor package.
+	Prefix = "bridge_monitor_"
+
+	// SolvencyCheckedTokensName is the name of the metric that shows the number of tokens checked by the latest solvency check.
+	SolvencyCheckedTokensName = Prefix + "solvency_checked_tokens"
+
+	// SolvencyDiscrepanciesName is the name of the metric that shows the number of tokens whose on chain amount
+	// doesn't match the synced deposits and claims, or whose wrapped supply exceeds the locked amount, in the latest solvency check.
+	SolvencyDiscrepanciesName = Prefix + "solvency_discrepancies"
+
+	// ClaimFindingsName is the name of the metric that shows the number of claims without a matching deposit in the latest claims check.
+	ClaimFindingsName = Prefix + "claim_findings"
+)
+
+// Register the metrics for the monitor package.
+func Register() {
+	gauges := []prometheus.GaugeOpts{
+		{
+			Name: SolvencyCheckedTokensName,
+			Help: "[MONITOR] number of tokens checked by the latest solvency check",
+		},
+		{
+			Name: SolvencyDiscrepanciesName,
+			Help: "[MONITOR] number of tokens with discrepancies in the latest solvency check",
+		},
+		{
+			Name: ClaimFindingsName,
+			Help: "[MONITOR] number of claims without a matching deposit in the latest claims check",
+		},
+	}
+
+	metrics.RegisterGauges(gauges...)
+}
+
+// SolvencyCheck observes the result of a solvency check of all the networks.
+func SolvencyCheck(tokens, discrepancies int) {
+	metrics.GaugeSet(SolvencyCheckedTokensName, float64(tokens))
+	metrics.GaugeSet(SolvencyDiscrepanciesName, float64(discrepancies))
+}
+
+// ClaimCheck observes the result of a claims check.
+func ClaimCheck(findings int) {
+	metrics.GaugeSet(ClaimFindingsName, float64(findings))
+}
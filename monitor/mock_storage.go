@@ -0,0 +1,121 @@
+// Code generated by mockery v2.39.0. DO NOT EDIT.
+
+package monitor
+
+import (
+	context "context"
+
+	etherman "github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	mock "github.com/stretchr/testify/mock"
+
+	pgx "github.com/jackc/pgx/v4"
+)
+
+// storageMock is an autogenerated mock type for the storageInterface type
+type storageMock struct {
+	mock.Mock
+}
+
+// GetInconsistentClaims provides a mock function with given fields: ctx, dbTx
+func (_m *storageMock) GetInconsistentClaims(ctx context.Context, dbTx pgx.Tx) ([]*etherman.InconsistentClaim, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInconsistentClaims")
+	}
+
+	var r0 []*etherman.InconsistentClaim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) ([]*etherman.InconsistentClaim, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) []*etherman.InconsistentClaim); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*etherman.InconsistentClaim)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLastBlock provides a mock function with given fields: ctx, networkID, dbTx
+func (_m *storageMock) GetLastBlock(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.Block, error) {
+	ret := _m.Called(ctx, networkID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastBlock")
+	}
+
+	var r0 *etherman.Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, pgx.Tx) (*etherman.Block, error)); ok {
+		return rf(ctx, networkID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, pgx.Tx) *etherman.Block); ok {
+		r0 = rf(ctx, networkID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*etherman.Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTokenBalances provides a mock function with given fields: ctx, networkID, blockNumber, dbTx
+func (_m *storageMock) GetTokenBalances(ctx context.Context, networkID uint, blockNumber uint64, dbTx pgx.Tx) ([]*etherman.TokenBalance, error) {
+	ret := _m.Called(ctx, networkID, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTokenBalances")
+	}
+
+	var r0 []*etherman.TokenBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint64, pgx.Tx) ([]*etherman.TokenBalance, error)); ok {
+		return rf(ctx, networkID, blockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint64, pgx.Tx) []*etherman.TokenBalance); ok {
+		r0 = rf(ctx, networkID, blockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*etherman.TokenBalance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint64, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, blockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// newStorageMock creates a new instance of storageMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newStorageMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *storageMock {
+	mock := &storageMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,215 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/monitor/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Network is a network whose bridge is checked
+type Network struct {
+	NetworkID     uint
+	BridgeAddress common.Address
+	Client        ethermanInterface
+}
+
+// TokenSolvency compares the amount of an origin token that the synced deposits and claims expect in a network with
+// the one on chain. The tokens native to the network are locked in the bridge, so the expected amount is compared with
+// the balance of the bridge. The rest of tokens are wrapped, so it is compared with the total supply of the wrapped token.
+type TokenSolvency struct {
+	OriginalNetwork uint
+	OriginalAddress common.Address
+	TokenAddress    common.Address
+	Native          bool
+	Expected        *big.Int
+	Actual          *big.Int
+}
+
+// Discrepancy returns true if the amount on chain doesn't match the expected one
+func (t TokenSolvency) Discrepancy() bool {
+	return t.Expected.Cmp(t.Actual) != 0
+}
+
+// CrossNetworkSolvency compares the amount of a token locked in the bridge of its origin network with the total supply
+// of its wrapped tokens in the rest of networks. The wrapped tokens are minted after the tokens are locked and burnt before
+// they are released, so the locked amount can't be lower than the wrapped supply.
+type CrossNetworkSolvency struct {
+	OriginalAddress common.Address
+	Locked          *big.Int
+	WrappedSupply   *big.Int
+	// WrappedNetworks are the networks where the wrapped token exists
+	WrappedNetworks []uint
+}
+
+// Undercollateralized returns true if the wrapped supply is not fully backed by the locked tokens
+func (c CrossNetworkSolvency) Undercollateralized() bool {
+	return c.Locked.Cmp(c.WrappedSupply) < 0
+}
+
+// SolvencyReport is the result of a solvency check of the bridge of a network at its last synced block. CrossNetwork
+// compares the tokens native to the network with their wrapped tokens in the rest of networks checked at the same time.
+type SolvencyReport struct {
+	NetworkID    uint
+	BlockNumber  uint64
+	CheckedAt    time.Time
+	Tokens       []TokenSolvency
+	CrossNetwork []CrossNetworkSolvency
+}
+
+// SolvencyChecker periodically reconciles the synced deposits and claims of each network with the balances of its
+// bridge and the supplies of its wrapped tokens. The reports are published in a topic per network.
+type SolvencyChecker struct {
+	cfg          Config
+	storage      storageInterface
+	reports      *eventbus.Bus[*SolvencyReport]
+	networksLock sync.RWMutex
+	networks     []Network
+}
+
+// NewSolvencyChecker creates a new solvency checker
+func NewSolvencyChecker(cfg Config, storage interface{}, reports *eventbus.Bus[*SolvencyReport]) *SolvencyChecker {
+	return &SolvencyChecker{
+		cfg:     cfg,
+		storage: storage.(storageInterface),
+		reports: reports,
+	}
+}
+
+// AddNetwork adds a network to the next checks
+func (c *SolvencyChecker) AddNetwork(network Network) {
+	c.networksLock.Lock()
+	defer c.networksLock.Unlock()
+	c.networks = append(c.networks, network)
+}
+
+// Start checks the networks every interval. It returns once the context is done.
+func (c *SolvencyChecker) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.cfg.SolvencyInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			c.checkNetworks(ctx)
+		}
+	}
+}
+
+func (c *SolvencyChecker) checkNetworks(ctx context.Context) {
+	c.networksLock.RLock()
+	networks := append([]Network(nil), c.networks...)
+	c.networksLock.RUnlock()
+	var tokens, discrepancies int
+	var reports []*SolvencyReport
+	for _, network := range networks {
+		report, err := c.Check(ctx, network)
+		if err == gerror.ErrStorageNotFound {
+			log.Debugf("networkID: %d, nothing synced yet, skipping the solvency check", network.NetworkID)
+			continue
+		} else if err != nil {
+			log.Errorf("networkID: %d, error checking the solvency of the bridge. Error: %v", network.NetworkID, err)
+			continue
+		}
+		for _, token := range report.Tokens {
+			if token.Discrepancy() {
+				log.Warnf("networkID: %d, solvency discrepancy at block %d. Origin token: %d %s, token: %s, native: %t, expected: %s, actual: %s",
+					network.NetworkID, report.BlockNumber, token.OriginalNetwork, token.OriginalAddress, token.TokenAddress, token.Native, token.Expected, token.Actual)
+				discrepancies++
+			}
+		}
+		tokens += len(report.Tokens)
+		reports = append(reports, report)
+	}
+	for _, report := range reports {
+		report.CrossNetwork = crossNetworkSolvency(report, reports)
+		for _, token := range report.CrossNetwork {
+			if token.Undercollateralized() {
+				log.Warnf("networkID: %d, token %s undercollateralized. Locked: %s, wrapped supply: %s in the networks %v",
+					report.NetworkID, token.OriginalAddress, token.Locked, token.WrappedSupply, token.WrappedNetworks)
+				discrepancies++
+			}
+		}
+		c.reports.Publish(report.NetworkID, report)
+	}
+	metrics.SolvencyCheck(tokens, discrepancies)
+}
+
+// crossNetworkSolvency compares the tokens native to the network of the report with their wrapped tokens in the rest of reports.
+// The tokens that are not wrapped in any network are skipped.
+func crossNetworkSolvency(report *SolvencyReport, reports []*SolvencyReport) []CrossNetworkSolvency {
+	var result []CrossNetworkSolvency
+	for _, native := range report.Tokens {
+		if !native.Native {
+			continue
+		}
+		token := CrossNetworkSolvency{
+			OriginalAddress: native.OriginalAddress,
+			Locked:          native.Actual,
+			WrappedSupply:   big.NewInt(0),
+		}
+		for _, other := range reports {
+			for _, wrapped := range other.Tokens {
+				if !wrapped.Native && wrapped.OriginalNetwork == report.NetworkID && wrapped.OriginalAddress == native.OriginalAddress {
+					token.WrappedSupply.Add(token.WrappedSupply, wrapped.Actual)
+					token.WrappedNetworks = append(token.WrappedNetworks, other.NetworkID)
+				}
+			}
+		}
+		if len(token.WrappedNetworks) > 0 {
+			result = append(result, token)
+		}
+	}
+	return result
+}
+
+// Check reconciles the synced deposits and claims of the network with the chain at its last synced block
+func (c *SolvencyChecker) Check(ctx context.Context, network Network) (*SolvencyReport, error) {
+	block, err := c.storage.GetLastBlock(ctx, network.NetworkID, nil)
+	if err != nil {
+		return nil, err
+	}
+	balances, err := c.storage.GetTokenBalances(ctx, network.NetworkID, block.BlockNumber, nil)
+	if err != nil {
+		return nil, err
+	}
+	report := &SolvencyReport{
+		NetworkID:   network.NetworkID,
+		BlockNumber: block.BlockNumber,
+		CheckedAt:   time.Now().UTC(),
+	}
+	for _, balance := range balances {
+		token := TokenSolvency{
+			OriginalNetwork: balance.OriginalNetwork,
+			OriginalAddress: balance.OriginalAddress,
+		}
+		switch {
+		case balance.OriginalNetwork == network.NetworkID:
+			// The deposits lock the tokens in the bridge and the claims release them
+			token.Native = true
+			token.TokenAddress = balance.OriginalAddress
+			token.Expected = new(big.Int).Sub(balance.Deposited, balance.Claimed)
+			token.Actual, err = network.Client.GetBalance(ctx, token.TokenAddress, network.BridgeAddress, block.BlockNumber)
+		case balance.WrappedAddress != nil:
+			// The claims mint the wrapped tokens and the deposits burn them
+			token.TokenAddress = *balance.WrappedAddress
+			token.Expected = new(big.Int).Sub(balance.Claimed, balance.Deposited)
+			token.Actual, err = network.Client.GetTotalSupply(ctx, token.TokenAddress, block.BlockNumber)
+		default:
+			// The gas token of the rollups is not wrapped, so it can't be checked
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		report.Tokens = append(report.Tokens, token)
+	}
+	return report, nil
+}
@@ -0,0 +1,108 @@
+package monitor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
+	"github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/pol"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSolvencyCheck(t *testing.T) {
+	ctx := context.Background()
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(1337))
+	require.NoError(t, err)
+	client, backend, polAddr, _, _, err := etherman.NewSimulatedEtherman(etherman.Config{}, auth)
+	require.NoError(t, err)
+	bridgeAddr := client.SCAddresses[1]
+
+	// Lock some POL in the bridge
+	polContract, err := pol.NewPol(polAddr, backend)
+	require.NoError(t, err)
+	_, err = polContract.Transfer(auth, bridgeAddr, big.NewInt(200))
+	require.NoError(t, err)
+	backend.Commit()
+	polSupply, err := polContract.TotalSupply(&bind.CallOpts{})
+	require.NoError(t, err)
+	blockNumber := backend.Blockchain().CurrentBlock().Number.Uint64()
+
+	storage := newStorageMock(t)
+	storage.On("GetLastBlock", ctx, uint(0), nil).Return(&etherman.Block{BlockNumber: blockNumber}, nil)
+	storage.On("GetTokenBalances", ctx, uint(0), blockNumber, nil).Return([]*etherman.TokenBalance{
+		// The ether deposited is not in the bridge
+		{OriginalNetwork: 0, OriginalAddress: common.Address{}, Deposited: big.NewInt(5), Claimed: big.NewInt(0)},
+		{OriginalNetwork: 0, OriginalAddress: polAddr, Deposited: big.NewInt(300), Claimed: big.NewInt(100)},
+		// POL plays the role of a token wrapped in the network
+		{OriginalNetwork: 1, OriginalAddress: common.HexToAddress("0x1"), WrappedAddress: &polAddr, Deposited: big.NewInt(0), Claimed: polSupply},
+		// The tokens that are not wrapped yet are skipped
+		{OriginalNetwork: 1, OriginalAddress: common.HexToAddress("0x2"), Deposited: big.NewInt(0), Claimed: big.NewInt(1)},
+	}, nil)
+
+	reports := eventbus.New[*SolvencyReport](1)
+	checker := NewSolvencyChecker(Config{}, storage, reports)
+	checker.AddNetwork(Network{NetworkID: 0, BridgeAddress: bridgeAddr, Client: client})
+	checker.checkNetworks(ctx)
+
+	report, ok := reports.Latest(0)
+	require.True(t, ok)
+	require.Equal(t, blockNumber, report.BlockNumber)
+	require.Len(t, report.Tokens, 3)
+
+	eth := report.Tokens[0]
+	require.True(t, eth.Native)
+	require.True(t, eth.Discrepancy())
+	require.Equal(t, big.NewInt(5), eth.Expected)
+	require.Equal(t, int64(0), eth.Actual.Int64())
+
+	locked := report.Tokens[1]
+	require.True(t, locked.Native)
+	require.Equal(t, polAddr, locked.TokenAddress)
+	require.False(t, locked.Discrepancy())
+
+	wrapped := report.Tokens[2]
+	require.False(t, wrapped.Native)
+	require.Equal(t, polAddr, wrapped.TokenAddress)
+	require.Equal(t, polSupply, wrapped.Actual)
+	require.False(t, wrapped.Discrepancy())
+}
+
+func TestCrossNetworkSolvency(t *testing.T) {
+	token := common.HexToAddress("0x1")
+	notWrapped := common.HexToAddress("0x2")
+	l1 := &SolvencyReport{NetworkID: 0, Tokens: []TokenSolvency{
+		{OriginalNetwork: 0, OriginalAddress: token, Native: true, Actual: big.NewInt(100)},
+		{OriginalNetwork: 0, OriginalAddress: notWrapped, Native: true, Actual: big.NewInt(5)},
+	}}
+	l2 := &SolvencyReport{NetworkID: 1, Tokens: []TokenSolvency{
+		{OriginalNetwork: 0, OriginalAddress: token, Actual: big.NewInt(60)},
+	}}
+	l3 := &SolvencyReport{NetworkID: 2, Tokens: []TokenSolvency{
+		{OriginalNetwork: 0, OriginalAddress: token, Actual: big.NewInt(30)},
+	}}
+	reports := []*SolvencyReport{l1, l2, l3}
+
+	result := crossNetworkSolvency(l1, reports)
+	require.Len(t, result, 1)
+	require.Equal(t, token, result[0].OriginalAddress)
+	require.Equal(t, big.NewInt(100), result[0].Locked)
+	require.Equal(t, big.NewInt(90), result[0].WrappedSupply)
+	require.Equal(t, []uint{1, 2}, result[0].WrappedNetworks)
+	require.False(t, result[0].Undercollateralized())
+
+	// More wrapped tokens than locked ones
+	l3.Tokens[0].Actual = big.NewInt(50)
+	result = crossNetworkSolvency(l1, reports)
+	require.Len(t, result, 1)
+	require.True(t, result[0].Undercollateralized())
+
+	// The networks without native tokens have nothing to compare
+	require.Empty(t, crossNetworkSolvency(l2, reports))
+}
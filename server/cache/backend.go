@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// Backend stores the cached responses. The generation is increased on each invalidation, and it's part
+// of the keys, so the responses cached before the invalidation are never read again.
+type Backend interface {
+	// Get returns the value of the key and whether it was found
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores the value of the key for the ttl. A zero ttl means no expiration
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Generation returns the current generation of the keys
+	Generation(ctx context.Context) (uint64, error)
+	// Invalidate increases the generation and discards the cached values
+	Invalidate(ctx context.Context) error
+	// Close releases the resources of the backend
+	Close() error
+}
+
+type memoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+type memoryBackend struct {
+	entries    *lru.Cache[string, memoryEntry]
+	generation atomic.Uint64
+}
+
+// NewMemoryBackend creates a backend that keeps up to size values in an in-process lru-cache
+func NewMemoryBackend(size int) (Backend, error) {
+	entries, err := lru.New[string, memoryEntry](size)
+	if err != nil {
+		return nil, err
+	}
+	return &memoryBackend{entries: entries}, nil
+}
+
+func (b *memoryBackend) Get(_ context.Context, key string) ([]byte, bool, error) {
+	entry, found := b.entries.Get(key)
+	if !found {
+		return nil, false, nil
+	}
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		b.entries.Remove(key)
+		return nil, false, nil
+	}
+	return entry.value, true, nil
+}
+
+func (b *memoryBackend) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	entry := memoryEntry{value: value}
+	if ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+	b.entries.Add(key, entry)
+	return nil
+}
+
+func (b *memoryBackend) Generation(_ context.Context) (uint64, error) {
+	return b.generation.Load(), nil
+}
+
+func (b *memoryBackend) Invalidate(_ context.Context) error {
+	b.generation.Add(1)
+	b.entries.Purge()
+	return nil
+}
+
+func (b *memoryBackend) Close() error {
+	return nil
+}
@@ -0,0 +1,133 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// createdAtSize is the size of the creation time stored before each response
+const createdAtSize = 8
+
+var (
+	// ErrUnsupportedBackend is used when the configured backend is not memory or redis
+	ErrUnsupportedBackend = errors.New("unsupported cache backend")
+	// ErrInvalidEntry is used when a cached value is too short to be a response
+	ErrInvalidEntry = errors.New("invalid cache entry")
+)
+
+// Cache caches the responses of the read APIs. All the responses are invalidated when the synchronizer
+// or the claim tx managers commit new data, so they are never older than the synced state.
+type Cache struct {
+	backend      Backend
+	ttl          time.Duration
+	commitEvents *eventbus.Bus[uint]
+}
+
+// New creates a response cache with the configured backend. It's invalidated by the events of commitEvents once started.
+func New(cfg Config, commitEvents *eventbus.Bus[uint]) (*Cache, error) {
+	var backend Backend
+	switch cfg.Backend {
+	case BackendMemory:
+		var err error
+		backend, err = NewMemoryBackend(cfg.Size)
+		if err != nil {
+			return nil, err
+		}
+	case BackendRedis:
+		backend = NewRedisBackend(cfg.Redis)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedBackend, cfg.Backend)
+	}
+	return NewWithBackend(backend, cfg.TTL.Duration, commitEvents), nil
+}
+
+// NewWithBackend creates a response cache that stores the responses in the backend
+func NewWithBackend(backend Backend, ttl time.Duration, commitEvents *eventbus.Bus[uint]) *Cache {
+	return &Cache{
+		backend:      backend,
+		ttl:          ttl,
+		commitEvents: commitEvents,
+	}
+}
+
+// Start invalidates the cache every time new data is committed. The events received while
+// invalidating are coalesced. It returns once the context is done.
+func (c *Cache) Start(ctx context.Context) error {
+	sub := c.commitEvents.SubscribeAll()
+	defer sub.Unsubscribe()
+	defer c.backend.Close()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.Events():
+		}
+	drain:
+		for {
+			select {
+			case <-sub.Events():
+			default:
+				break drain
+			}
+		}
+		err := c.Invalidate(ctx)
+		if err != nil {
+			log.Errorf("error invalidating the response cache. Error: %v", err)
+		}
+	}
+}
+
+// Invalidate discards all the cached responses
+func (c *Cache) Invalidate(ctx context.Context) error {
+	return c.backend.Invalidate(ctx)
+}
+
+// Key returns the key of the response of the method to the request in the current generation
+func (c *Cache) Key(ctx context.Context, method string, req proto.Message) (string, error) {
+	generation, err := c.backend.Generation(ctx)
+	if err != nil {
+		return "", err
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d:%s:%x", generation, method, sha256.Sum256(data)), nil
+}
+
+// Get reads the cached response of the key into res. It returns the time since the response was cached
+// and whether it was found.
+func (c *Cache) Get(ctx context.Context, key string, res proto.Message) (time.Duration, bool, error) {
+	value, found, err := c.backend.Get(ctx, key)
+	if err != nil || !found {
+		return 0, false, err
+	}
+	if len(value) < createdAtSize {
+		return 0, false, ErrInvalidEntry
+	}
+	err = proto.Unmarshal(value[createdAtSize:], res)
+	if err != nil {
+		return 0, false, err
+	}
+	createdAt := time.Unix(0, int64(binary.BigEndian.Uint64(value[:createdAtSize])))
+	return time.Since(createdAt), true, nil
+}
+
+// Set caches the response of the key
+func (c *Cache) Set(ctx context.Context, key string, res proto.Message) error {
+	data, err := proto.Marshal(res)
+	if err != nil {
+		return err
+	}
+	value := make([]byte, createdAtSize, createdAtSize+len(data))
+	binary.BigEndian.PutUint64(value, uint64(time.Now().UnixNano()))
+	return c.backend.Set(ctx, key, append(value, data...), c.ttl)
+}
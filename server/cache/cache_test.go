@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+	commitEvents := eventbus.New[uint](10)
+	c, err := New(Config{Backend: BackendMemory, Size: 10, TTL: types.NewDuration(time.Minute)}, commitEvents)
+	require.NoError(t, err)
+
+	req := &pb.GetProofRequest{NetId: 1, DepositCnt: 2}
+	key, err := c.Key(ctx, "GetProof", req)
+	require.NoError(t, err)
+	otherKey, err := c.Key(ctx, "GetProof", &pb.GetProofRequest{NetId: 1, DepositCnt: 3})
+	require.NoError(t, err)
+	require.NotEqual(t, key, otherKey)
+
+	var res pb.GetProofResponse
+	_, found, err := c.Get(ctx, key, &res)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	expected := &pb.GetProofResponse{Proof: &pb.Proof{MainExitRoot: "0x1", RollupExitRoot: "0x2"}}
+	require.NoError(t, c.Set(ctx, key, expected))
+	age, found, err := c.Get(ctx, key, &res)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.GreaterOrEqual(t, age, time.Duration(0))
+	require.Equal(t, expected.Proof.MainExitRoot, res.Proof.MainExitRoot)
+	require.Equal(t, expected.Proof.RollupExitRoot, res.Proof.RollupExitRoot)
+
+	// A commit of any network invalidates the responses
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+	go func() {
+		done <- c.Start(ctx)
+	}()
+	require.Eventually(t, func() bool {
+		commitEvents.Publish(1, 1)
+		newKey, err := c.Key(ctx, "GetProof", req)
+		return err == nil && newKey != key
+	}, time.Second, 10*time.Millisecond)
+	_, found, err = c.Get(ctx, key, &res)
+	require.NoError(t, err)
+	require.False(t, found)
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestMemoryBackendTTL(t *testing.T) {
+	ctx := context.Background()
+	backend, err := NewMemoryBackend(10)
+	require.NoError(t, err)
+	require.NoError(t, backend.Set(ctx, "a", []byte{1}, time.Millisecond))
+	require.NoError(t, backend.Set(ctx, "b", []byte{2}, 0))
+	time.Sleep(5 * time.Millisecond)
+	_, found, err := backend.Get(ctx, "a")
+	require.NoError(t, err)
+	require.False(t, found)
+	value, found, err := backend.Get(ctx, "b")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte{2}, value)
+}
+
+func TestRedisCacheShared(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	cfg := Config{
+		Backend: BackendRedis,
+		TTL:     types.NewDuration(time.Minute),
+		Redis:   RedisConfig{Addr: server.Addr(), KeyPrefix: "bridge:"},
+	}
+	// Two replicas of the service sharing the redis server
+	replica1, err := New(cfg, eventbus.New[uint](10))
+	require.NoError(t, err)
+	replica2, err := New(cfg, eventbus.New[uint](10))
+	require.NoError(t, err)
+
+	req := &pb.GetTokenWrappedRequest{OrigTokenAddr: "0x1", OrigNet: 0}
+	key, err := replica1.Key(ctx, "GetTokenWrapped", req)
+	require.NoError(t, err)
+	expected := &pb.GetTokenWrappedResponse{Tokenwrapped: &pb.TokenWrapped{WrappedTokenAddr: "0x2"}}
+	require.NoError(t, replica1.Set(ctx, key, expected))
+	require.True(t, server.Exists("bridge:"+key))
+	require.Equal(t, time.Minute, server.TTL("bridge:"+key))
+
+	key2, err := replica2.Key(ctx, "GetTokenWrapped", req)
+	require.NoError(t, err)
+	require.Equal(t, key, key2)
+	var res pb.GetTokenWrappedResponse
+	_, found, err := replica2.Get(ctx, key2, &res)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "0x2", res.Tokenwrapped.WrappedTokenAddr)
+
+	// The invalidation of a replica is seen by the other
+	require.NoError(t, replica1.Invalidate(ctx))
+	key2, err = replica2.Key(ctx, "GetTokenWrapped", req)
+	require.NoError(t, err)
+	require.NotEqual(t, key, key2)
+	_, found, err = replica2.Get(ctx, key2, &res)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// The backend errors are returned
+	server.Close()
+	_, err = replica2.Key(ctx, "GetTokenWrapped", req)
+	require.Error(t, err)
+}
+
+func TestUnsupportedBackend(t *testing.T) {
+	_, err := New(Config{Backend: "memcached"}, eventbus.New[uint](1))
+	require.ErrorIs(t, err, ErrUnsupportedBackend)
+}
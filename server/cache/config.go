@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+)
+
+const (
+	// BackendMemory keeps the responses in an in-process lru-cache
+	BackendMemory = "memory"
+	// BackendRedis keeps the responses in a redis server, so they are shared by all the replicas of the service
+	BackendRedis = "redis"
+)
+
+// Config represents the configuration of the response cache of the read APIs
+type Config struct {
+	// Enabled whether to cache the responses of the read APIs
+	Enabled bool `mapstructure:"Enabled"`
+	// Backend is where the responses are stored: memory or redis
+	Backend string `mapstructure:"Backend"`
+	// Size is the maximum number of responses kept by the memory backend
+	Size int `mapstructure:"Size"`
+	// TTL is the maximum time a response is cached. The responses are invalidated when the synced data changes,
+	// so it only bounds how long a response can be stale if an invalidation is missed
+	TTL types.Duration `mapstructure:"TTL"`
+	// Redis is the configuration of the redis backend
+	Redis RedisConfig `mapstructure:"Redis"`
+}
+
+// RedisConfig represents the configuration of the redis backend
+type RedisConfig struct {
+	// Addr is the host:port of the redis server
+	Addr string `mapstructure:"Addr"`
+	// Username is the user of the redis server, if ACLs are used
+	Username string `mapstructure:"Username"`
+	// Password is the password of the redis server
+	Password string `mapstructure:"Password"`
+	// DB is the redis database number
+	DB int `mapstructure:"DB"`
+	// KeyPrefix is prepended to all the keys, so several services can share the redis server
+	KeyPrefix string `mapstructure:"KeyPrefix"`
+}
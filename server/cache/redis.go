@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// generationKey is the key of the generation counter
+const generationKey = "generation"
+
+type redisBackend struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisBackend creates a backend that keeps the values in a redis server. The values of the previous
+// generations are not deleted on invalidation, they are evicted when their ttl expires.
+func NewRedisBackend(cfg RedisConfig) Backend {
+	return &redisBackend{
+		client: redis.NewClient(&redis.Options{
+			Addr:     cfg.Addr,
+			Username: cfg.Username,
+			Password: cfg.Password,
+			DB:       cfg.DB,
+		}),
+		prefix: cfg.KeyPrefix,
+	}
+}
+
+func (b *redisBackend) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := b.client.Get(ctx, b.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (b *redisBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return b.client.Set(ctx, b.prefix+key, value, ttl).Err()
+}
+
+func (b *redisBackend) Generation(ctx context.Context) (uint64, error) {
+	generation, err := b.client.Get(ctx, b.prefix+generationKey).Uint64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return generation, err
+}
+
+func (b *redisBackend) Invalidate(ctx context.Context) error {
+	return b.client.Incr(ctx, b.prefix+generationKey).Err()
+}
+
+func (b *redisBackend) Close() error {
+	return b.client.Close()
+}
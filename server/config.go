@@ -1,6 +1,9 @@
 package server
 
-import "github.com/0xPolygonHermez/zkevm-bridge-service/db"
+import (
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/server/cache"
+)
 
 // Config struct
 type Config struct {
@@ -18,4 +21,6 @@ type Config struct {
 	BridgeVersion string `mapstructure:"BridgeVersion"`
 	// DB is the database config
 	DB db.Config `mapstructure:"DB"`
+	// ResponseCache is the config of the response cache of the read APIs
+	ResponseCache cache.Config `mapstructure:"ResponseCache"`
 }
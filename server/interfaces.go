@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/ethereum/go-ethereum/common"
@@ -13,12 +14,30 @@ type bridgeServiceStorage interface {
 	GetRoot(ctx context.Context, depositCnt uint, network uint, dbTx pgx.Tx) ([]byte, error)
 	GetDepositCountByRoot(ctx context.Context, root []byte, network uint8, dbTx pgx.Tx) (uint, error)
 	GetLatestExitRoot(ctx context.Context, isRollup bool, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
-	GetClaim(ctx context.Context, index uint, networkID uint, dbTx pgx.Tx) (*etherman.Claim, error)
+	GetClaim(ctx context.Context, index uint, origNetworkID uint, networkID uint, dbTx pgx.Tx) (*etherman.Claim, error)
 	GetClaims(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Claim, error)
 	GetClaimCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error)
 	GetDeposit(ctx context.Context, depositCnt uint, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error)
 	GetDeposits(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
 	GetDepositCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error)
+	GetPendingClaims(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	GetPendingClaimTotals(ctx context.Context, destAddr string, dbTx pgx.Tx) ([]*etherman.PendingClaimTotal, error)
 	GetTokenWrapped(ctx context.Context, originalNetwork uint, originalTokenAddress common.Address, dbTx pgx.Tx) (*etherman.TokenWrapped, error)
+	GetTokensWrapped(ctx context.Context, networkID *uint, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.TokenWrapped, error)
+	GetTokenWrappedCount(ctx context.Context, networkID *uint, dbTx pgx.Tx) (uint64, error)
+	GetTokenWrappedByAddress(ctx context.Context, wrappedTokenAddress common.Address, networkID uint, dbTx pgx.Tx) (*etherman.TokenWrapped, error)
+	GetNativeTokenMetadata(ctx context.Context, tokenAddress common.Address, networkID uint, dbTx pgx.Tx) (*etherman.TokenMetadata, error)
+	GetBridgeStats(ctx context.Context, interval string, from, to time.Time, origNet *uint, origAddr *common.Address, dbTx pgx.Tx) ([]*etherman.BridgeStats, error)
+	GetUnclaimedStats(ctx context.Context, origNet *uint, origAddr *common.Address, dbTx pgx.Tx) ([]*etherman.UnclaimedStats, error)
 	GetRollupExitLeavesByRoot(ctx context.Context, root common.Hash, dbTx pgx.Tx) ([]etherman.RollupExitLeaf, error)
+	GetReorgs(ctx context.Context, networkID uint, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Reorg, error)
+	GetReorgCount(ctx context.Context, networkID uint, dbTx pgx.Tx) (uint64, error)
+	GetEmergencyState(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.EmergencyState, error)
+	GetRollups(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Rollup, error)
+	GetLastBlock(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.Block, error)
+	GetLastBlocks(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Block, error)
+	GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	GetLatestTrustedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	GetLatestRollupExitRoot(ctx context.Context, dbTx pgx.Tx) (common.Hash, error)
+	GetClaimableDelay(ctx context.Context, networkID uint, limit uint, dbTx pgx.Tx) (time.Duration, error)
 }
@@ -5,9 +5,8 @@ import (
 	"fmt"
 	"net"
 	"net/http"
-	"os"
-	"os/signal"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
@@ -19,10 +18,13 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-// RunServer runs gRPC server and HTTP gateway
-func RunServer(cfg Config, bridgeService pb.BridgeServiceServer) error {
-	ctx := context.Background()
+// shutdownTimeout is the time given to the servers to drain the in-flight requests
+const shutdownTimeout = 5 * time.Second
 
+// RunServer runs gRPC server and HTTP gateway until the context is done.
+// Then, the servers stop accepting requests and the in-flight ones are drained
+// before returning, at most for the shutdown timeout.
+func RunServer(ctx context.Context, cfg Config, bridgeService pb.BridgeServiceServer) error {
 	if len(cfg.GRPCPort) == 0 {
 		return fmt.Errorf("invalid TCP port for gRPC server: '%s'", cfg.GRPCPort)
 	}
@@ -31,15 +33,32 @@ func RunServer(cfg Config, bridgeService pb.BridgeServiceServer) error {
 		return fmt.Errorf("invalid TCP port for HTTP gateway: '%s'", cfg.HTTPPort)
 	}
 
+	// The gRPC server is stopped once the HTTP gateway is drained, so the requests
+	// forwarded by the gateway are served.
+	restCtx, stopRest := context.WithCancel(ctx)
+	grpcCtx, stopGRPC := context.WithCancel(context.WithoutCancel(ctx))
+	var (
+		wg               sync.WaitGroup
+		restErr, grpcErr error
+	)
+	wg.Add(2) //nolint:gomnd
 	go func() {
-		_ = runRestServer(ctx, cfg.GRPCPort, cfg.HTTPPort)
+		defer wg.Done()
+		defer stopGRPC()
+		restErr = runRestServer(restCtx, cfg.GRPCPort, cfg.HTTPPort)
 	}()
 
 	go func() {
-		_ = runGRPCServer(ctx, bridgeService, cfg.GRPCPort)
+		defer wg.Done()
+		defer stopRest()
+		grpcErr = runGRPCServer(grpcCtx, bridgeService, cfg.GRPCPort)
 	}()
 
-	return nil
+	wg.Wait()
+	if grpcErr != nil {
+		return grpcErr
+	}
+	return restErr
 }
 
 // HealthChecker will provide an implementation of the HealthCheck interface.
@@ -81,12 +100,18 @@ func runGRPCServer(ctx context.Context, bridgeServer pb.BridgeServiceServer, por
 	healthService := newHealthChecker()
 	grpc_health_v1.RegisterHealthServer(server, healthService)
 
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
 	go func() {
-		for range c {
+		<-ctx.Done()
+		stopped := make(chan struct{})
+		go func() {
 			server.GracefulStop()
-			<-ctx.Done()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			log.Warn("gRPC Server didn't drain the requests in time, stopping it")
+			server.Stop()
 		}
 	}()
 
@@ -117,9 +142,6 @@ func allowCORS(h http.Handler) http.Handler {
 }
 
 func runRestServer(ctx context.Context, grpcPort, httpPort string) error {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	endpoint := "localhost:" + grpcPort
 	conn, err := grpc.Dial(endpoint, opts...)
@@ -149,20 +171,24 @@ func runRestServer(ctx context.Context, grpcPort, httpPort string) error {
 		Handler:     allowCORS(mux),
 	}
 
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
+	shutdownErr := make(chan error, 1)
 	go func() {
-		for range c {
-			_ = srv.Shutdown(ctx)
-			<-ctx.Done()
-		}
-
-		_, cancel := context.WithTimeout(ctx, 5*time.Second) //nolint:gomnd
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 		defer cancel()
 
-		_ = srv.Shutdown(ctx)
+		err := srv.Shutdown(shutdownCtx)
+		if err != nil {
+			log.Warn("Restful Server didn't drain the requests in time, closing it")
+			_ = srv.Close()
+		}
+		shutdownErr <- conn.Close()
 	}()
 
 	log.Info("Restful Server is serving at ", httpPort)
-	return srv.ListenAndServe()
+	err = srv.ListenAndServe()
+	if err != http.ErrServerClosed {
+		return err
+	}
+	return <-shutdownErr
 }
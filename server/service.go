@@ -4,52 +4,148 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math"
+	"math/big"
+	"sync"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/monitor"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/server/cache"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/synchronizer"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/ethereum/go-ethereum/common"
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/jackc/pgx/v4"
+	"google.golang.org/protobuf/proto"
 )
 
+// Network is a network synced by the service
+type Network struct {
+	NetworkID     uint
+	ChainID       uint64
+	BridgeAddress common.Address
+	// RollupID is the id of the rollup in the rollupManager. It's 0 for L1
+	RollupID uint
+}
+
+const (
+	// claimableDelaySamples is the number of latest deposits of a network used to estimate when a deposit is claimable
+	claimableDelaySamples = 100
+	// claimableDelayTTL is the time that the estimation of a network is cached
+	claimableDelayTTL = time.Minute
+	// maxStatsBuckets is the maximum number of time buckets returned by GetBridgeStats
+	maxStatsBuckets = 744
+	// defaultStatsBuckets is the number of time buckets returned by GetBridgeStats when the time range is not provided
+	defaultStatsBuckets = 24
+)
+
+// statsIntervals are the time buckets supported by GetBridgeStats
+var statsIntervals = map[string]time.Duration{
+	"hour": time.Hour,
+	"day":  24 * time.Hour, //nolint:gomnd
+}
+
+type claimableDelay struct {
+	delay     time.Duration
+	found     bool
+	updatedAt time.Time
+}
+
 type bridgeService struct {
-	rollupID         uint
 	storage          bridgeServiceStorage
+	networksLock     sync.RWMutex
+	networks         []Network
 	networkIDs       map[uint]uint8
+	autoClaims       map[uint][]common.Address
+	statusEvents     *eventbus.Bus[*synchronizer.Status]
+	solvencyReports  *eventbus.Bus[*monitor.SolvencyReport]
+	claimReports     *eventbus.Bus[*monitor.ClaimCheckReport]
 	height           uint8
 	defaultPageLimit uint32
 	maxPageLimit     uint32
 	version          string
 	cache            *lru.Cache[string, [][]byte]
+	responses        *cache.Cache
+	delaysLock       sync.Mutex
+	claimableDelays  map[uint]claimableDelay
 	pb.UnimplementedBridgeServiceServer
 }
 
-// NewBridgeService creates new bridge service.
-func NewBridgeService(cfg Config, height uint8, networks []uint, storage interface{}, rollupID uint) *bridgeService {
+// NewBridgeService creates new bridge service. The responses of the read APIs are cached in responses, if any.
+func NewBridgeService(cfg Config, height uint8, networks []Network, storage interface{}, statusEvents *eventbus.Bus[*synchronizer.Status], solvencyReports *eventbus.Bus[*monitor.SolvencyReport], claimReports *eventbus.Bus[*monitor.ClaimCheckReport], responses *cache.Cache) *bridgeService {
 	var networkIDs = make(map[uint]uint8)
 	for i, network := range networks {
-		networkIDs[network] = uint8(i)
+		networkIDs[network.NetworkID] = uint8(i)
 	}
-	cache, err := lru.New[string, [][]byte](cfg.CacheSize)
+	nodeCache, err := lru.New[string, [][]byte](cfg.CacheSize)
 	if err != nil {
 		panic(err)
 	}
 	return &bridgeService{
-		rollupID:         rollupID,
 		storage:          storage.(bridgeServiceStorage),
 		height:           height,
+		networks:         networks,
 		networkIDs:       networkIDs,
+		autoClaims:       make(map[uint][]common.Address),
+		statusEvents:     statusEvents,
+		solvencyReports:  solvencyReports,
+		claimReports:     claimReports,
 		defaultPageLimit: cfg.DefaultPageLimit,
 		maxPageLimit:     cfg.MaxPageLimit,
 		version:          cfg.BridgeVersion,
-		cache:            cache,
+		cache:            nodeCache,
+		responses:        responses,
+		claimableDelays:  make(map[uint]claimableDelay),
+	}
+}
+
+// AddNetwork registers a network at runtime, when its rollup is added to the rollupManager.
+func (s *bridgeService) AddNetwork(network Network) {
+	s.networksLock.Lock()
+	defer s.networksLock.Unlock()
+	if _, found := s.networkIDs[network.NetworkID]; !found {
+		s.networkIDs[network.NetworkID] = uint8(len(s.networks))
+		s.networks = append(s.networks, network)
 	}
 }
 
+// EnableAutoClaim registers that the deposits to the network are claimed by a ClaimTxManager. The message
+// deposits are only claimed when they are sent by one of the authorized addresses.
+func (s *bridgeService) EnableAutoClaim(networkID uint, authorizedMessageAddrs []common.Address) {
+	s.networksLock.Lock()
+	defer s.networksLock.Unlock()
+	s.autoClaims[networkID] = authorizedMessageAddrs
+}
+
+// isAutoClaimed checks if a ClaimTxManager claims the deposit. It only claims the deposits from mainnet.
+func (s *bridgeService) isAutoClaimed(deposit *etherman.Deposit) bool {
+	s.networksLock.RLock()
+	defer s.networksLock.RUnlock()
+	authorizedMessageAddrs, found := s.autoClaims[deposit.DestinationNetwork]
+	if !found || deposit.NetworkID != 0 {
+		return false
+	}
+	if deposit.LeafType != uint8(utils.LeafTypeMessage) {
+		return true
+	}
+	for _, addr := range authorizedMessageAddrs {
+		if deposit.OriginalAddress == addr {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *bridgeService) getNetworkID(networkID uint) (uint8, error) {
+	s.networksLock.RLock()
+	defer s.networksLock.RUnlock()
 	tID, found := s.networkIDs[networkID]
 	if !found {
 		return 0, gerror.ErrNetworkNotRegister
@@ -74,11 +170,10 @@ func (s *bridgeService) getNode(ctx context.Context, parentHash [bridgectrl.KeyL
 }
 
 // getProof returns the merkle proof for a given index and root.
-func (s *bridgeService) getProof(index uint, root [bridgectrl.KeyLen]byte, dbTx pgx.Tx) ([][bridgectrl.KeyLen]byte, error) {
+func (s *bridgeService) getProof(ctx context.Context, index uint, root [bridgectrl.KeyLen]byte, dbTx pgx.Tx) ([][bridgectrl.KeyLen]byte, error) {
 	var siblings [][bridgectrl.KeyLen]byte
 
 	cur := root
-	ctx := context.Background()
 	// It starts in height-1 because 0 is the level of the leafs
 	for h := int(s.height - 1); h >= 0; h-- {
 		left, right, err := s.getNode(ctx, cur, dbTx)
@@ -125,9 +220,7 @@ func (s *bridgeService) getProof(index uint, root [bridgectrl.KeyLen]byte, dbTx
 }
 
 // getRollupExitProof returns the merkle proof for the zkevm leaf.
-func (s *bridgeService) getRollupExitProof(rollupIndex uint, root common.Hash, dbTx pgx.Tx) ([][bridgectrl.KeyLen]byte, common.Hash, error) {
-	ctx := context.Background()
-
+func (s *bridgeService) getRollupExitProof(ctx context.Context, rollupIndex uint, root common.Hash, dbTx pgx.Tx) ([][bridgectrl.KeyLen]byte, common.Hash, error) {
 	// Get leaves given the root
 	leaves, err := s.storage.GetRollupExitLeavesByRoot(ctx, root, dbTx)
 	if err != nil {
@@ -140,6 +233,9 @@ func (s *bridgeService) getRollupExitProof(rollupIndex uint, root common.Hash, d
 		copy(aux[:], l.Leaf.Bytes())
 		ls = append(ls, aux)
 	}
+	if rollupIndex >= uint(len(ls)) {
+		return nil, common.Hash{}, fmt.Errorf("rollup index %d not found in the rollupExitTree. Root: %s", rollupIndex, root.String())
+	}
 	siblings, r, err := bridgectrl.ComputeSiblings(rollupIndex, ls, s.height)
 	if err != nil {
 		return nil, common.Hash{}, err
@@ -149,9 +245,73 @@ func (s *bridgeService) getRollupExitProof(rollupIndex uint, root common.Hash, d
 	return siblings, ls[rollupIndex], nil
 }
 
+// getRollupIndex returns the index of the rollup in the rollupExitTree. The rollupManager
+// assigns to each rollup a network ID equal to its rollup ID, and the rollup IDs start at 1.
+func getRollupIndex(networkID uint) uint {
+	return networkID - 1
+}
+
+// getGlobalIndex returns the global index used to claim the deposit.
+func getGlobalIndex(deposit *etherman.Deposit) *big.Int {
+	if deposit.NetworkID == 0 {
+		return etherman.GenerateGlobalIndex(true, 0, deposit.DepositCount)
+	}
+	return etherman.GenerateGlobalIndex(false, getRollupIndex(deposit.NetworkID), deposit.DepositCount)
+}
+
+// getClaimableDepositCount gets the deposit count of the root of the network included in the latest exit root, as
+// GetClaimProof takes it. The proofs to claim the deposits of the network up to that one can be built. The root of
+// a rollup is its leaf in the rollup exit tree of that exit root. found is false if no exit root includes the network.
+func (s *bridgeService) getClaimableDepositCount(ctx context.Context, networkID uint) (depositCount uint, found bool, err error) {
+	ctx = pgstorage.WithConsistentRead(ctx)
+	tID, err := s.getNetworkID(networkID)
+	if err == gerror.ErrNetworkNotRegister {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+	globalExitRoot, err := s.storage.GetLatestExitRoot(ctx, tID != 0, nil)
+	if err == gerror.ErrStorageNotFound {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+	const mainnetExitRootIndex, rollupExitRootIndex = 0, 1
+	root := globalExitRoot.ExitRoots[mainnetExitRootIndex]
+	if networkID != 0 {
+		leaves, err := s.storage.GetRollupExitLeavesByRoot(ctx, globalExitRoot.ExitRoots[rollupExitRootIndex], nil)
+		if err == gerror.ErrStorageNotFound {
+			return 0, false, nil
+		} else if err != nil {
+			return 0, false, err
+		}
+		rollupIndex := getRollupIndex(networkID)
+		if rollupIndex >= uint(len(leaves)) {
+			return 0, false, nil
+		}
+		root = leaves[rollupIndex].Leaf
+	}
+	depositCount, err = s.storage.GetDepositCountByRoot(ctx, root[:], uint8(networkID), nil)
+	if err == gerror.ErrStorageNotFound {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+	return depositCount, true, nil
+}
+
+// getClaimTxHash returns the hash of the tx that claimed the deposit, or an empty string if it isn't claimed yet.
+func getClaimTxHash(deposit *etherman.Deposit) string {
+	if deposit.ClaimTxHash == nil {
+		return ""
+	}
+	return deposit.ClaimTxHash.String()
+}
+
 // GetClaimProof returns the merkle proof to claim the given deposit.
+// The reads are served by the primary database, so the exit root and the nodes of the proof are consistent.
 func (s *bridgeService) GetClaimProof(depositCnt, networkID uint, dbTx pgx.Tx) (*etherman.GlobalExitRoot, [][bridgectrl.KeyLen]byte, [][bridgectrl.KeyLen]byte, error) {
-	ctx := context.Background()
+	ctx := pgstorage.WithConsistentRead(context.Background())
 
 	if dbTx == nil { // if the call comes from the rest API
 		deposit, err := s.storage.GetDeposit(ctx, depositCnt, networkID, nil)
@@ -173,6 +333,9 @@ func (s *bridgeService) GetClaimProof(depositCnt, networkID uint, dbTx pgx.Tx) (
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	// The global exit root only contains the mainnet exit root and the rollup exit root
+	// that includes the local exit roots of all the rollups.
+	const mainnetExitRootIndex, rollupExitRootIndex = 0, 1
 
 	var (
 		merkleProof       [][bridgectrl.KeyLen]byte
@@ -180,19 +343,19 @@ func (s *bridgeService) GetClaimProof(depositCnt, networkID uint, dbTx pgx.Tx) (
 		rollupLeaf        common.Hash
 	)
 	if networkID == 0 { // Mainnet
-		merkleProof, err = s.getProof(depositCnt, globalExitRoot.ExitRoots[tID], dbTx)
+		merkleProof, err = s.getProof(ctx, depositCnt, globalExitRoot.ExitRoots[mainnetExitRootIndex], dbTx)
 		if err != nil {
 			log.Error("error getting merkleProof. Error: ", err)
 			return nil, nil, nil, fmt.Errorf("getting the proof failed, error: %v, network: %d", err, networkID)
 		}
 		rollupMerkleProof = emptyProof()
 	} else { // Rollup
-		rollupMerkleProof, rollupLeaf, err = s.getRollupExitProof(s.rollupID-1, globalExitRoot.ExitRoots[tID], dbTx)
+		rollupMerkleProof, rollupLeaf, err = s.getRollupExitProof(ctx, getRollupIndex(networkID), globalExitRoot.ExitRoots[rollupExitRootIndex], dbTx)
 		if err != nil {
 			log.Error("error getting rollupProof. Error: ", err)
 			return nil, nil, nil, fmt.Errorf("getting the rollup proof failed, error: %v, network: %d", err, networkID)
 		}
-		merkleProof, err = s.getProof(depositCnt, rollupLeaf, dbTx)
+		merkleProof, err = s.getProof(ctx, depositCnt, rollupLeaf, dbTx)
 		if err != nil {
 			log.Error("error getting merkleProof. Error: ", err)
 			return nil, nil, nil, fmt.Errorf("getting the proof failed, error: %v, network: %d", err, networkID)
@@ -211,12 +374,12 @@ func emptyProof() [][bridgectrl.KeyLen]byte {
 }
 
 // GetDepositStatus returns deposit with ready_for_claim status.
-func (s *bridgeService) GetDepositStatus(ctx context.Context, depositCount uint, destNetworkID uint) (string, error) {
+func (s *bridgeService) GetDepositStatus(ctx context.Context, depositCount uint, origNetworkID uint, destNetworkID uint) (string, error) {
 	var (
 		claimTxHash string
 	)
 	// Get the claim tx hash
-	claim, err := s.storage.GetClaim(ctx, depositCount, destNetworkID, nil)
+	claim, err := s.storage.GetClaim(ctx, depositCount, origNetworkID, destNetworkID, nil)
 	if err != nil {
 		if err != gerror.ErrStorageNotFound {
 			return "", err
@@ -227,6 +390,74 @@ func (s *bridgeService) GetDepositStatus(ctx context.Context, depositCount uint,
 	return claimTxHash, nil
 }
 
+// getEstimatedSecsToClaim estimates the seconds until the deposit is claimable from the time that the latest deposits
+// of the network took to be claimable. It returns 0 if the deposit is ready for claim or there isn't enough data.
+func (s *bridgeService) getEstimatedSecsToClaim(ctx context.Context, deposit *etherman.Deposit) (uint64, error) {
+	if deposit.ReadyForClaim {
+		return 0, nil
+	}
+	s.delaysLock.Lock()
+	defer s.delaysLock.Unlock()
+	delay, ok := s.claimableDelays[deposit.NetworkID]
+	if !ok || time.Since(delay.updatedAt) > claimableDelayTTL {
+		value, err := s.storage.GetClaimableDelay(ctx, deposit.NetworkID, claimableDelaySamples, nil)
+		if err != nil && err != gerror.ErrStorageNotFound {
+			return 0, err
+		}
+		delay = claimableDelay{delay: value, found: err == nil, updatedAt: time.Now()}
+		s.claimableDelays[deposit.NetworkID] = delay
+	}
+	remaining := delay.delay - time.Since(deposit.ReceivedAt)
+	if !delay.found || remaining <= 0 {
+		return 0, nil
+	}
+	return uint64(math.Ceil(remaining.Seconds())), nil
+}
+
+// getCachedResponse reads the cached response of the method to the request into res. It returns the key
+// to cache the response if it's not found, and the time since the response was cached if it's found.
+// The cache errors are logged and the response is read from the storage. The returned context starts a read
+// session, so all the reads of the response are served by the same database.
+func (s *bridgeService) getCachedResponse(ctx context.Context, method string, req, res proto.Message) (context.Context, string, time.Duration, bool) {
+	ctx = pgstorage.WithReadSession(ctx)
+	if s.responses == nil {
+		return ctx, "", 0, false
+	}
+	key, err := s.responses.Key(ctx, method, req)
+	if err != nil {
+		log.Warnf("error getting the cache key of %s. Error: %v", method, err)
+		return ctx, "", 0, false
+	}
+	age, found, err := s.responses.Get(ctx, key, res)
+	if err != nil {
+		log.Warnf("error getting the cached response of %s. Error: %v", method, err)
+		return ctx, key, 0, false
+	}
+	return ctx, key, age, found
+}
+
+// setCachedResponse caches the response of the key returned by getCachedResponse. The responses read from a
+// read replica are not cached, because the replica may lag behind the commits that invalidated the cache.
+func (s *bridgeService) setCachedResponse(ctx context.Context, key string, res proto.Message) {
+	if s.responses == nil || key == "" || pgstorage.IsReplicaRead(ctx) {
+		return
+	}
+	err := s.responses.Set(ctx, key, res)
+	if err != nil {
+		log.Warnf("error caching the response. Error: %v", err)
+	}
+}
+
+// elapseEstimatedSecsToClaim updates the estimation of a cached deposit with the time since it was cached
+func elapseEstimatedSecsToClaim(deposit *pb.Deposit, age time.Duration) {
+	elapsed := uint64(age.Seconds())
+	if deposit.EstimatedSecsToClaim <= elapsed {
+		deposit.EstimatedSecsToClaim = 0
+	} else {
+		deposit.EstimatedSecsToClaim -= elapsed
+	}
+}
+
 // CheckAPI returns api version.
 // Bridge rest API endpoint
 func (s *bridgeService) CheckAPI(ctx context.Context, req *pb.CheckAPIRequest) (*pb.CheckAPIResponse, error) {
@@ -238,6 +469,14 @@ func (s *bridgeService) CheckAPI(ctx context.Context, req *pb.CheckAPIRequest) (
 // GetBridges returns bridges for the destination address both in L1 and L2.
 // Bridge rest API endpoint
 func (s *bridgeService) GetBridges(ctx context.Context, req *pb.GetBridgesRequest) (*pb.GetBridgesResponse, error) {
+	var cached pb.GetBridgesResponse
+	ctx, key, age, found := s.getCachedResponse(ctx, "GetBridges", req, &cached)
+	if found {
+		for _, deposit := range cached.Deposits {
+			elapseEstimatedSecsToClaim(deposit, age)
+		}
+		return &cached, nil
+	}
 	limit := req.Limit
 	if limit == 0 {
 		limit = s.defaultPageLimit
@@ -256,42 +495,47 @@ func (s *bridgeService) GetBridges(ctx context.Context, req *pb.GetBridgesReques
 
 	var pbDeposits []*pb.Deposit
 	for _, deposit := range deposits {
-		claimTxHash, err := s.GetDepositStatus(ctx, deposit.DepositCount, deposit.DestinationNetwork)
+		estimatedSecsToClaim, err := s.getEstimatedSecsToClaim(ctx, deposit)
 		if err != nil {
 			return nil, err
 		}
-		mainnetFlag := deposit.NetworkID == 0
-		rollupIndex := s.rollupID - 1
-		localExitRootIndex := deposit.DepositCount
 		pbDeposits = append(
 			pbDeposits, &pb.Deposit{
-				LeafType:      uint32(deposit.LeafType),
-				OrigNet:       uint32(deposit.OriginalNetwork),
-				OrigAddr:      deposit.OriginalAddress.Hex(),
-				Amount:        deposit.Amount.String(),
-				DestNet:       uint32(deposit.DestinationNetwork),
-				DestAddr:      deposit.DestinationAddress.Hex(),
-				BlockNum:      deposit.BlockNumber,
-				DepositCnt:    uint64(deposit.DepositCount),
-				NetworkId:     uint32(deposit.NetworkID),
-				TxHash:        deposit.TxHash.String(),
-				ClaimTxHash:   claimTxHash,
-				Metadata:      "0x" + hex.EncodeToString(deposit.Metadata),
-				ReadyForClaim: deposit.ReadyForClaim,
-				GlobalIndex:   etherman.GenerateGlobalIndex(mainnetFlag, rollupIndex, localExitRootIndex).String(),
+				LeafType:             uint32(deposit.LeafType),
+				OrigNet:              uint32(deposit.OriginalNetwork),
+				OrigAddr:             deposit.OriginalAddress.Hex(),
+				Amount:               deposit.Amount.String(),
+				DestNet:              uint32(deposit.DestinationNetwork),
+				DestAddr:             deposit.DestinationAddress.Hex(),
+				BlockNum:             deposit.BlockNumber,
+				DepositCnt:           uint64(deposit.DepositCount),
+				NetworkId:            uint32(deposit.NetworkID),
+				TxHash:               deposit.TxHash.String(),
+				ClaimTxHash:          getClaimTxHash(deposit),
+				Metadata:             "0x" + hex.EncodeToString(deposit.Metadata),
+				ReadyForClaim:        deposit.ReadyForClaim,
+				GlobalIndex:          getGlobalIndex(deposit).String(),
+				EstimatedSecsToClaim: estimatedSecsToClaim,
 			},
 		)
 	}
 
-	return &pb.GetBridgesResponse{
+	res := &pb.GetBridgesResponse{
 		Deposits: pbDeposits,
 		TotalCnt: totalCount,
-	}, nil
+	}
+	s.setCachedResponse(ctx, key, res)
+	return res, nil
 }
 
 // GetClaims returns claims for the specific smart contract address both in L1 and L2.
 // Bridge rest API endpoint
 func (s *bridgeService) GetClaims(ctx context.Context, req *pb.GetClaimsRequest) (*pb.GetClaimsResponse, error) {
+	var cached pb.GetClaimsResponse
+	ctx, key, _, found := s.getCachedResponse(ctx, "GetClaims", req, &cached)
+	if found {
+		return &cached, nil
+	}
 	limit := req.Limit
 	if limit == 0 {
 		limit = s.defaultPageLimit
@@ -324,15 +568,22 @@ func (s *bridgeService) GetClaims(ctx context.Context, req *pb.GetClaimsRequest)
 		})
 	}
 
-	return &pb.GetClaimsResponse{
+	res := &pb.GetClaimsResponse{
 		Claims:   pbClaims,
 		TotalCnt: totalCount,
-	}, nil
+	}
+	s.setCachedResponse(ctx, key, res)
+	return res, nil
 }
 
 // GetProof returns the merkle proof for the given deposit.
 // Bridge rest API endpoint
 func (s *bridgeService) GetProof(ctx context.Context, req *pb.GetProofRequest) (*pb.GetProofResponse, error) {
+	var cached pb.GetProofResponse
+	ctx, key, _, found := s.getCachedResponse(ctx, "GetProof", req, &cached)
+	if found {
+		return &cached, nil
+	}
 	globalExitRoot, merkleProof, rollupMerkleProof, err := s.GetClaimProof(uint(req.DepositCnt), uint(req.NetId), nil)
 	if err != nil {
 		return nil, err
@@ -349,64 +600,631 @@ func (s *bridgeService) GetProof(ctx context.Context, req *pb.GetProofRequest) (
 		rollupProof = append(rollupProof, "0x"+hex.EncodeToString(rollupMerkleProof[i][:]))
 	}
 
-	return &pb.GetProofResponse{
+	res := &pb.GetProofResponse{
 		Proof: &pb.Proof{
 			RollupMerkleProof: rollupProof,
 			MerkleProof:       proof,
 			MainExitRoot:      globalExitRoot.ExitRoots[0].Hex(),
 			RollupExitRoot:    globalExitRoot.ExitRoots[1].Hex(),
 		},
-	}, nil
+	}
+	s.setCachedResponse(ctx, key, res)
+	return res, nil
 }
 
 // GetBridge returns the bridge  with status whether it is able to send a claim transaction or not.
 // Bridge rest API endpoint
 func (s *bridgeService) GetBridge(ctx context.Context, req *pb.GetBridgeRequest) (*pb.GetBridgeResponse, error) {
+	var cached pb.GetBridgeResponse
+	ctx, key, age, found := s.getCachedResponse(ctx, "GetBridge", req, &cached)
+	if found {
+		elapseEstimatedSecsToClaim(cached.Deposit, age)
+		return &cached, nil
+	}
 	deposit, err := s.storage.GetDeposit(ctx, uint(req.DepositCnt), uint(req.NetId), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	claimTxHash, err := s.GetDepositStatus(ctx, uint(req.DepositCnt), deposit.DestinationNetwork)
+	estimatedSecsToClaim, err := s.getEstimatedSecsToClaim(ctx, deposit)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.GetBridgeResponse{
+	res := &pb.GetBridgeResponse{
 		Deposit: &pb.Deposit{
-			LeafType:      uint32(deposit.LeafType),
-			OrigNet:       uint32(deposit.OriginalNetwork),
-			OrigAddr:      deposit.OriginalAddress.Hex(),
-			Amount:        deposit.Amount.String(),
-			DestNet:       uint32(deposit.DestinationNetwork),
-			DestAddr:      deposit.DestinationAddress.Hex(),
-			BlockNum:      deposit.BlockNumber,
-			DepositCnt:    uint64(deposit.DepositCount),
-			NetworkId:     uint32(deposit.NetworkID),
-			TxHash:        deposit.TxHash.String(),
-			ClaimTxHash:   claimTxHash,
-			Metadata:      "0x" + hex.EncodeToString(deposit.Metadata),
-			ReadyForClaim: deposit.ReadyForClaim,
+			LeafType:             uint32(deposit.LeafType),
+			OrigNet:              uint32(deposit.OriginalNetwork),
+			OrigAddr:             deposit.OriginalAddress.Hex(),
+			Amount:               deposit.Amount.String(),
+			DestNet:              uint32(deposit.DestinationNetwork),
+			DestAddr:             deposit.DestinationAddress.Hex(),
+			BlockNum:             deposit.BlockNumber,
+			DepositCnt:           uint64(deposit.DepositCount),
+			NetworkId:            uint32(deposit.NetworkID),
+			TxHash:               deposit.TxHash.String(),
+			ClaimTxHash:          getClaimTxHash(deposit),
+			Metadata:             "0x" + hex.EncodeToString(deposit.Metadata),
+			ReadyForClaim:        deposit.ReadyForClaim,
+			GlobalIndex:          getGlobalIndex(deposit).String(),
+			EstimatedSecsToClaim: estimatedSecsToClaim,
 		},
-	}, nil
+	}
+	s.setCachedResponse(ctx, key, res)
+	return res, nil
 }
 
 // GetTokenWrapped returns the token wrapped created for a specific network
 // Bridge rest API endpoint
 func (s *bridgeService) GetTokenWrapped(ctx context.Context, req *pb.GetTokenWrappedRequest) (*pb.GetTokenWrappedResponse, error) {
+	var cached pb.GetTokenWrappedResponse
+	ctx, key, _, found := s.getCachedResponse(ctx, "GetTokenWrapped", req, &cached)
+	if found {
+		return &cached, nil
+	}
 	tokenWrapped, err := s.storage.GetTokenWrapped(ctx, uint(req.OrigNet), common.HexToAddress(req.OrigTokenAddr), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.GetTokenWrappedResponse{
-		Tokenwrapped: &pb.TokenWrapped{
-			OrigNet:           uint32(tokenWrapped.OriginalNetwork),
-			OriginalTokenAddr: tokenWrapped.OriginalTokenAddress.Hex(),
-			WrappedTokenAddr:  tokenWrapped.WrappedTokenAddress.Hex(),
-			NetworkId:         uint32(tokenWrapped.NetworkID),
-			Name:              tokenWrapped.Name,
-			Symbol:            tokenWrapped.Symbol,
-			Decimals:          uint32(tokenWrapped.Decimals),
-		},
+	res := &pb.GetTokenWrappedResponse{
+		Tokenwrapped: toPbTokenWrapped(tokenWrapped),
+	}
+	s.setCachedResponse(ctx, key, res)
+	return res, nil
+}
+
+// ListTokenWrapped returns the tokens wrapped in all the networks or in the specific network.
+// Bridge rest API endpoint
+func (s *bridgeService) ListTokenWrapped(ctx context.Context, req *pb.ListTokenWrappedRequest) (*pb.ListTokenWrappedResponse, error) {
+	var cached pb.ListTokenWrappedResponse
+	ctx, key, _, found := s.getCachedResponse(ctx, "ListTokenWrapped", req, &cached)
+	if found {
+		return &cached, nil
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = s.defaultPageLimit
+	}
+	if limit > s.maxPageLimit {
+		limit = s.maxPageLimit
+	}
+	var networkID *uint
+	if req.NetId != nil {
+		id := uint(*req.NetId)
+		networkID = &id
+	}
+	totalCount, err := s.storage.GetTokenWrappedCount(ctx, networkID, nil)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := s.storage.GetTokensWrapped(ctx, networkID, uint(limit), uint(req.Offset), nil)
+	if err != nil {
+		return nil, err
+	}
+	var pbTokens []*pb.TokenWrapped
+	for _, token := range tokens {
+		pbTokens = append(pbTokens, toPbTokenWrapped(token))
+	}
+	res := &pb.ListTokenWrappedResponse{
+		Tokenwrapped: pbTokens,
+		TotalCnt:     totalCount,
+	}
+	s.setCachedResponse(ctx, key, res)
+	return res, nil
+}
+
+// GetTokenOrigin returns the original token of a token wrapped in the specific network. If the token is native
+// to the network, its metadata is decoded from the metadata of its deposits.
+// Bridge rest API endpoint
+func (s *bridgeService) GetTokenOrigin(ctx context.Context, req *pb.GetTokenOriginRequest) (*pb.GetTokenOriginResponse, error) {
+	tokenAddress := common.HexToAddress(req.TokenAddr)
+	tokenWrapped, err := s.storage.GetTokenWrappedByAddress(ctx, tokenAddress, uint(req.NetId), nil)
+	if err == nil {
+		return &pb.GetTokenOriginResponse{
+			Tokenwrapped: toPbTokenWrapped(tokenWrapped),
+		}, nil
+	} else if err != gerror.ErrStorageNotFound {
+		return nil, err
+	}
+	// The token was never wrapped, so it's native to the network
+	metadata, err := s.storage.GetNativeTokenMetadata(ctx, tokenAddress, uint(req.NetId), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTokenOriginResponse{
+		Tokenwrapped: toPbTokenWrapped(&etherman.TokenWrapped{
+			TokenMetadata:        *metadata,
+			OriginalNetwork:      uint(req.NetId),
+			OriginalTokenAddress: tokenAddress,
+			NetworkID:            uint(req.NetId),
+		}),
+		Native: true,
+	}, nil
+}
+
+func toPbTokenWrapped(tokenWrapped *etherman.TokenWrapped) *pb.TokenWrapped {
+	return &pb.TokenWrapped{
+		OrigNet:           uint32(tokenWrapped.OriginalNetwork),
+		OriginalTokenAddr: tokenWrapped.OriginalTokenAddress.Hex(),
+		WrappedTokenAddr:  tokenWrapped.WrappedTokenAddress.Hex(),
+		NetworkId:         uint32(tokenWrapped.NetworkID),
+		Name:              tokenWrapped.Name,
+		Symbol:            tokenWrapped.Symbol,
+		Decimals:          uint32(tokenWrapped.Decimals),
+	}
+}
+
+// GetReorgs returns the reorgs detected in the specific network, including the removed deposits, claims and global exit roots.
+// Bridge rest API endpoint
+func (s *bridgeService) GetReorgs(ctx context.Context, req *pb.GetReorgsRequest) (*pb.GetReorgsResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = s.defaultPageLimit
+	}
+	if limit > s.maxPageLimit {
+		limit = s.maxPageLimit
+	}
+	// The count and the page are read from the same database, so they are consistent
+	ctx = pgstorage.WithReadSession(ctx)
+	totalCount, err := s.storage.GetReorgCount(ctx, uint(req.NetId), nil)
+	if err != nil {
+		return nil, err
+	}
+	reorgs, err := s.storage.GetReorgs(ctx, uint(req.NetId), uint(limit), uint(req.Offset), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbReorgs []*pb.Reorg
+	for _, reorg := range reorgs {
+		pbReorg := &pb.Reorg{
+			Id:           reorg.ID,
+			NetworkId:    uint32(reorg.NetworkID),
+			ForkBlockNum: reorg.ForkBlockNumber,
+			Depth:        reorg.Depth,
+			OldBlockHash: reorg.OldBlockHash.String(),
+			NewBlockHash: reorg.NewBlockHash.String(),
+			DetectedAt:   reorg.DetectedAt.UTC().Format(time.RFC3339),
+		}
+		for _, deposit := range reorg.Deposits {
+			pbReorg.Deposits = append(pbReorg.Deposits, &pb.Deposit{
+				LeafType:   uint32(deposit.LeafType),
+				OrigNet:    uint32(deposit.OriginalNetwork),
+				OrigAddr:   deposit.OriginalAddress.Hex(),
+				Amount:     deposit.Amount.String(),
+				DestNet:    uint32(deposit.DestinationNetwork),
+				DestAddr:   deposit.DestinationAddress.Hex(),
+				BlockNum:   deposit.BlockNumber,
+				DepositCnt: uint64(deposit.DepositCount),
+				NetworkId:  uint32(deposit.NetworkID),
+				TxHash:     deposit.TxHash.String(),
+				Metadata:   "0x" + hex.EncodeToString(deposit.Metadata),
+			})
+		}
+		for _, claim := range reorg.Claims {
+			pbReorg.Claims = append(pbReorg.Claims, &pb.Claim{
+				Index:       uint64(claim.Index),
+				OrigNet:     uint32(claim.OriginalNetwork),
+				OrigAddr:    claim.OriginalAddress.Hex(),
+				Amount:      claim.Amount.String(),
+				NetworkId:   uint32(claim.NetworkID),
+				DestAddr:    claim.DestinationAddress.Hex(),
+				BlockNum:    claim.BlockNumber,
+				TxHash:      claim.TxHash.String(),
+				RollupIndex: claim.RollupIndex,
+				MainnetFlag: claim.MainnetFlag,
+			})
+		}
+		for _, ger := range reorg.GlobalExitRoots {
+			pbReorg.GlobalExitRoots = append(pbReorg.GlobalExitRoots, toPbGlobalExitRoot(ger))
+		}
+		pbReorgs = append(pbReorgs, pbReorg)
+	}
+
+	return &pb.GetReorgsResponse{
+		Reorgs:   pbReorgs,
+		TotalCnt: totalCount,
 	}, nil
 }
+
+func toPbGlobalExitRoot(ger etherman.GlobalExitRoot) *pb.GlobalExitRoot {
+	var exitRoots []string
+	for _, exitRoot := range ger.ExitRoots {
+		exitRoots = append(exitRoots, exitRoot.String())
+	}
+	return &pb.GlobalExitRoot{
+		BlockNum:       ger.BlockNumber,
+		GlobalExitRoot: ger.GlobalExitRoot.String(),
+		ExitRoots:      exitRoots,
+	}
+}
+
+// GetEmergencyState returns whether the bridge is paused in the specific network because the emergency state is active.
+// Bridge rest API endpoint
+func (s *bridgeService) GetEmergencyState(ctx context.Context, req *pb.GetEmergencyStateRequest) (*pb.GetEmergencyStateResponse, error) {
+	emergencyState, err := s.storage.GetEmergencyState(ctx, uint(req.NetId), nil)
+	if err != nil {
+		if err != gerror.ErrStorageNotFound {
+			return nil, err
+		}
+		// The emergency state was never activated
+		return &pb.GetEmergencyStateResponse{}, nil
+	}
+	return &pb.GetEmergencyStateResponse{
+		Paused:   emergencyState.Activated,
+		BlockNum: emergencyState.BlockNumber,
+		TxHash:   emergencyState.TxHash.String(),
+	}, nil
+}
+
+// GetNetworks returns the networks synced by the service and the rollups registered in the rollupManager.
+// Bridge rest API endpoint
+func (s *bridgeService) GetNetworks(ctx context.Context, req *pb.GetNetworksRequest) (*pb.GetNetworksResponse, error) {
+	rollups, err := s.storage.GetRollups(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := s.storage.GetLastBlocks(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	lastBlocks := make(map[uint]*etherman.Block)
+	for _, block := range blocks {
+		lastBlocks[block.NetworkID] = block
+	}
+	s.networksLock.RLock()
+	networks := make([]Network, len(s.networks))
+	copy(networks, s.networks)
+	s.networksLock.RUnlock()
+
+	var pbNetworks []*pb.Network
+	for _, network := range networks {
+		pbNetwork := &pb.Network{
+			NetworkId:  uint32(network.NetworkID),
+			RollupId:   uint32(network.RollupID),
+			ChainId:    network.ChainID,
+			BridgeAddr: network.BridgeAddress.String(),
+		}
+		if block, found := lastBlocks[network.NetworkID]; found {
+			pbNetwork.SyncedBlockNum = block.BlockNumber
+		}
+		// The network is synced while the latest block synced reaches the chain head of the latest synchronization iteration
+		if status, ok := s.statusEvents.Latest(network.NetworkID); ok {
+			pbNetwork.Synced = status.ChainHead > 0 && pbNetwork.SyncedBlockNum >= status.ChainHead
+		}
+		pbNetworks = append(pbNetworks, pbNetwork)
+	}
+	for _, rollup := range rollups {
+		var pbNetwork *pb.Network
+		for _, n := range pbNetworks {
+			if n.RollupId == uint32(rollup.RollupID) {
+				pbNetwork = n
+				break
+			}
+		}
+		if pbNetwork == nil {
+			// The rollup is registered but it isn't synced by the service. The rollupManager
+			// assigns to each rollup the network id equal to its rollup id
+			pbNetwork = &pb.Network{
+				NetworkId: uint32(rollup.RollupID),
+				RollupId:  uint32(rollup.RollupID),
+				ChainId:   rollup.ChainID,
+			}
+			pbNetworks = append(pbNetworks, pbNetwork)
+		}
+		pbNetwork.RollupAddr = rollup.RollupAddress.String()
+		pbNetwork.GasTokenAddr = rollup.GasTokenAddress.String()
+		pbNetwork.RollupTypeId = uint32(rollup.RollupTypeID)
+		pbNetwork.ForkId = rollup.ForkID
+		pbNetwork.RollupTypeObsolete = rollup.RollupTypeObsolete
+		pbNetwork.RegisteredBlockNum = rollup.BlockNumber
+		pbNetwork.RegisteredTxHash = rollup.TxHash.String()
+		// The deprecated fields are kept for the clients of the previous versions
+		pbNetwork.BlockNum = pbNetwork.RegisteredBlockNum //nolint:staticcheck
+		pbNetwork.TxHash = pbNetwork.RegisteredTxHash     //nolint:staticcheck
+	}
+	return &pb.GetNetworksResponse{Networks: pbNetworks}, nil
+}
+
+// GetSyncStatus returns the synchronization progress of the specific network.
+// Bridge rest API endpoint
+func (s *bridgeService) GetSyncStatus(ctx context.Context, req *pb.GetSyncStatusRequest) (*pb.GetSyncStatusResponse, error) {
+	networkID := uint(req.NetId)
+	_, err := s.getNetworkID(networkID)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.GetSyncStatusResponse{NetworkId: req.NetId}
+	block, err := s.storage.GetLastBlock(ctx, networkID, nil)
+	if err != nil && err != gerror.ErrStorageNotFound {
+		return nil, err
+	} else if err == nil {
+		res.LastBlockNum = block.BlockNumber
+		res.LastBlockHash = block.BlockHash.String()
+	}
+	// The chain head is only known once the synchronizer has finished an iteration
+	if status, ok := s.statusEvents.Latest(networkID); ok {
+		res.ChainHead = status.ChainHead
+		res.LastSyncedAt = status.SyncedAt.UTC().Format(time.RFC3339)
+		if status.ChainHead > res.LastBlockNum {
+			res.Lag = status.ChainHead - res.LastBlockNum
+		}
+	}
+	l1Ger, err := s.storage.GetLatestL1SyncedExitRoot(ctx, nil)
+	if err != nil && err != gerror.ErrStorageNotFound {
+		return nil, err
+	} else if err == nil {
+		res.L1SyncedGlobalExitRoot = toPbGlobalExitRoot(*l1Ger)
+	}
+	trustedGer, err := s.storage.GetLatestTrustedExitRoot(ctx, nil)
+	if err != nil && err != gerror.ErrStorageNotFound {
+		return nil, err
+	} else if err == nil {
+		res.TrustedGlobalExitRoot = toPbGlobalExitRoot(*trustedGer)
+	}
+	rollupExitRoot, err := s.storage.GetLatestRollupExitRoot(ctx, nil)
+	if err != nil && err != gerror.ErrStorageNotFound {
+		return nil, err
+	} else if err == nil {
+		res.RollupExitRoot = rollupExitRoot.String()
+	}
+	return res, nil
+}
+
+// GetBridgeStats returns the bridge volume of each origin token and direction grouped by hour or day,
+// and the value of each origin token and direction that is deposited but not claimed yet.
+// Bridge rest API endpoint
+func (s *bridgeService) GetBridgeStats(ctx context.Context, req *pb.GetBridgeStatsRequest) (*pb.GetBridgeStatsResponse, error) {
+	interval := req.Interval
+	if interval == "" {
+		interval = "hour"
+	}
+	bucketSize, ok := statsIntervals[interval]
+	if !ok {
+		return nil, fmt.Errorf("invalid interval %s, it must be hour or day", interval)
+	}
+	to := time.Now().UTC()
+	if req.To != "" {
+		t, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, fmt.Errorf("invalid to time %s, error: %v", req.To, err)
+		}
+		to = t
+	}
+	from := to.Add(-defaultStatsBuckets * bucketSize)
+	if req.From != "" {
+		t, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, fmt.Errorf("invalid from time %s, error: %v", req.From, err)
+		}
+		from = t
+	}
+	if !from.Before(to) || to.Sub(from) > maxStatsBuckets*bucketSize {
+		return nil, fmt.Errorf("invalid time range from %s to %s, at most %d buckets are returned", from.Format(time.RFC3339), to.Format(time.RFC3339), maxStatsBuckets)
+	}
+	var (
+		origNet  *uint
+		origAddr *common.Address
+	)
+	if req.OrigNet != nil {
+		id := uint(*req.OrigNet)
+		origNet = &id
+	}
+	if req.OrigTokenAddr != "" {
+		addr := common.HexToAddress(req.OrigTokenAddr)
+		origAddr = &addr
+	}
+
+	stats, err := s.storage.GetBridgeStats(ctx, interval, from, to, origNet, origAddr, nil)
+	if err != nil {
+		return nil, err
+	}
+	unclaimed, err := s.storage.GetUnclaimedStats(ctx, origNet, origAddr, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.GetBridgeStatsResponse{}
+	for _, stat := range stats {
+		res.Stats = append(res.Stats, &pb.BridgeStats{
+			Bucket:        stat.Bucket.UTC().Format(time.RFC3339),
+			OrigNet:       uint32(stat.OriginalNetwork),
+			OrigAddr:      stat.OriginalAddress.Hex(),
+			FromNet:       uint32(stat.FromNetwork),
+			ToNet:         uint32(stat.ToNetwork),
+			DepositCnt:    stat.DepositCount,
+			DepositAmount: stat.DepositAmount.String(),
+			ClaimCnt:      stat.ClaimCount,
+			ClaimAmount:   stat.ClaimAmount.String(),
+		})
+	}
+	for _, stat := range unclaimed {
+		res.Unclaimed = append(res.Unclaimed, &pb.UnclaimedStats{
+			OrigNet:  uint32(stat.OriginalNetwork),
+			OrigAddr: stat.OriginalAddress.Hex(),
+			FromNet:  uint32(stat.FromNetwork),
+			ToNet:    uint32(stat.ToNetwork),
+			Cnt:      stat.Count,
+			Amount:   stat.Amount.String(),
+		})
+	}
+	return res, nil
+}
+
+// GetSolvency returns the latest solvency check of each network. The checks compare the amount of each
+// origin token locked in or minted by the bridge according to the synced deposits and claims with the one on chain.
+// Bridge rest API endpoint
+func (s *bridgeService) GetSolvency(ctx context.Context, req *pb.GetSolvencyRequest) (*pb.GetSolvencyResponse, error) {
+	s.networksLock.RLock()
+	networks := append([]Network(nil), s.networks...)
+	s.networksLock.RUnlock()
+	res := &pb.GetSolvencyResponse{}
+	for _, network := range networks {
+		report, ok := s.solvencyReports.Latest(network.NetworkID)
+		if !ok {
+			continue
+		}
+		pbReport := &pb.SolvencyReport{
+			NetworkId: uint32(report.NetworkID),
+			BlockNum:  report.BlockNumber,
+			CheckedAt: report.CheckedAt.UTC().Format(time.RFC3339),
+		}
+		for _, token := range report.Tokens {
+			if req.OnlyDiscrepancies && !token.Discrepancy() {
+				continue
+			}
+			pbReport.Tokens = append(pbReport.Tokens, &pb.TokenSolvency{
+				OrigNet:     uint32(token.OriginalNetwork),
+				OrigAddr:    token.OriginalAddress.Hex(),
+				TokenAddr:   token.TokenAddress.Hex(),
+				Native:      token.Native,
+				Expected:    token.Expected.String(),
+				Actual:      token.Actual.String(),
+				Discrepancy: token.Discrepancy(),
+			})
+		}
+		for _, token := range report.CrossNetwork {
+			if req.OnlyDiscrepancies && !token.Undercollateralized() {
+				continue
+			}
+			pbToken := &pb.CrossNetworkSolvency{
+				OrigAddr:            token.OriginalAddress.Hex(),
+				Locked:              token.Locked.String(),
+				WrappedSupply:       token.WrappedSupply.String(),
+				Undercollateralized: token.Undercollateralized(),
+			}
+			for _, networkID := range token.WrappedNetworks {
+				pbToken.WrappedNetIds = append(pbToken.WrappedNetIds, uint32(networkID))
+			}
+			pbReport.CrossNetwork = append(pbReport.CrossNetwork, pbToken)
+		}
+		res.Reports = append(res.Reports, pbReport)
+	}
+	return res, nil
+}
+
+// GetClaimFindings returns the findings of the latest claim check: the claims of unknown deposits, the claims
+// whose fields differ from the deposit ones and the duplicated claims.
+// Bridge rest API endpoint
+func (s *bridgeService) GetClaimFindings(ctx context.Context, req *pb.GetClaimFindingsRequest) (*pb.GetClaimFindingsResponse, error) {
+	res := &pb.GetClaimFindingsResponse{}
+	report, ok := s.claimReports.Latest(monitor.ClaimFindingsTopic)
+	if !ok {
+		return res, nil
+	}
+	res.CheckedAt = report.CheckedAt.UTC().Format(time.RFC3339)
+	for _, finding := range report.Findings {
+		if req.NetId != nil && finding.Claim.NetworkID != uint(*req.NetId) {
+			continue
+		}
+		pbFinding := &pb.ClaimFinding{
+			Kind: string(finding.Kind),
+			Claim: &pb.Claim{
+				Index:       uint64(finding.Claim.Index),
+				OrigNet:     uint32(finding.Claim.OriginalNetwork),
+				OrigAddr:    finding.Claim.OriginalAddress.Hex(),
+				Amount:      finding.Claim.Amount.String(),
+				NetworkId:   uint32(finding.Claim.NetworkID),
+				DestAddr:    finding.Claim.DestinationAddress.Hex(),
+				BlockNum:    finding.Claim.BlockNumber,
+				TxHash:      finding.Claim.TxHash.String(),
+				RollupIndex: finding.Claim.RollupIndex,
+				MainnetFlag: finding.Claim.MainnetFlag,
+			},
+			ClaimCnt:         finding.ClaimCount,
+			MismatchedFields: finding.MismatchedFields,
+		}
+		if deposit := finding.Deposit; deposit != nil {
+			pbFinding.Deposit = &pb.Deposit{
+				LeafType:    uint32(deposit.LeafType),
+				OrigNet:     uint32(deposit.OriginalNetwork),
+				OrigAddr:    deposit.OriginalAddress.Hex(),
+				Amount:      deposit.Amount.String(),
+				DestNet:     uint32(deposit.DestinationNetwork),
+				DestAddr:    deposit.DestinationAddress.Hex(),
+				BlockNum:    deposit.BlockNumber,
+				DepositCnt:  uint64(deposit.DepositCount),
+				NetworkId:   uint32(deposit.NetworkID),
+				TxHash:      deposit.TxHash.String(),
+				Metadata:    "0x" + hex.EncodeToString(deposit.Metadata),
+				GlobalIndex: getGlobalIndex(deposit).String(),
+			}
+		}
+		res.Findings = append(res.Findings, pbFinding)
+	}
+	return res, nil
+}
+
+// GetPendingClaims returns the deposits to the destination address that are ready for claim but not claimed yet,
+// with the pending value of each origin token. Each deposit reports if the proof to claim it can be built and
+// if a ClaimTxManager claims it.
+// Bridge rest API endpoint
+func (s *bridgeService) GetPendingClaims(ctx context.Context, req *pb.GetPendingClaimsRequest) (*pb.GetPendingClaimsResponse, error) {
+	var cached pb.GetPendingClaimsResponse
+	ctx, key, _, found := s.getCachedResponse(ctx, "GetPendingClaims", req, &cached)
+	if found {
+		return &cached, nil
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = s.defaultPageLimit
+	}
+	if limit > s.maxPageLimit {
+		limit = s.maxPageLimit
+	}
+	totals, err := s.storage.GetPendingClaimTotals(ctx, req.DestAddr, nil)
+	if err != nil {
+		return nil, err
+	}
+	deposits, err := s.storage.GetPendingClaims(ctx, req.DestAddr, uint(limit), uint(req.Offset), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetPendingClaimsResponse{}
+	for _, total := range totals {
+		res.Totals = append(res.Totals, &pb.PendingClaimTotal{
+			OrigNet:  uint32(total.OriginalNetwork),
+			OrigAddr: total.OriginalAddress.Hex(),
+			Cnt:      total.Count,
+			Amount:   total.Amount.String(),
+		})
+		res.TotalCnt += total.Count
+	}
+	// The proof of a deposit is available if the latest exit root of its network includes it
+	claimableDepositCounts := make(map[uint]*uint)
+	for _, deposit := range deposits {
+		claimable, found := claimableDepositCounts[deposit.NetworkID]
+		if !found {
+			depositCount, ok, err := s.getClaimableDepositCount(ctx, deposit.NetworkID)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				claimable = &depositCount
+			}
+			claimableDepositCounts[deposit.NetworkID] = claimable
+		}
+		proofAvailable := claimable != nil && deposit.DepositCount <= *claimable
+		res.Claims = append(res.Claims, &pb.PendingClaim{
+			Deposit: &pb.Deposit{
+				LeafType:      uint32(deposit.LeafType),
+				OrigNet:       uint32(deposit.OriginalNetwork),
+				OrigAddr:      deposit.OriginalAddress.Hex(),
+				Amount:        deposit.Amount.String(),
+				DestNet:       uint32(deposit.DestinationNetwork),
+				DestAddr:      deposit.DestinationAddress.Hex(),
+				BlockNum:      deposit.BlockNumber,
+				DepositCnt:    uint64(deposit.DepositCount),
+				NetworkId:     uint32(deposit.NetworkID),
+				TxHash:        deposit.TxHash.String(),
+				Metadata:      "0x" + hex.EncodeToString(deposit.Metadata),
+				ReadyForClaim: deposit.ReadyForClaim,
+				GlobalIndex:   getGlobalIndex(deposit).String(),
+			},
+			ProofAvailable: proofAvailable,
+			AutoClaim:      s.isAutoClaimed(deposit),
+		})
+	}
+	s.setCachedResponse(ctx, key, res)
+	return res, nil
+}
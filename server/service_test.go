@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/memstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/monitor"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/synchronizer"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestService(networks []Network) (*bridgeService, *memstorage.MemoryStorage, *eventbus.Bus[*synchronizer.Status]) {
+	store := memstorage.NewMemoryStorage()
+	statusEvents := eventbus.New[*synchronizer.Status](1)
+	cfg := Config{CacheSize: 100, DefaultPageLimit: 25, MaxPageLimit: 100}                                                                                                          //nolint:gomnd
+	return NewBridgeService(cfg, 32, networks, store, statusEvents, eventbus.New[*monitor.SolvencyReport](1), eventbus.New[*monitor.ClaimCheckReport](1), nil), store, statusEvents //nolint:gomnd
+}
+
+func addBlock(t *testing.T, store *memstorage.MemoryStorage, networkID uint, blockNumber uint64) uint64 {
+	blockID, err := store.AddBlock(context.Background(), &etherman.Block{
+		BlockNumber: blockNumber,
+		BlockHash:   common.BigToHash(big.NewInt(int64(networkID*1000) + int64(blockNumber))),
+		NetworkID:   networkID,
+	}, nil)
+	require.NoError(t, err)
+	return blockID
+}
+
+func TestGetNetworks(t *testing.T) {
+	ctx := context.Background()
+	bridgeAddr := common.HexToAddress("0x10")
+	s, store, statusEvents := newTestService([]Network{
+		{NetworkID: 0, ChainID: 1, BridgeAddress: bridgeAddr},
+		{NetworkID: 1, ChainID: 1101, BridgeAddress: bridgeAddr, RollupID: 1},
+	})
+	addBlock(t, store, 0, 4)
+	blockID := addBlock(t, store, 0, 5)
+	addBlock(t, store, 1, 7)
+	rollupTx := common.HexToHash("0x5C7831")
+	require.NoError(t, store.AddRollup(ctx, &etherman.Rollup{BlockID: blockID, RollupID: 1, ChainID: 1101, RollupTypeID: 1, TxHash: rollupTx}, nil))
+	require.NoError(t, store.AddRollup(ctx, &etherman.Rollup{BlockID: blockID, RollupID: 2, ChainID: 1102, RollupTypeID: 1, TxHash: rollupTx}, nil))
+
+	statusEvents.Publish(0, &synchronizer.Status{NetworkID: 0, LastBlockNumber: 5, ChainHead: 5})
+	statusEvents.Publish(1, &synchronizer.Status{NetworkID: 1, LastBlockNumber: 7, ChainHead: 10})
+	res, err := s.GetNetworks(ctx, &pb.GetNetworksRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Networks, 3)
+
+	l1 := res.Networks[0]
+	require.Equal(t, uint64(5), l1.SyncedBlockNum)
+	require.True(t, l1.Synced)
+	require.Equal(t, bridgeAddr.String(), l1.BridgeAddr)
+
+	rollup := res.Networks[1]
+	require.Equal(t, uint64(7), rollup.SyncedBlockNum)
+	require.False(t, rollup.Synced)
+	require.Equal(t, uint64(5), rollup.RegisteredBlockNum)
+	require.Equal(t, rollupTx.String(), rollup.RegisteredTxHash)
+	// The deprecated fields keep their values
+	require.Equal(t, rollup.RegisteredBlockNum, rollup.BlockNum) //nolint:staticcheck
+	require.Equal(t, rollup.RegisteredTxHash, rollup.TxHash)     //nolint:staticcheck
+
+	// The rollup registered but not synced by the service
+	notSynced := res.Networks[2]
+	require.Equal(t, uint32(2), notSynced.NetworkId)
+	require.Equal(t, uint64(1102), notSynced.ChainId)
+	require.Zero(t, notSynced.SyncedBlockNum)
+	require.False(t, notSynced.Synced)
+
+	// The networks are not synced anymore when they fall behind the chain head
+	statusEvents.Publish(0, &synchronizer.Status{NetworkID: 0, LastBlockNumber: 5, ChainHead: 8})
+	statusEvents.Publish(1, &synchronizer.Status{NetworkID: 1, LastBlockNumber: 7, ChainHead: 7})
+	res, err = s.GetNetworks(ctx, &pb.GetNetworksRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Networks[0].Synced)
+	require.True(t, res.Networks[1].Synced)
+}
+
+func TestGetClaimFindings(t *testing.T) {
+	ctx := context.Background()
+	s, _, _ := newTestService([]Network{{NetworkID: 0}, {NetworkID: 1, RollupID: 1}})
+	res, err := s.GetClaimFindings(ctx, &pb.GetClaimFindingsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Findings)
+	require.Empty(t, res.CheckedAt)
+
+	deposit := &etherman.Deposit{Amount: big.NewInt(100), DestinationNetwork: 1, DepositCount: 1}
+	s.claimReports.Publish(monitor.ClaimFindingsTopic, &monitor.ClaimCheckReport{
+		CheckedAt: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		Findings: []monitor.ClaimFinding{
+			{Kind: monitor.MismatchFinding, Claim: etherman.Claim{NetworkID: 1, Index: 1, MainnetFlag: true, Amount: big.NewInt(1000)}, Deposit: deposit, ClaimCount: 1, MismatchedFields: []string{"amount"}},
+			{Kind: monitor.UnknownDepositFinding, Claim: etherman.Claim{NetworkID: 0, Index: 3, Amount: big.NewInt(1)}, ClaimCount: 1},
+		},
+	})
+	res, err = s.GetClaimFindings(ctx, &pb.GetClaimFindingsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "2024-05-01T00:00:00Z", res.CheckedAt)
+	require.Len(t, res.Findings, 2)
+	require.Equal(t, "1000", res.Findings[0].Claim.Amount)
+	require.Equal(t, "100", res.Findings[0].Deposit.Amount)
+	require.Equal(t, []string{"amount"}, res.Findings[0].MismatchedFields)
+	require.Nil(t, res.Findings[1].Deposit)
+
+	netID := uint32(0)
+	res, err = s.GetClaimFindings(ctx, &pb.GetClaimFindingsRequest{NetId: &netID})
+	require.NoError(t, err)
+	require.Len(t, res.Findings, 1)
+	require.Equal(t, string(monitor.UnknownDepositFinding), res.Findings[0].Kind)
+}
+
+func TestGetPendingClaimsProofAvailable(t *testing.T) {
+	ctx := context.Background()
+	s, store, _ := newTestService([]Network{{NetworkID: 0}, {NetworkID: 1, RollupID: 1}})
+	bt, err := bridgectrl.NewBridgeController(ctx, bridgectrl.Config{Height: 32}, []uint{0, 1}, store)
+	require.NoError(t, err)
+	blockID := addBlock(t, store, 0, 1)
+	destAddr := common.HexToAddress("0x20")
+	roots := make([][]byte, 3) //nolint:gomnd
+	for i := range roots {
+		deposit := &etherman.Deposit{
+			Amount:             big.NewInt(int64(i + 1)),
+			DestinationNetwork: 1,
+			DestinationAddress: destAddr,
+			BlockID:            blockID,
+			DepositCount:       uint(i),
+		}
+		depositID, err := store.AddDeposit(ctx, deposit, nil)
+		require.NoError(t, err)
+		require.NoError(t, bt.AddDeposit(ctx, deposit, depositID, nil))
+		roots[i], err = store.GetRoot(ctx, uint(i), 0, nil)
+		require.NoError(t, err)
+	}
+	// All the deposits are ready for claim, but the latest exit root only includes the first two
+	_, err = store.UpdateL1DepositsStatus(ctx, roots[2], 1, nil)
+	require.NoError(t, err)
+	_, err = store.AddTrustedGlobalExitRoot(ctx, &etherman.GlobalExitRoot{
+		GlobalExitRoot: common.HexToHash("0x1"),
+		ExitRoots:      []common.Hash{common.BytesToHash(roots[1]), {}},
+	}, nil)
+	require.NoError(t, err)
+
+	res, err := s.GetPendingClaims(ctx, &pb.GetPendingClaimsRequest{DestAddr: destAddr.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Claims, 3)
+	proofsAvailable := make(map[uint64]bool)
+	for _, claim := range res.Claims {
+		proofsAvailable[claim.Deposit.DepositCnt] = claim.ProofAvailable
+	}
+	require.Equal(t, map[uint64]bool{0: true, 1: true, 2: false}, proofsAvailable)
+}
@@ -0,0 +1,27 @@
+package snapshot
+
+import (
+	"context"
+	"io"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/jackc/pgx/v4"
+)
+
+// storageInterface interface for the snapshots
+type storageInterface interface {
+	BeginSnapshotTransaction(ctx context.Context) (pgx.Tx, error)
+	BeginDBTransaction(ctx context.Context) (pgx.Tx, error)
+	Commit(ctx context.Context, dbTx pgx.Tx) error
+	Rollback(ctx context.Context, dbTx pgx.Tx) error
+	GetMigrationVersion(ctx context.Context, dbTx pgx.Tx) (string, error)
+	GetSnapshotTables(ctx context.Context, dbTx pgx.Tx) ([]string, error)
+	GetTableColumns(ctx context.Context, table string, dbTx pgx.Tx) ([]string, error)
+	CopyTableTo(ctx context.Context, table string, columns []string, w io.Writer, dbTx pgx.Tx) (int64, error)
+	CopyTableFrom(ctx context.Context, table string, columns []string, r io.Reader, dbTx pgx.Tx) (int64, error)
+	ResetSequences(ctx context.Context, table string, columns []string, dbTx pgx.Tx) error
+	GetBlockRanges(ctx context.Context, dbTx pgx.Tx) ([]etherman.BlockRange, error)
+	Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error)
+	GetPrunedDepositCount(ctx context.Context, network uint, dbTx pgx.Tx) (uint, error)
+	IterateDepositRoots(ctx context.Context, networkID uint, fn func(depositID uint64, deposit *etherman.Deposit, root []byte) error, dbTx pgx.Tx) error
+}
@@ -0,0 +1,257 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	// FormatVersion is the version of the format of the snapshots. It changes when the format changes, not when the
+	// schema of the database does, which is identified by the migration of the snapshot.
+	FormatVersion = 1
+
+	manifestName   = "manifest.json"
+	tableExtension = ".copy"
+)
+
+var (
+	// ErrUnsupportedVersion is used when the format version of the snapshot is not supported
+	ErrUnsupportedVersion = errors.New("unsupported snapshot version")
+	// ErrMigrationMismatch is used when the snapshot was taken with a schema different from the one of the database
+	ErrMigrationMismatch = errors.New("snapshot migration mismatch")
+	// ErrChecksumMismatch is used when the content of a table doesn't match its checksum in the manifest
+	ErrChecksumMismatch = errors.New("snapshot checksum mismatch")
+	// ErrNotEmpty is used when the snapshot is imported into a database with synced blocks
+	ErrNotEmpty = errors.New("the database is not empty")
+	// ErrInvalidSnapshot is used when the content of the snapshot doesn't match its manifest
+	ErrInvalidSnapshot = errors.New("invalid snapshot")
+)
+
+// Table is a table of the snapshot. SHA256 is the checksum of its rows in the text format of COPY.
+type Table struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Rows    int64    `json:"rows"`
+	SHA256  string   `json:"sha256"`
+}
+
+// Manifest describes the content of a snapshot: the schema it was taken with, the synced blocks of each network
+// and the tables in the order they are restored.
+type Manifest struct {
+	Version   int                   `json:"version"`
+	Migration string                `json:"migration"`
+	CreatedAt time.Time             `json:"createdAt"`
+	Networks  []etherman.BlockRange `json:"networks"`
+	Tables    []Table               `json:"tables"`
+}
+
+// Export writes a snapshot of the sync and mt schemas of the storage. The snapshot is a gzip compressed tar
+// whose first entry is the manifest, followed by an entry per table. The tables are read in a single db
+// transaction, so the snapshot is consistent while the synchronizers are running.
+func Export(ctx context.Context, storage interface{}, w io.Writer) (*Manifest, error) {
+	s := storage.(storageInterface)
+	dbTx, err := s.BeginSnapshotTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if errRollback := s.Rollback(ctx, dbTx); errRollback != nil && !errors.Is(errRollback, pgx.ErrTxClosed) {
+			log.Errorf("error rolling back the snapshot db transaction. Error: %v", errRollback)
+		}
+	}()
+
+	manifest := &Manifest{Version: FormatVersion, CreatedAt: time.Now().UTC()}
+	if manifest.Migration, err = s.GetMigrationVersion(ctx, dbTx); err != nil {
+		return nil, err
+	}
+	if manifest.Networks, err = s.GetBlockRanges(ctx, dbTx); err != nil {
+		return nil, err
+	}
+	tables, err := s.GetSnapshotTables(ctx, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	// The tables are dumped to temporary files first, because the manifest with their checksums goes first
+	var files []*os.File
+	defer func() {
+		for _, f := range files {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+	for _, name := range tables {
+		columns, err := s.GetTableColumns(ctx, name, dbTx)
+		if err != nil {
+			return nil, err
+		}
+		f, err := os.CreateTemp("", "snapshot-*"+tableExtension)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+		hash := sha256.New()
+		rows, err := s.CopyTableTo(ctx, name, columns, io.MultiWriter(f, hash), dbTx)
+		if err != nil {
+			return nil, fmt.Errorf("error exporting the table %s: %w", name, err)
+		}
+		manifest.Tables = append(manifest.Tables, Table{Name: name, Columns: columns, Rows: rows, SHA256: hex.EncodeToString(hash.Sum(nil))})
+		log.Infof("exported %d rows of the table %s", rows, name)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	err = tw.WriteHeader(&tar.Header{Name: manifestName, Mode: 0644, Size: int64(len(content)), ModTime: manifest.CreatedAt}) //nolint:gomnd
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tw.Write(content); err != nil {
+		return nil, err
+	}
+	for i, f := range files {
+		info, err := f.Stat()
+		if err != nil {
+			return nil, err
+		}
+		err = tw.WriteHeader(&tar.Header{Name: manifest.Tables[i].Name + tableExtension, Mode: 0644, Size: info.Size(), ModTime: manifest.CreatedAt}) //nolint:gomnd
+		if err != nil {
+			return nil, err
+		}
+		if _, err = f.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if _, err = io.Copy(tw, f); err != nil {
+			return nil, err
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, gz.Close()
+}
+
+// Import restores a snapshot into the storage, whose schema must be migrated to the migration of the snapshot and
+// must not have synced blocks. The checksums of the tables and the merkle roots stored for the deposits, rebuilt
+// from them, are verified before the db transaction of the import is committed. The synchronizers resume from the
+// last blocks of the snapshot.
+func Import(ctx context.Context, storage interface{}, height uint8, r io.Reader) (*Manifest, error) {
+	s := storage.(storageInterface)
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	header, err := tr.Next()
+	if err != nil {
+		return nil, err
+	}
+	if header.Name != manifestName {
+		return nil, fmt.Errorf("%w: the first entry is %s instead of the manifest", ErrInvalidSnapshot, header.Name)
+	}
+	var manifest Manifest
+	if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return nil, err
+	}
+	if manifest.Version != FormatVersion {
+		return nil, fmt.Errorf("%w: %d, supported: %d", ErrUnsupportedVersion, manifest.Version, FormatVersion)
+	}
+
+	dbTx, err := s.BeginDBTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = importTables(ctx, s, &manifest, tr, dbTx)
+	if err == nil {
+		err = verifyRoots(ctx, s, height, &manifest, dbTx)
+	}
+	if err != nil {
+		if errRollback := s.Rollback(ctx, dbTx); errRollback != nil {
+			log.Errorf("error rolling back the snapshot import. Error: %v", errRollback)
+		}
+		return nil, err
+	}
+	return &manifest, s.Commit(ctx, dbTx)
+}
+
+func importTables(ctx context.Context, s storageInterface, manifest *Manifest, tr *tar.Reader, dbTx pgx.Tx) error {
+	migration, err := s.GetMigrationVersion(ctx, dbTx)
+	if err != nil {
+		return err
+	}
+	if migration != manifest.Migration {
+		return fmt.Errorf("%w: snapshot: %s, database: %s", ErrMigrationMismatch, manifest.Migration, migration)
+	}
+	ranges, err := s.GetBlockRanges(ctx, dbTx)
+	if err != nil {
+		return err
+	}
+	if len(ranges) > 0 {
+		return ErrNotEmpty
+	}
+	for _, table := range manifest.Tables {
+		header, err := tr.Next()
+		if err != nil {
+			return fmt.Errorf("%w: missing the table %s: %v", ErrInvalidSnapshot, table.Name, err)
+		}
+		if header.Name != table.Name+tableExtension {
+			return fmt.Errorf("%w: entry %s instead of the table %s", ErrInvalidSnapshot, header.Name, table.Name)
+		}
+		hash := sha256.New()
+		rows, err := s.CopyTableFrom(ctx, table.Name, table.Columns, io.TeeReader(tr, hash), dbTx)
+		if err != nil {
+			return fmt.Errorf("error importing the table %s: %w", table.Name, err)
+		}
+		if checksum := hex.EncodeToString(hash.Sum(nil)); checksum != table.SHA256 || rows != table.Rows {
+			return fmt.Errorf("%w: table %s, rows: %d, expected rows: %d, sha256: %s, expected sha256: %s",
+				ErrChecksumMismatch, table.Name, rows, table.Rows, checksum, table.SHA256)
+		}
+		if err := s.ResetSequences(ctx, table.Name, table.Columns, dbTx); err != nil {
+			return err
+		}
+		log.Infof("imported %d rows of the table %s", rows, table.Name)
+	}
+	if header, err := tr.Next(); err != io.EOF {
+		if err == nil {
+			err = fmt.Errorf("%w: unexpected entry %s", ErrInvalidSnapshot, header.Name)
+		}
+		return err
+	}
+	ranges, err = s.GetBlockRanges(ctx, dbTx)
+	if err != nil {
+		return err
+	}
+	if !slices.Equal(ranges, manifest.Networks) {
+		return fmt.Errorf("%w: the imported blocks don't match the blocks of the manifest", ErrInvalidSnapshot)
+	}
+	return nil
+}
+
+func verifyRoots(ctx context.Context, s storageInterface, height uint8, manifest *Manifest, dbTx pgx.Tx) error {
+	for _, network := range manifest.Networks {
+		checked, err := bridgectrl.VerifyRoots(ctx, s, height, network.NetworkID, dbTx)
+		if err != nil {
+			return err
+		}
+		log.Infof("networkID: %d, verified %d merkle roots, last block: %d %s", network.NetworkID, checked, network.LastBlockNumber, network.LastBlockHash)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/memstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// copyStorage keeps the tables as COPY text on top of the memory storage, whose blocks, deposits and roots are the
+// ones of the database
+type copyStorage struct {
+	*memstorage.MemoryStorage
+	migration string
+	tables    map[string][]byte
+	sequences map[string]bool
+}
+
+func (s *copyStorage) BeginSnapshotTransaction(ctx context.Context) (pgx.Tx, error) {
+	return s.BeginDBTransaction(ctx)
+}
+
+func (s *copyStorage) GetMigrationVersion(ctx context.Context, dbTx pgx.Tx) (string, error) {
+	return s.migration, nil
+}
+
+func (s *copyStorage) GetSnapshotTables(ctx context.Context, dbTx pgx.Tx) ([]string, error) {
+	return []string{"sync.block", "mt.root"}, nil
+}
+
+func (s *copyStorage) GetTableColumns(ctx context.Context, table string, dbTx pgx.Tx) ([]string, error) {
+	return []string{"id", "value"}, nil
+}
+
+func (s *copyStorage) CopyTableTo(ctx context.Context, table string, columns []string, w io.Writer, dbTx pgx.Tx) (int64, error) {
+	_, err := w.Write(s.tables[table])
+	return int64(bytes.Count(s.tables[table], []byte("\n"))), err
+}
+
+func (s *copyStorage) CopyTableFrom(ctx context.Context, table string, columns []string, r io.Reader, dbTx pgx.Tx) (int64, error) {
+	content, err := io.ReadAll(r)
+	s.tables[table] = content
+	return int64(bytes.Count(content, []byte("\n"))), err
+}
+
+func (s *copyStorage) ResetSequences(ctx context.Context, table string, columns []string, dbTx pgx.Tx) error {
+	s.sequences[table] = true
+	return nil
+}
+
+func newCopyStorage(store *memstorage.MemoryStorage) *copyStorage {
+	return &copyStorage{MemoryStorage: store, migration: "0013.sql", tables: make(map[string][]byte), sequences: make(map[string]bool)}
+}
+
+func addDeposits(t *testing.T, store *memstorage.MemoryStorage, bt *bridgectrl.BridgeController) {
+	ctx := context.Background()
+	for i := uint(0); i < 5; i++ {
+		blockID, err := store.AddBlock(ctx, &etherman.Block{BlockNumber: uint64(i + 10), BlockHash: common.BigToHash(big.NewInt(int64(i + 1))), ReceivedAt: time.Now()}, nil)
+		require.NoError(t, err)
+		deposit := &etherman.Deposit{Amount: big.NewInt(int64(i + 1)), BlockID: blockID, DepositCount: i}
+		depositID, err := store.AddDeposit(ctx, deposit, nil)
+		require.NoError(t, err)
+		if bt != nil {
+			require.NoError(t, bt.AddDeposit(ctx, deposit, depositID, nil))
+		} else {
+			require.NoError(t, store.SetRoot(ctx, common.BigToHash(big.NewInt(int64(i))).Bytes(), depositID, 0, nil))
+		}
+	}
+}
+
+// rewriteSnapshot changes the content of the entries of a snapshot
+func rewriteSnapshot(t *testing.T, snapshot []byte, rewrite func(name string, content []byte) []byte) []byte {
+	gz, err := gzip.NewReader(bytes.NewReader(snapshot))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		content = rewrite(header.Name, content)
+		header.Size = int64(len(content))
+		require.NoError(t, tw.WriteHeader(header))
+		_, err = tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func TestExportImport(t *testing.T) {
+	const height = 32
+	ctx := context.Background()
+	source := memstorage.NewMemoryStorage()
+	bt, err := bridgectrl.NewBridgeController(ctx, bridgectrl.Config{Height: height}, []uint{0}, source)
+	require.NoError(t, err)
+	addDeposits(t, source, bt)
+	exporter := newCopyStorage(source)
+	exporter.tables["sync.block"] = []byte("1\t\\\\x01\n2\t\\\\x02\n")
+	exporter.tables["mt.root"] = []byte("1\t\\\\x03\n")
+
+	var buf bytes.Buffer
+	manifest, err := Export(ctx, exporter, &buf)
+	require.NoError(t, err)
+	require.Equal(t, FormatVersion, manifest.Version)
+	require.Equal(t, "0013.sql", manifest.Migration)
+	require.Equal(t, []etherman.BlockRange{{
+		NetworkID:        0,
+		FirstBlockNumber: 10,
+		FirstBlockHash:   common.BigToHash(big.NewInt(1)),
+		LastBlockNumber:  14,
+		LastBlockHash:    common.BigToHash(big.NewInt(5)),
+	}}, manifest.Networks)
+	require.Len(t, manifest.Tables, 2)
+	require.Equal(t, int64(2), manifest.Tables[0].Rows)
+	snapshot := buf.Bytes()
+
+	// The database must be empty and migrated to the migration of the snapshot
+	_, err = Import(ctx, newCopyStorage(source), height, bytes.NewReader(snapshot))
+	require.ErrorIs(t, err, ErrNotEmpty)
+	wrongMigration := newCopyStorage(memstorage.NewMemoryStorage())
+	wrongMigration.migration = "0012.sql"
+	_, err = Import(ctx, wrongMigration, height, bytes.NewReader(snapshot))
+	require.ErrorIs(t, err, ErrMigrationMismatch)
+
+	// The memory storage plays the database once the tables are restored
+	importer := newCopyStorage(source)
+	imported, err := Import(ctx, &restoringStorage{copyStorage: importer}, height, bytes.NewReader(snapshot))
+	require.NoError(t, err)
+	require.Equal(t, manifest.Tables, imported.Tables)
+	require.Equal(t, exporter.tables, importer.tables)
+	require.True(t, importer.sequences["sync.block"])
+
+	// A corrupted table fails the checksum
+	corrupted := rewriteSnapshot(t, snapshot, func(name string, content []byte) []byte {
+		if name == "mt.root"+tableExtension {
+			return []byte("1\t\\\\x04\n")
+		}
+		return content
+	})
+	_, err = Import(ctx, &restoringStorage{copyStorage: newCopyStorage(source)}, height, bytes.NewReader(corrupted))
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+
+	// A snapshot whose roots don't match its deposits fails the import
+	wrongRoots := memstorage.NewMemoryStorage()
+	addDeposits(t, wrongRoots, nil)
+	_, err = Import(ctx, &restoringStorage{copyStorage: newCopyStorage(wrongRoots)}, height, bytes.NewReader(snapshot))
+	require.ErrorIs(t, err, gerror.ErrRootMismatch)
+}
+
+// restoringStorage doesn't see the blocks of the memory storage until the tables are restored
+type restoringStorage struct {
+	*copyStorage
+}
+
+func (s *restoringStorage) GetBlockRanges(ctx context.Context, dbTx pgx.Tx) ([]etherman.BlockRange, error) {
+	if len(s.tables) == 0 {
+		return nil, nil
+	}
+	return s.copyStorage.GetBlockRanges(ctx, dbTx)
+}
@@ -29,14 +29,23 @@ type storageInterface interface {
 	AddGlobalExitRoot(ctx context.Context, exitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) error
 	AddDeposit(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) (uint64, error)
 	AddClaim(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) error
+	AddDepositStats(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) error
+	AddClaimStats(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) error
 	AddTokenWrapped(ctx context.Context, tokenWrapped *etherman.TokenWrapped, dbTx pgx.Tx) error
 	Reset(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) error
+	AddReorg(ctx context.Context, reorg *etherman.Reorg, dbTx pgx.Tx) error
 	GetPreviousBlock(ctx context.Context, networkID uint, offset uint64, dbTx pgx.Tx) (*etherman.Block, error)
 	GetNumberDeposits(ctx context.Context, origNetworkID uint, blockNumber uint64, dbTx pgx.Tx) (uint64, error)
 	AddTrustedGlobalExitRoot(ctx context.Context, trustedExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) (bool, error)
 	GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
 	CheckIfRootExists(ctx context.Context, root []byte, network uint8, dbTx pgx.Tx) (bool, error)
 	IsLxLyActivated(ctx context.Context, dbTx pgx.Tx) (bool, error)
+	AddPendingStateChange(ctx context.Context, pendingState *etherman.PendingStateChange, dbTx pgx.Tx) error
+	InvalidateRollupExitLeaves(ctx context.Context, override *etherman.PendingStateChange, dbTx pgx.Tx) (int64, error)
+	AddEmergencyState(ctx context.Context, emergencyState *etherman.EmergencyState, dbTx pgx.Tx) error
+	AddRollup(ctx context.Context, rollup *etherman.Rollup, dbTx pgx.Tx) error
+	AddRollupUpdate(ctx context.Context, update *etherman.RollupUpdate, dbTx pgx.Tx) error
+	AddObsoleteRollupType(ctx context.Context, obsolete *etherman.ObsoleteRollupType, dbTx pgx.Tx) error
 }
 
 type bridgectrlInterface interface {
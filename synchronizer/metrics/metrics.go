@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/0xPolygonHermez/zkevm-node/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Prefix for the metrics of the synchronizer package.
+	Prefix = "bridge_synchronizer_"
+
+	// NetworkIDLabelName is the name of the label that identifies the network.
+	NetworkIDLabelName = "network_id"
+
+	// ReorgsName is the name of the metric that counts the detected reorgs.
+	ReorgsName = Prefix + "reorgs"
+
+	// ReorgDepthName is the name of the metric that observes the depth of the detected reorgs.
+	ReorgDepthName = Prefix + "reorg_depth"
+
+	// ReorgedDepositsName is the name of the metric that counts the deposits removed by reorgs.
+	ReorgedDepositsName = Prefix + "reorged_deposits"
+
+	// ReorgedClaimsName is the name of the metric that counts the claims removed by reorgs.
+	ReorgedClaimsName = Prefix + "reorged_claims"
+
+	// ReorgedGlobalExitRootsName is the name of the metric that counts the global exit roots removed by reorgs.
+	ReorgedGlobalExitRootsName = Prefix + "reorged_global_exit_roots"
+)
+
+// Register the metrics for the synchronizer package.
+func Register() {
+	counterVecs := []metrics.CounterVecOpts{
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: ReorgsName,
+				Help: "[SYNCHRONIZER] number of reorgs detected",
+			},
+			Labels: []string{NetworkIDLabelName},
+		},
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: ReorgedDepositsName,
+				Help: "[SYNCHRONIZER] number of deposits removed by reorgs",
+			},
+			Labels: []string{NetworkIDLabelName},
+		},
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: ReorgedClaimsName,
+				Help: "[SYNCHRONIZER] number of claims removed by reorgs",
+			},
+			Labels: []string{NetworkIDLabelName},
+		},
+		{
+			CounterOpts: prometheus.CounterOpts{
+				Name: ReorgedGlobalExitRootsName,
+				Help: "[SYNCHRONIZER] number of global exit roots removed by reorgs",
+			},
+			Labels: []string{NetworkIDLabelName},
+		},
+	}
+	histogramVecs := []metrics.HistogramVecOpts{
+		{
+			HistogramOpts: prometheus.HistogramOpts{
+				Name:    ReorgDepthName,
+				Help:    "[SYNCHRONIZER] depth in blocks of the reorgs detected",
+				Buckets: prometheus.ExponentialBuckets(1, 2, 12), //nolint:gomnd
+			},
+			Labels: []string{NetworkIDLabelName},
+		},
+	}
+
+	metrics.RegisterCounterVecs(counterVecs...)
+	metrics.RegisterHistogramVecs(histogramVecs...)
+}
+
+// Reorg observes a reorg detected in the given network.
+func Reorg(networkID uint, depth uint64, deposits, claims, globalExitRoots int) {
+	label := strconv.FormatUint(uint64(networkID), 10) //nolint:gomnd
+	metrics.CounterVecInc(ReorgsName, label)
+	metrics.HistogramVecObserve(ReorgDepthName, label, float64(depth))
+	metrics.CounterVecAdd(ReorgedDepositsName, label, float64(deposits))
+	metrics.CounterVecAdd(ReorgedClaimsName, label, float64(claims))
+	metrics.CounterVecAdd(ReorgedGlobalExitRootsName, label, float64(globalExitRoots))
+}
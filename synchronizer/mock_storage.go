@@ -62,6 +62,24 @@ func (_m *storageMock) AddClaim(ctx context.Context, claim *etherman.Claim, dbTx
 	return r0
 }
 
+// AddClaimStats provides a mock function with given fields: ctx, claim, dbTx
+func (_m *storageMock) AddClaimStats(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, claim, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddClaimStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.Claim, pgx.Tx) error); ok {
+		r0 = rf(ctx, claim, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddDeposit provides a mock function with given fields: ctx, deposit, dbTx
 func (_m *storageMock) AddDeposit(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) (uint64, error) {
 	ret := _m.Called(ctx, deposit, dbTx)
@@ -90,6 +108,42 @@ func (_m *storageMock) AddDeposit(ctx context.Context, deposit *etherman.Deposit
 	return r0, r1
 }
 
+// AddDepositStats provides a mock function with given fields: ctx, deposit, dbTx
+func (_m *storageMock) AddDepositStats(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, deposit, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDepositStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.Deposit, pgx.Tx) error); ok {
+		r0 = rf(ctx, deposit, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddEmergencyState provides a mock function with given fields: ctx, emergencyState, dbTx
+func (_m *storageMock) AddEmergencyState(ctx context.Context, emergencyState *etherman.EmergencyState, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, emergencyState, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddEmergencyState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.EmergencyState, pgx.Tx) error); ok {
+		r0 = rf(ctx, emergencyState, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddGlobalExitRoot provides a mock function with given fields: ctx, exitRoot, dbTx
 func (_m *storageMock) AddGlobalExitRoot(ctx context.Context, exitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, exitRoot, dbTx)
@@ -108,6 +162,96 @@ func (_m *storageMock) AddGlobalExitRoot(ctx context.Context, exitRoot *etherman
 	return r0
 }
 
+// AddObsoleteRollupType provides a mock function with given fields: ctx, obsolete, dbTx
+func (_m *storageMock) AddObsoleteRollupType(ctx context.Context, obsolete *etherman.ObsoleteRollupType, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, obsolete, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddObsoleteRollupType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.ObsoleteRollupType, pgx.Tx) error); ok {
+		r0 = rf(ctx, obsolete, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddPendingStateChange provides a mock function with given fields: ctx, pendingState, dbTx
+func (_m *storageMock) AddPendingStateChange(ctx context.Context, pendingState *etherman.PendingStateChange, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, pendingState, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPendingStateChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.PendingStateChange, pgx.Tx) error); ok {
+		r0 = rf(ctx, pendingState, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddReorg provides a mock function with given fields: ctx, reorg, dbTx
+func (_m *storageMock) AddReorg(ctx context.Context, reorg *etherman.Reorg, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, reorg, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReorg")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.Reorg, pgx.Tx) error); ok {
+		r0 = rf(ctx, reorg, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddRollup provides a mock function with given fields: ctx, rollup, dbTx
+func (_m *storageMock) AddRollup(ctx context.Context, rollup *etherman.Rollup, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, rollup, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.Rollup, pgx.Tx) error); ok {
+		r0 = rf(ctx, rollup, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddRollupUpdate provides a mock function with given fields: ctx, update, dbTx
+func (_m *storageMock) AddRollupUpdate(ctx context.Context, update *etherman.RollupUpdate, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, update, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollupUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.RollupUpdate, pgx.Tx) error); ok {
+		r0 = rf(ctx, update, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddTokenWrapped provides a mock function with given fields: ctx, tokenWrapped, dbTx
 func (_m *storageMock) AddTokenWrapped(ctx context.Context, tokenWrapped *etherman.TokenWrapped, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, tokenWrapped, dbTx)
@@ -348,6 +492,34 @@ func (_m *storageMock) GetPreviousBlock(ctx context.Context, networkID uint, off
 	return r0, r1
 }
 
+// InvalidateRollupExitLeaves provides a mock function with given fields: ctx, override, dbTx
+func (_m *storageMock) InvalidateRollupExitLeaves(ctx context.Context, override *etherman.PendingStateChange, dbTx pgx.Tx) (int64, error) {
+	ret := _m.Called(ctx, override, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InvalidateRollupExitLeaves")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.PendingStateChange, pgx.Tx) (int64, error)); ok {
+		return rf(ctx, override, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.PendingStateChange, pgx.Tx) int64); ok {
+		r0 = rf(ctx, override, dbTx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *etherman.PendingStateChange, pgx.Tx) error); ok {
+		r1 = rf(ctx, override, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsLxLyActivated provides a mock function with given fields: ctx, dbTx
 func (_m *storageMock) IsLxLyActivated(ctx context.Context, dbTx pgx.Tx) (bool, error) {
 	ret := _m.Called(ctx, dbTx)
@@ -8,7 +8,10 @@ import (
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/synchronizer/metrics"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgx/v4"
@@ -26,18 +29,36 @@ type ClientSynchronizer struct {
 	bridgeCtrl       bridgectrlInterface
 	storage          storageInterface
 	ctx              context.Context
+	stopCtx          context.Context
 	cancelCtx        context.CancelFunc
 	genBlockNumber   uint64
 	cfg              Config
 	networkID        uint
-	chExitRootEvent  chan *etherman.GlobalExitRoot
-	chSynced         chan uint
+	exitRootEvents   *eventbus.Bus[*etherman.GlobalExitRoot]
+	syncedEvents     *eventbus.Bus[uint]
+	statusEvents     *eventbus.Bus[*Status]
+	commitEvents     *eventbus.Bus[uint]
+	outbox           *webhook.Outbox
 	zkEVMClient      zkEVMClientInterface
 	synced           bool
+	chainHead        uint64
 	l1RollupExitRoot common.Hash
 }
 
-// NewSynchronizer creates and initializes an instance of Synchronizer
+// Status is the progress of the synchronization of a network
+type Status struct {
+	NetworkID uint
+	// LastBlockNumber and LastBlockHash identify the latest block synced
+	LastBlockNumber uint64
+	LastBlockHash   common.Hash
+	// ChainHead is the latest block of the network when the synchronization iteration started
+	ChainHead uint64
+	// SyncedAt is the time when the latest synchronization iteration finished successfully
+	SyncedAt time.Time
+}
+
+// NewSynchronizer creates and initializes an instance of Synchronizer. The webhook events are recorded in the outbox, if any.
+// The network ID is published in commitEvents every time the synchronized data changes.
 func NewSynchronizer(
 	ctx context.Context,
 	storage interface{},
@@ -45,10 +66,16 @@ func NewSynchronizer(
 	ethMan ethermanInterface,
 	zkEVMClient zkEVMClientInterface,
 	genBlockNumber uint64,
-	chExitRootEvent chan *etherman.GlobalExitRoot,
-	chSynced chan uint,
+	exitRootEvents *eventbus.Bus[*etherman.GlobalExitRoot],
+	syncedEvents *eventbus.Bus[uint],
+	statusEvents *eventbus.Bus[*Status],
+	commitEvents *eventbus.Bus[uint],
+	outbox *webhook.Outbox,
 	cfg Config) (Synchronizer, error) {
-	ctx, cancel := context.WithCancel(ctx)
+	// The synchronizer stops when stopCtx is done, but the work in progress is done with a context
+	// that is not cancelled, so the blocks are never stored partially.
+	stopCtx, cancel := context.WithCancel(ctx)
+	ctx = context.WithoutCancel(ctx)
 	networkID, err := ethMan.GetNetworkID(ctx)
 	if err != nil {
 		log.Fatal("error getting networkID. Error: ", err)
@@ -76,12 +103,16 @@ func NewSynchronizer(
 			storage:          storage.(storageInterface),
 			etherMan:         ethMan,
 			ctx:              ctx,
+			stopCtx:          stopCtx,
 			cancelCtx:        cancel,
 			genBlockNumber:   genBlockNumber,
 			cfg:              cfg,
 			networkID:        networkID,
-			chExitRootEvent:  chExitRootEvent,
-			chSynced:         chSynced,
+			exitRootEvents:   exitRootEvents,
+			syncedEvents:     syncedEvents,
+			statusEvents:     statusEvents,
+			commitEvents:     commitEvents,
+			outbox:           outbox,
 			zkEVMClient:      zkEVMClient,
 			l1RollupExitRoot: ger.ExitRoots[1],
 		}, nil
@@ -91,10 +122,15 @@ func NewSynchronizer(
 		storage:        storage.(storageInterface),
 		etherMan:       ethMan,
 		ctx:            ctx,
+		stopCtx:        stopCtx,
 		cancelCtx:      cancel,
 		genBlockNumber: genBlockNumber,
 		cfg:            cfg,
-		chSynced:       chSynced,
+		exitRootEvents: exitRootEvents,
+		syncedEvents:   syncedEvents,
+		statusEvents:   statusEvents,
+		commitEvents:   commitEvents,
+		outbox:         outbox,
 		networkID:      networkID,
 	}, nil
 }
@@ -124,21 +160,26 @@ func (s *ClientSynchronizer) Sync() error {
 	log.Debugf("NetworkID: %d, initial lastBlockSynced: %+v", s.networkID, lastBlockSynced)
 	for {
 		select {
-		case <-s.ctx.Done():
+		case <-s.stopCtx.Done():
 			log.Debugf("NetworkID: %d, synchronizer ctx done", s.networkID)
 			return nil
 		case <-time.After(waitDuration):
 			log.Debugf("NetworkID: %d, syncing...", s.networkID)
 			//Sync L1Blocks
 			if lastBlockSynced, err = s.syncBlocks(lastBlockSynced); err != nil {
+				if s.stopCtx.Err() != nil {
+					continue
+				}
 				log.Warnf("networkID: %d, error syncing blocks: %v", s.networkID, err)
 				lastBlockSynced, err = s.storage.GetLastBlock(s.ctx, s.networkID, nil)
 				if err != nil {
 					log.Fatalf("networkID: %d, error getting lastBlockSynced to resume the synchronization... Error: ", s.networkID, err)
 				}
-				if s.ctx.Err() != nil {
+				if s.stopCtx.Err() != nil {
 					continue
 				}
+			} else {
+				s.notifyStatus(lastBlockSynced)
 			}
 			if !s.synced {
 				// Check latest Block
@@ -152,14 +193,14 @@ func (s *ClientSynchronizer) Sync() error {
 					log.Infof("NetworkID %d Synced!", s.networkID)
 					waitDuration = s.cfg.SyncInterval.Duration
 					s.synced = true
-					s.chSynced <- s.networkID
+					s.notifySynced()
 				}
 				if lastBlockSynced.BlockNumber > lastKnownBlock {
 					if s.networkID == 0 {
 						log.Fatalf("networkID: %d, error: latest Synced BlockNumber (%d) is higher than the latest Proposed block (%d) in the network", s.networkID, lastBlockSynced.BlockNumber, lastKnownBlock)
 					} else {
 						log.Errorf("networkID: %d, error: latest Synced BlockNumber (%d) is higher than the latest Proposed block (%d) in the network", s.networkID, lastBlockSynced.BlockNumber, lastKnownBlock)
-						err = s.resetState(lastKnownBlock)
+						err = s.resetState(s.newReorg(lastBlockSynced, &etherman.Block{BlockNumber: lastKnownBlock}, header.Hash()))
 						if err != nil {
 							log.Errorf("networkID: %d, error resetting the state to a previous block. Error: %v", s.networkID, err)
 							continue
@@ -185,6 +226,24 @@ func (s *ClientSynchronizer) Stop() {
 	s.cancelCtx()
 }
 
+func (s *ClientSynchronizer) notifySynced() {
+	s.syncedEvents.Publish(s.networkID, s.networkID)
+}
+
+func (s *ClientSynchronizer) notifyCommit() {
+	s.commitEvents.Publish(s.networkID, s.networkID)
+}
+
+func (s *ClientSynchronizer) notifyStatus(lastBlockSynced *etherman.Block) {
+	s.statusEvents.Publish(s.networkID, &Status{
+		NetworkID:       s.networkID,
+		LastBlockNumber: lastBlockSynced.BlockNumber,
+		LastBlockHash:   lastBlockSynced.BlockHash,
+		ChainHead:       s.chainHead,
+		SyncedAt:        time.Now().UTC(),
+	})
+}
+
 func (s *ClientSynchronizer) syncTrustedState() error {
 	lastGER, err := s.zkEVMClient.GetLatestGlobalExitRoot(s.ctx)
 	if err != nil {
@@ -217,7 +276,8 @@ func (s *ClientSynchronizer) syncTrustedState() error {
 		return err
 	}
 	if isUpdated {
-		s.chExitRootEvent <- ger
+		s.notifyCommit()
+		s.exitRootEvents.Publish(s.networkID, ger)
 	}
 	return nil
 }
@@ -225,13 +285,13 @@ func (s *ClientSynchronizer) syncTrustedState() error {
 // This function syncs the node from a specific block to the latest
 func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*etherman.Block, error) {
 	// This function will read events fromBlockNum to latestEthBlock. Check reorg to be sure that everything is ok.
-	block, err := s.checkReorg(lastBlockSynced)
+	block, reorg, err := s.checkReorg(lastBlockSynced)
 	if err != nil {
 		log.Errorf("networkID: %d, error checking reorgs. Retrying... Err: %s", s.networkID, err.Error())
 		return lastBlockSynced, fmt.Errorf("networkID: %d, error checking reorgs", s.networkID)
 	}
 	if block != nil {
-		err = s.resetState(block.BlockNumber)
+		err = s.resetState(reorg)
 		if err != nil {
 			log.Errorf("networkID: %d, error resetting the state to a previous block. Retrying... Error: %s", s.networkID, err.Error())
 			return lastBlockSynced, fmt.Errorf("networkID: %d, error resetting the state to a previous block", s.networkID)
@@ -245,6 +305,7 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 		return lastBlockSynced, err
 	}
 	lastKnownBlock := header.Number
+	s.chainHead = lastKnownBlock.Uint64()
 
 	var fromBlock uint64
 	if lastBlockSynced.BlockNumber > 0 {
@@ -252,6 +313,9 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 	}
 
 	for {
+		if s.stopCtx.Err() != nil {
+			return lastBlockSynced, s.stopCtx.Err()
+		}
 		toBlock := fromBlock + s.cfg.SyncChunkSize
 
 		log.Debugf("NetworkID: %d, Getting bridge info from block %d to block %d", s.networkID, fromBlock, toBlock)
@@ -280,7 +344,7 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 				log.Infof("NetworkID %d Synced!", s.networkID)
 				waitDuration = s.cfg.SyncInterval.Duration
 				s.synced = true
-				s.chSynced <- s.networkID
+				s.notifySynced()
 			}
 			break
 		}
@@ -314,6 +378,10 @@ func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order ma
 	// New info has to be included into the db using the state
 	var isNewGer bool
 	for i := range blocks {
+		// Stop between blocks if the synchronizer is stopped
+		if s.stopCtx.Err() != nil {
+			return s.stopCtx.Err()
+		}
 		// Begin db transaction
 		dbTx, err := s.storage.BeginDBTransaction(s.ctx)
 		if err != nil {
@@ -366,6 +434,31 @@ func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order ma
 			case etherman.ActivateEtrogOrder:
 				// this is activated when the bridge detects the CreateNewRollup or the AddExistingRollup event from the rollupManager
 				log.Info("Event received. Activating LxLyEtrog...")
+			case etherman.PendingStateOrder:
+				err = s.processPendingStateChange(blocks[i].PendingStateChanges[element.Pos], blockID, dbTx)
+				if err != nil {
+					return err
+				}
+			case etherman.EmergencyStateOrder:
+				err = s.processEmergencyState(blocks[i].EmergencyStates[element.Pos], blockID, dbTx)
+				if err != nil {
+					return err
+				}
+			case etherman.RollupOrder:
+				err = s.processRollup(blocks[i].Rollups[element.Pos], blockID, dbTx)
+				if err != nil {
+					return err
+				}
+			case etherman.UpdateRollupOrder:
+				err = s.processRollupUpdate(blocks[i].RollupUpdates[element.Pos], blockID, dbTx)
+				if err != nil {
+					return err
+				}
+			case etherman.ObsoleteRollupTypeOrder:
+				err = s.processObsoleteRollupType(blocks[i].ObsoleteRollupTypes[element.Pos], blockID, dbTx)
+				if err != nil {
+					return err
+				}
 			}
 		}
 		err = s.storage.Commit(s.ctx, dbTx)
@@ -380,6 +473,7 @@ func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order ma
 			}
 			return err
 		}
+		s.notifyCommit()
 	}
 	if isNewGer {
 		// Send latest GER stored to claimTxManager
@@ -391,20 +485,45 @@ func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order ma
 		if s.l1RollupExitRoot != ger.ExitRoots[1] {
 			log.Debugf("Updating ger: %+v", ger)
 			s.l1RollupExitRoot = ger.ExitRoots[1]
-			s.chExitRootEvent <- ger
+			s.exitRootEvents.Publish(s.networkID, ger)
 		}
 	}
 	return nil
 }
 
-// This function allows reset the state until an specific ethereum block
-func (s *ClientSynchronizer) resetState(blockNumber uint64) error {
+// This function allows reset the state until an specific ethereum block.
+// The reorg is stored in the journal, with the data that is removed, before resetting the state.
+func (s *ClientSynchronizer) resetState(reorg *etherman.Reorg) error {
+	blockNumber := reorg.ForkBlockNumber
 	log.Infof("NetworkID: %d. Reverting synchronization to block: %d", s.networkID, blockNumber)
 	dbTx, err := s.storage.BeginDBTransaction(s.ctx)
 	if err != nil {
 		log.Errorf("networkID: %d, Error starting a db transaction to reset the state. Error: %v", s.networkID, err)
 		return err
 	}
+	reorg.DetectedAt = time.Now().UTC()
+	err = s.storage.AddReorg(s.ctx, reorg, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error storing the reorg in the journal. Error: %v", s.networkID, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %v, error : %s",
+				s.networkID, blockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
+	err = s.addDepositEvents(webhook.DepositReorgedEvent, reorg.Deposits, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error adding the webhook events of the reorged deposits. Error: %v", s.networkID, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %v, error : %s",
+				s.networkID, blockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
 	err = s.storage.Reset(s.ctx, blockNumber, s.networkID, dbTx)
 	if err != nil {
 		log.Errorf("networkID: %d, error resetting the state. Error: %v", s.networkID, err)
@@ -450,6 +569,11 @@ func (s *ClientSynchronizer) resetState(blockNumber uint64) error {
 		}
 		return err
 	}
+	s.notifyCommit()
+	log.Warnf("NetworkID: %d, reorg %d stored. ForkBlock: %d, depth: %d, oldBlockHash: %s, newBlockHash: %s, removed deposits: %d, claims: %d, globalExitRoots: %d",
+		s.networkID, reorg.ID, reorg.ForkBlockNumber, reorg.Depth, reorg.OldBlockHash.String(), reorg.NewBlockHash.String(),
+		len(reorg.Deposits), len(reorg.Claims), len(reorg.GlobalExitRoots))
+	metrics.Reorg(s.networkID, reorg.Depth, len(reorg.Deposits), len(reorg.Claims), len(reorg.GlobalExitRoots))
 
 	return nil
 }
@@ -459,71 +583,144 @@ This function will check if there is a reorg.
 As input param needs the last ethereum block synced. Retrieve the block info from the blockchain
 to compare it with the stored info. If hash and hash parent matches, then no reorg is detected and return a nil.
 If hash or hash parent don't match, reorg detected and the function will return the block until the sync process
-must be reverted. Then, check the previous ethereum block synced, get block info from the blockchain and check
-hash and has parent. This operation has to be done until a match is found.
+must be reverted. To find it, the stored blocks are checked going back with an exponential step until a block
+that matches the blockchain is found, and then a binary search is done between the last reorged block and that
+one. This way the common ancestor is found with O(log n) queries to the blockchain.
+When a reorg is detected, the reorg info to be stored in the journal is also returned.
 */
-func (s *ClientSynchronizer) checkReorg(latestBlock *etherman.Block) (*etherman.Block, error) {
+func (s *ClientSynchronizer) checkReorg(latestBlock *etherman.Block) (*etherman.Block, *etherman.Reorg, error) {
 	// This function only needs to worry about reorgs if some of the reorganized blocks contained rollup info.
-	latestBlockSynced := *latestBlock
-	var depth uint64
+	newBlockHash, match, err := s.isBlockInChain(latestBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+	if match {
+		log.Debugf("NetworkID: %d, no reorg detected", s.networkID)
+		return nil, nil, nil
+	}
+	log.Infof("NetworkID: %d, reorg detected in block: %d", s.networkID, latestBlock.BlockNumber)
+
+	// The stored block at offset lower is reorged. Look for an offset upper whose block is not reorged
+	// or that is beyond the stored blocks. If the block is not found, forkBlock remains nil.
+	var (
+		lower     uint64
+		upper     uint64 = 1
+		forkBlock *etherman.Block
+	)
 	for {
-		block, err := s.etherMan.EthBlockByNumber(s.ctx, latestBlock.BlockNumber)
+		log.Info("NetworkID: ", s.networkID, ", REORG: Looking for the latest correct block. Depth: ", upper)
+		block, err := s.getPreviousBlock(upper)
+		if errors.Is(err, gerror.ErrStorageNotFound) {
+			break
+		} else if err != nil {
+			return nil, nil, err
+		}
+		_, match, err := s.isBlockInChain(block)
 		if err != nil {
-			log.Errorf("networkID: %d, error getting latest block synced from blockchain. Block: %d, error: %v",
-				s.networkID, latestBlock.BlockNumber, err)
-			return nil, err
-		}
-		if block.NumberU64() != latestBlock.BlockNumber {
-			err = fmt.Errorf("networkID: %d, wrong ethereum block retrieved from blockchain. Block numbers don't match."+
-				" BlockNumber stored: %d. BlockNumber retrieved: %d", s.networkID, latestBlock.BlockNumber, block.NumberU64())
-			log.Error("error: ", err)
-			return nil, err
-		}
-		// Compare hashes
-		if (block.Hash() != latestBlock.BlockHash || block.ParentHash() != latestBlock.ParentHash) && latestBlock.BlockNumber > s.genBlockNumber {
-			log.Info("NetworkID: ", s.networkID, ", [checkReorg function] => latestBlockNumber: ", latestBlock.BlockNumber)
-			log.Info("NetworkID: ", s.networkID, ", [checkReorg function] => latestBlockHash: ", latestBlock.BlockHash)
-			log.Info("NetworkID: ", s.networkID, ", [checkReorg function] => latestBlockHashParent: ", latestBlock.ParentHash)
-			log.Info("NetworkID: ", s.networkID, ", [checkReorg function] => BlockNumber: ", latestBlock.BlockNumber, block.NumberU64())
-			log.Info("NetworkID: ", s.networkID, ", [checkReorg function] => BlockHash: ", block.Hash())
-			log.Info("NetworkID: ", s.networkID, ", [checkReorg function] => BlockHashParent: ", block.ParentHash())
-			depth++
-			log.Info("NetworkID: ", s.networkID, ", REORG: Looking for the latest correct block. Depth: ", depth)
-			// Reorg detected. Getting previous block
-			dbTx, err := s.storage.BeginDBTransaction(s.ctx)
-			if err != nil {
-				log.Errorf("networkID: %d, error creating db transaction to get previous blocks. Error: %v", s.networkID, err)
-				return nil, err
-			}
-			latestBlock, err = s.storage.GetPreviousBlock(s.ctx, s.networkID, depth, dbTx)
-			errC := s.storage.Commit(s.ctx, dbTx)
-			if errC != nil {
-				log.Errorf("networkID: %d, error committing dbTx, err: %v", s.networkID, errC)
-				rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-				if rollbackErr != nil {
-					log.Errorf("networkID: %d, error rolling back state. RollbackErr: %v, err: %s",
-						s.networkID, rollbackErr, errC.Error())
-					return nil, rollbackErr
-				}
-				return nil, errC
-			}
-			if errors.Is(err, gerror.ErrStorageNotFound) {
-				log.Warnf("networkID: %d, error checking reorg: previous block not found in db: %v", s.networkID, err)
-				return &etherman.Block{}, nil
-			} else if err != nil {
-				log.Errorf("networkID: %d, error detected getting previous block: %v", s.networkID, err)
-				return nil, err
-			}
-		} else {
+			return nil, nil, err
+		}
+		if match {
+			forkBlock = block
 			break
 		}
+		lower = upper
+		upper *= 2
+	}
+	// The latest correct block is the one stored at the lowest offset in (lower, upper] that is not reorged
+	for upper-lower > 1 {
+		mid := lower + (upper-lower)/2 //nolint:gomnd
+		log.Info("NetworkID: ", s.networkID, ", REORG: Looking for the latest correct block. Depth: ", mid)
+		block, err := s.getPreviousBlock(mid)
+		if errors.Is(err, gerror.ErrStorageNotFound) {
+			upper = mid
+			forkBlock = nil
+			continue
+		} else if err != nil {
+			return nil, nil, err
+		}
+		_, match, err := s.isBlockInChain(block)
+		if err != nil {
+			return nil, nil, err
+		}
+		if match {
+			upper = mid
+			forkBlock = block
+		} else {
+			lower = mid
+		}
+	}
+	if forkBlock == nil {
+		log.Warnf("networkID: %d, error checking reorg: previous block not found in db", s.networkID)
+		forkBlock = &etherman.Block{}
+	}
+	log.Infof("NetworkID: %d, latest correct block found: %d", s.networkID, forkBlock.BlockNumber)
+	return forkBlock, s.newReorg(latestBlock, forkBlock, newBlockHash), nil
+}
+
+// isBlockInChain retrieves the block info from the blockchain and compares it with the stored block.
+// It returns the hash of the block in the blockchain and if the stored block is still in the chain.
+// Blocks previous to the genesis block are always considered in the chain.
+func (s *ClientSynchronizer) isBlockInChain(storedBlock *etherman.Block) (common.Hash, bool, error) {
+	block, err := s.etherMan.EthBlockByNumber(s.ctx, storedBlock.BlockNumber)
+	if err != nil {
+		log.Errorf("networkID: %d, error getting latest block synced from blockchain. Block: %d, error: %v",
+			s.networkID, storedBlock.BlockNumber, err)
+		return common.Hash{}, false, err
+	}
+	if block.NumberU64() != storedBlock.BlockNumber {
+		err = fmt.Errorf("networkID: %d, wrong ethereum block retrieved from blockchain. Block numbers don't match."+
+			" BlockNumber stored: %d. BlockNumber retrieved: %d", s.networkID, storedBlock.BlockNumber, block.NumberU64())
+		log.Error("error: ", err)
+		return common.Hash{}, false, err
+	}
+	// Compare hashes
+	if (block.Hash() != storedBlock.BlockHash || block.ParentHash() != storedBlock.ParentHash) && storedBlock.BlockNumber > s.genBlockNumber {
+		log.Info("NetworkID: ", s.networkID, ", [checkReorg function] => storedBlockNumber: ", storedBlock.BlockNumber)
+		log.Info("NetworkID: ", s.networkID, ", [checkReorg function] => storedBlockHash: ", storedBlock.BlockHash)
+		log.Info("NetworkID: ", s.networkID, ", [checkReorg function] => storedBlockHashParent: ", storedBlock.ParentHash)
+		log.Info("NetworkID: ", s.networkID, ", [checkReorg function] => BlockHash: ", block.Hash())
+		log.Info("NetworkID: ", s.networkID, ", [checkReorg function] => BlockHashParent: ", block.ParentHash())
+		return block.Hash(), false, nil
+	}
+	return block.Hash(), true, nil
+}
+
+// getPreviousBlock gets the stored block at the given offset from the latest one.
+func (s *ClientSynchronizer) getPreviousBlock(offset uint64) (*etherman.Block, error) {
+	dbTx, err := s.storage.BeginDBTransaction(s.ctx)
+	if err != nil {
+		log.Errorf("networkID: %d, error creating db transaction to get previous blocks. Error: %v", s.networkID, err)
+		return nil, err
+	}
+	block, err := s.storage.GetPreviousBlock(s.ctx, s.networkID, offset, dbTx)
+	errC := s.storage.Commit(s.ctx, dbTx)
+	if errC != nil {
+		log.Errorf("networkID: %d, error committing dbTx, err: %v", s.networkID, errC)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state. RollbackErr: %v, err: %s",
+				s.networkID, rollbackErr, errC.Error())
+			return nil, rollbackErr
+		}
+		return nil, errC
 	}
-	if latestBlockSynced.BlockHash != latestBlock.BlockHash {
-		log.Infof("NetworkID: %d, reorg detected in block: %d", s.networkID, latestBlockSynced.BlockNumber)
-		return latestBlock, nil
+	if err != nil && !errors.Is(err, gerror.ErrStorageNotFound) {
+		log.Errorf("networkID: %d, error detected getting previous block: %v", s.networkID, err)
+	}
+	return block, err
+}
+
+// newReorg builds the reorg info from the latest block synced, the block where the chains fork
+// and the hash of the block that replaces the latest block synced in the canonical chain. When the
+// chain became shorter than the latest block synced, the hash of the current chain head is used.
+func (s *ClientSynchronizer) newReorg(latestBlockSynced, forkBlock *etherman.Block, newBlockHash common.Hash) *etherman.Reorg {
+	return &etherman.Reorg{
+		NetworkID:       s.networkID,
+		ForkBlockNumber: forkBlock.BlockNumber,
+		Depth:           latestBlockSynced.BlockNumber - forkBlock.BlockNumber,
+		OldBlockHash:    latestBlockSynced.BlockHash,
+		NewBlockHash:    newBlockHash,
 	}
-	log.Debugf("NetworkID: %d, no reorg detected", s.networkID)
-	return nil, nil
 }
 
 func (s *ClientSynchronizer) processVerifyBatch(verifyBatch etherman.VerifiedBatch, blockID uint64, dbTx pgx.Tx) error {
@@ -562,10 +759,39 @@ func (s *ClientSynchronizer) processVerifyBatch(verifyBatch etherman.VerifiedBat
 			return fmt.Errorf("networkID: %d, Root: %s doesn't exist!", s.networkID, verifyBatch.LocalExitRoot.String())
 		}
 	}
+	if verifyBatch.Trusted {
+		// The trusted aggregator consolidates the state of the rollup, so it is stored as a pending state change
+		pendingState := etherman.PendingStateChange{
+			BlockID:     blockID,
+			BlockNumber: verifyBatch.BlockNumber,
+			Event:       etherman.TrustedVerifyBatchesEvent,
+			RollupID:    verifyBatch.RollupID,
+			BatchNumber: verifyBatch.BatchNumber,
+			StateRoot:   verifyBatch.StateRoot,
+			ExitRoot:    verifyBatch.LocalExitRoot,
+			Aggregator:  verifyBatch.Aggregator,
+			TxHash:      verifyBatch.TxHash,
+		}
+		err := s.storage.AddPendingStateChange(s.ctx, &pendingState, dbTx)
+		if err != nil {
+			log.Errorf("networkID: %d, error storing the trusted verification. BlockNumber: %d, error: %v", s.networkID, verifyBatch.BlockNumber, err)
+			rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+			if rollbackErr != nil {
+				log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, error : %s",
+					s.networkID, verifyBatch.BlockNumber, rollbackErr, err.Error())
+				return rollbackErr
+			}
+			return err
+		}
+	}
+	return s.addRollupExitLeaf(verifyBatch.RollupID, verifyBatch.LocalExitRoot, verifyBatch.BlockNumber, blockID, dbTx)
+}
+
+func (s *ClientSynchronizer) addRollupExitLeaf(rollupID uint, localExitRoot common.Hash, blockNumber, blockID uint64, dbTx pgx.Tx) error {
 	rollupLeaf := etherman.RollupExitLeaf{
 		BlockID:  blockID,
-		Leaf:     verifyBatch.LocalExitRoot,
-		RollupId: verifyBatch.RollupID,
+		Leaf:     localExitRoot,
+		RollupId: rollupID,
 	}
 	// Update rollupExitRoot
 	err := s.bridgeCtrl.AddRollupExitLeaf(s.ctx, rollupLeaf, dbTx)
@@ -574,7 +800,7 @@ func (s *ClientSynchronizer) processVerifyBatch(verifyBatch etherman.VerifiedBat
 		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
 		if rollbackErr != nil {
 			log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, error : %s",
-				s.networkID, verifyBatch.BlockNumber, rollbackErr, err.Error())
+				s.networkID, blockNumber, rollbackErr, err.Error())
 			return rollbackErr
 		}
 		return err
@@ -582,6 +808,115 @@ func (s *ClientSynchronizer) processVerifyBatch(verifyBatch etherman.VerifiedBat
 	return nil
 }
 
+// processPendingStateChange stores the consolidations and overrides of the pending state. When the pending state is
+// overridden, the rollup exit roots that include the discarded pending states are invalidated and the exit root of
+// the override is added to the rollupExitTree.
+func (s *ClientSynchronizer) processPendingStateChange(pendingState etherman.PendingStateChange, blockID uint64, dbTx pgx.Tx) error {
+	pendingState.BlockID = blockID
+	err := s.storage.AddPendingStateChange(s.ctx, &pendingState, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error storing the pending state change. BlockNumber: %d, error: %v", s.networkID, pendingState.BlockNumber, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, error : %s",
+				s.networkID, pendingState.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
+	if pendingState.Event != etherman.OverridePendingStateEvent {
+		return nil
+	}
+	invalidated, err := s.storage.InvalidateRollupExitLeaves(s.ctx, &pendingState, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error invalidating rollup exit leaves. BlockNumber: %d, error: %v", s.networkID, pendingState.BlockNumber, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, error : %s",
+				s.networkID, pendingState.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
+	log.Warnf("networkID: %d, pending state of rollup %d overridden at batch %d. %d rollup exit leaves invalidated",
+		s.networkID, pendingState.RollupID, pendingState.BatchNumber, invalidated)
+	return s.addRollupExitLeaf(pendingState.RollupID, pendingState.ExitRoot, pendingState.BlockNumber, blockID, dbTx)
+}
+
+func (s *ClientSynchronizer) processEmergencyState(emergencyState etherman.EmergencyState, blockID uint64, dbTx pgx.Tx) error {
+	emergencyState.BlockID = blockID
+	emergencyState.NetworkID = s.networkID
+	err := s.storage.AddEmergencyState(s.ctx, &emergencyState, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error storing the emergency state. BlockNumber: %d, error: %v", s.networkID, emergencyState.BlockNumber, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, error : %s",
+				s.networkID, emergencyState.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
+	if emergencyState.Activated {
+		log.Warnf("networkID: %d, emergency state activated by %s. The bridge is paused", s.networkID, emergencyState.Address)
+	} else {
+		log.Infof("networkID: %d, emergency state deactivated by %s", s.networkID, emergencyState.Address)
+	}
+	return nil
+}
+
+func (s *ClientSynchronizer) processRollup(rollup etherman.Rollup, blockID uint64, dbTx pgx.Tx) error {
+	rollup.BlockID = blockID
+	err := s.storage.AddRollup(s.ctx, &rollup, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error storing the rollup %d. BlockNumber: %d, error: %v", s.networkID, rollup.RollupID, rollup.BlockNumber, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, error : %s",
+				s.networkID, rollup.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
+	log.Infof("networkID: %d, rollup %d registered. ChainID: %d, address: %s", s.networkID, rollup.RollupID, rollup.ChainID, rollup.RollupAddress)
+	return nil
+}
+
+func (s *ClientSynchronizer) processRollupUpdate(update etherman.RollupUpdate, blockID uint64, dbTx pgx.Tx) error {
+	update.BlockID = blockID
+	err := s.storage.AddRollupUpdate(s.ctx, &update, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error storing the upgrade of the rollup %d. BlockNumber: %d, error: %v", s.networkID, update.RollupID, update.BlockNumber, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, error : %s",
+				s.networkID, update.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
+	log.Infof("networkID: %d, rollup %d upgraded to the rollup type %d. Last verified batch before the upgrade: %d",
+		s.networkID, update.RollupID, update.RollupTypeID, update.LastVerifiedBatchBeforeUpgrade)
+	return nil
+}
+
+func (s *ClientSynchronizer) processObsoleteRollupType(obsolete etherman.ObsoleteRollupType, blockID uint64, dbTx pgx.Tx) error {
+	obsolete.BlockID = blockID
+	err := s.storage.AddObsoleteRollupType(s.ctx, &obsolete, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error storing the obsolete rollup type %d. BlockNumber: %d, error: %v", s.networkID, obsolete.RollupTypeID, obsolete.BlockNumber, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %v, error : %s",
+				s.networkID, obsolete.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
+	log.Warnf("networkID: %d, rollup type %d is obsolete", s.networkID, obsolete.RollupTypeID)
+	return nil
+}
+
 func (s *ClientSynchronizer) processGlobalExitRoot(globalExitRoot etherman.GlobalExitRoot, blockID uint64, dbTx pgx.Tx) error {
 	// Store GlobalExitRoot
 	globalExitRoot.BlockID = blockID
@@ -614,6 +949,18 @@ func (s *ClientSynchronizer) processDeposit(deposit etherman.Deposit, blockID ui
 		return err
 	}
 
+	err = s.storage.AddDepositStats(s.ctx, &deposit, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, failed to add the deposit to the bridge stats, BlockNumber: %d, Deposit: %+v err: %v", s.networkID, deposit.BlockNumber, deposit, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %v, rollbackErr: %v, err: %s",
+				s.networkID, deposit.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
+
 	err = s.bridgeCtrl.AddDeposit(s.ctx, &deposit, depositID, dbTx)
 	if err != nil {
 		log.Errorf("networkID: %d, failed to store new deposit in the bridge tree, BlockNumber: %d, Deposit: %+v err: %v", s.networkID, deposit.BlockNumber, deposit, err)
@@ -625,14 +972,38 @@ func (s *ClientSynchronizer) processDeposit(deposit etherman.Deposit, blockID ui
 		}
 		return err
 	}
+
+	err = s.addDepositEvents(webhook.DepositIndexedEvent, []etherman.Deposit{deposit}, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, failed to add the webhook event of the deposit, BlockNumber: %d, Deposit: %+v err: %v", s.networkID, deposit.BlockNumber, deposit, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %v, rollbackErr: %v, err: %s",
+				s.networkID, deposit.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
 	return nil
 }
 
-func (s *ClientSynchronizer) processClaim(claim etherman.Claim, blockID uint64, dbTx pgx.Tx) error {
-	if claim.RollupIndex != uint64(s.etherMan.GetRollupID()) && claim.RollupIndex != 0 {
-		log.Debugf("Claim for different Rollup (RollupID: %d, RollupIndex: %d). Ignoring...", s.etherMan.GetRollupID(), claim.RollupIndex)
+// addDepositEvents records the webhook events of the deposits
+func (s *ClientSynchronizer) addDepositEvents(eventType etherman.WebhookEventType, deposits []etherman.Deposit, dbTx pgx.Tx) error {
+	if s.outbox == nil {
 		return nil
 	}
+	events := make([]*etherman.WebhookEvent, 0, len(deposits))
+	for i := range deposits {
+		event, err := webhook.NewDepositEvent(eventType, &deposits[i])
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+	return s.outbox.Add(s.ctx, events, dbTx)
+}
+
+func (s *ClientSynchronizer) processClaim(claim etherman.Claim, blockID uint64, dbTx pgx.Tx) error {
 	claim.BlockID = blockID
 	claim.NetworkID = s.networkID
 	err := s.storage.AddClaim(s.ctx, &claim, dbTx)
@@ -646,6 +1017,17 @@ func (s *ClientSynchronizer) processClaim(claim etherman.Claim, blockID uint64,
 		}
 		return err
 	}
+	err = s.storage.AddClaimStats(s.ctx, &claim, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error adding the Claim to the bridge stats in Block:  %d, Claim: %+v, err: %v", s.networkID, claim.BlockNumber, claim, err)
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %v, err: %s",
+				s.networkID, claim.BlockNumber, rollbackErr, err.Error())
+			return rollbackErr
+		}
+		return err
+	}
 	return nil
 }
 
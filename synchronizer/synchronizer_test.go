@@ -2,16 +2,19 @@ package synchronizer
 
 import (
 	context "context"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	cfgTypes "github.com/0xPolygonHermez/zkevm-node/config/types"
 	rpcTypes "github.com/0xPolygonHermez/zkevm-node/jsonrpc/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jackc/pgx/v4"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -25,7 +28,7 @@ type mocks struct {
 }
 
 func TestSyncGer(t *testing.T) {
-	setupMocks := func(m *mocks) Synchronizer {
+	setupMocks := func(m *mocks, exitRootEvents *eventbus.Bus[*etherman.GlobalExitRoot], syncedEvents *eventbus.Bus[uint], statusEvents *eventbus.Bus[*Status], commitEvents *eventbus.Bus[uint]) Synchronizer {
 		genBlockNumber := uint64(123456)
 		cfg := Config{
 			SyncInterval:  cfgTypes.Duration{Duration: 1 * time.Second},
@@ -35,24 +38,9 @@ func TestSyncGer(t *testing.T) {
 		m.Etherman.On("GetNetworkID", ctx).Return(uint(0), nil)
 		m.Storage.On("GetLatestL1SyncedExitRoot", ctx, nil).Return(&etherman.GlobalExitRoot{}, gerror.ErrStorageNotFound).Once()
 		m.Storage.On("IsLxLyActivated", ctx, nil).Return(true, nil).Once()
-		chEvent := make(chan *etherman.GlobalExitRoot)
-		chSynced := make(chan uint)
-		sync, err := NewSynchronizer(context.Background(), m.Storage, m.BridgeCtrl, m.Etherman, m.ZkEVMClient, genBlockNumber, chEvent, chSynced, cfg)
+		sync, err := NewSynchronizer(context.Background(), m.Storage, m.BridgeCtrl, m.Etherman, m.ZkEVMClient, genBlockNumber, exitRootEvents, syncedEvents, statusEvents, commitEvents, nil, cfg)
 		require.NoError(t, err)
 
-		go func() {
-			for {
-				select {
-				case <-chEvent:
-					t.Log("New GER received")
-				case netID := <-chSynced:
-					t.Log("Synced networkID: ", netID)
-				case <-context.Background().Done():
-					return
-				}
-			}
-		}()
-
 		parentHash := common.HexToHash("0x111")
 		ethHeader := &types.Header{Number: big.NewInt(1), ParentHash: parentHash}
 		ethBlock := types.NewBlockWithHeader(ethHeader)
@@ -174,8 +162,218 @@ func TestSyncGer(t *testing.T) {
 
 	// start synchronizing
 	t.Run("Sync Ger test", func(t *testing.T) {
-		sync := setupMocks(&m)
+		exitRootEvents := eventbus.New[*etherman.GlobalExitRoot](1)
+		syncedEvents := eventbus.New[uint](1)
+		statusEvents := eventbus.New[*Status](1)
+		commitEvents := eventbus.New[uint](1)
+		sync := setupMocks(&m, exitRootEvents, syncedEvents, statusEvents, commitEvents)
 		err := sync.Sync()
 		require.NoError(t, err)
+		netID, ok := syncedEvents.Latest(0)
+		require.True(t, ok)
+		require.Equal(t, uint(0), netID)
+		status, ok := statusEvents.Latest(0)
+		require.True(t, ok)
+		require.Equal(t, uint(0), status.NetworkID)
+		require.Equal(t, uint64(1), status.ChainHead)
+		require.False(t, status.SyncedAt.IsZero())
+		netID, ok = commitEvents.Latest(0)
+		require.True(t, ok)
+		require.Equal(t, uint(0), netID)
 	})
 }
+
+func TestResetStateStoresReorg(t *testing.T) {
+	m := mocks{
+		BridgeCtrl: newBridgectrlMock(t),
+		Storage:    newStorageMock(t),
+		DbTx:       newDbTxMock(t),
+	}
+	s := &ClientSynchronizer{
+		bridgeCtrl:   m.BridgeCtrl,
+		storage:      m.Storage,
+		ctx:          context.Background(),
+		networkID:    1,
+		commitEvents: eventbus.New[uint](1),
+	}
+	reorg := &etherman.Reorg{
+		NetworkID:       1,
+		ForkBlockNumber: 10,
+		Depth:           3,
+		OldBlockHash:    common.HexToHash("0x1"),
+		NewBlockHash:    common.HexToHash("0x2"),
+	}
+	ctx := mock.MatchedBy(func(ctx context.Context) bool { return ctx != nil })
+	m.Storage.On("BeginDBTransaction", ctx).Return(m.DbTx, nil).Once()
+	m.Storage.On("AddReorg", ctx, reorg, m.DbTx).Return(nil).Once()
+	m.Storage.On("Reset", ctx, uint64(10), uint(1), m.DbTx).Return(nil).Once()
+	m.Storage.On("GetNumberDeposits", ctx, uint(1), uint64(10), m.DbTx).Return(uint64(5), nil).Once()
+	m.BridgeCtrl.On("ReorgMT", ctx, uint(5), uint(1), m.DbTx).Return(nil).Once()
+	m.Storage.On("Commit", ctx, m.DbTx).Return(nil).Once()
+
+	err := s.resetState(reorg)
+	require.NoError(t, err)
+	require.False(t, reorg.DetectedAt.IsZero())
+	_, ok := s.commitEvents.Latest(1)
+	require.True(t, ok)
+}
+
+func TestProcessOverridePendingState(t *testing.T) {
+	m := mocks{
+		BridgeCtrl: newBridgectrlMock(t),
+		Storage:    newStorageMock(t),
+		DbTx:       newDbTxMock(t),
+	}
+	s := &ClientSynchronizer{
+		bridgeCtrl: m.BridgeCtrl,
+		storage:    m.Storage,
+		ctx:        context.Background(),
+		networkID:  0,
+	}
+	override := etherman.PendingStateChange{
+		BlockNumber: 10,
+		Event:       etherman.OverridePendingStateEvent,
+		RollupID:    1,
+		BatchNumber: 20,
+		ExitRoot:    common.HexToHash("0x1"),
+	}
+	stored := override
+	stored.BlockID = 5
+	ctx := mock.MatchedBy(func(ctx context.Context) bool { return ctx != nil })
+	m.Storage.On("AddPendingStateChange", ctx, &stored, m.DbTx).Return(nil).Once()
+	m.Storage.On("InvalidateRollupExitLeaves", ctx, &stored, m.DbTx).Return(int64(2), nil).Once()
+	m.BridgeCtrl.On("AddRollupExitLeaf", ctx, etherman.RollupExitLeaf{BlockID: 5, Leaf: override.ExitRoot, RollupId: 1}, m.DbTx).Return(nil).Once()
+
+	err := s.processPendingStateChange(override, 5, m.DbTx)
+	require.NoError(t, err)
+
+	// A consolidation is only stored
+	consolidation := override
+	consolidation.Event = etherman.ConsolidatePendingStateEvent
+	storedConsolidation := consolidation
+	storedConsolidation.BlockID = 6
+	m.Storage.On("AddPendingStateChange", ctx, &storedConsolidation, m.DbTx).Return(nil).Once()
+	err = s.processPendingStateChange(consolidation, 6, m.DbTx)
+	require.NoError(t, err)
+}
+
+func TestProcessRollupTypeEvents(t *testing.T) {
+	m := mocks{
+		Storage: newStorageMock(t),
+		DbTx:    newDbTxMock(t),
+	}
+	s := &ClientSynchronizer{
+		storage:   m.Storage,
+		ctx:       context.Background(),
+		networkID: 0,
+	}
+	ctx := mock.MatchedBy(func(ctx context.Context) bool { return ctx != nil })
+	update := etherman.RollupUpdate{BlockNumber: 10, RollupID: 1, RollupTypeID: 2, LastVerifiedBatchBeforeUpgrade: 30}
+	storedUpdate := update
+	storedUpdate.BlockID = 5
+	m.Storage.On("AddRollupUpdate", ctx, &storedUpdate, m.DbTx).Return(nil).Once()
+	err := s.processRollupUpdate(update, 5, m.DbTx)
+	require.NoError(t, err)
+
+	obsolete := etherman.ObsoleteRollupType{BlockNumber: 10, RollupTypeID: 1}
+	storedObsolete := obsolete
+	storedObsolete.BlockID = 5
+	m.Storage.On("AddObsoleteRollupType", ctx, &storedObsolete, m.DbTx).Return(nil).Once()
+	err = s.processObsoleteRollupType(obsolete, 5, m.DbTx)
+	require.NoError(t, err)
+
+	// A storage error rolls back the db tx
+	storageErr := errors.New("storage error")
+	m.Storage.On("AddRollupUpdate", ctx, &storedUpdate, m.DbTx).Return(storageErr).Once()
+	m.Storage.On("Rollback", ctx, m.DbTx).Return(nil).Once()
+	err = s.processRollupUpdate(update, 5, m.DbTx)
+	require.ErrorIs(t, err, storageErr)
+}
+
+func TestCheckReorg(t *testing.T) {
+	const (
+		genBlockNumber = uint64(100)
+		storedBlocks   = 1000
+	)
+	// newChain returns the headers from genBlockNumber. Headers after forkBlock differ for each fork id.
+	newChain := func(forkBlock uint64, fork int64) []*types.Header {
+		var (
+			headers    []*types.Header
+			parentHash common.Hash
+		)
+		for i := uint64(0); i < storedBlocks; i++ {
+			header := &types.Header{Number: new(big.Int).SetUint64(genBlockNumber + i), ParentHash: parentHash, Difficulty: big.NewInt(0)}
+			if genBlockNumber+i > forkBlock {
+				header.Difficulty = big.NewInt(fork)
+			}
+			headers = append(headers, header)
+			parentHash = header.Hash()
+		}
+		return headers
+	}
+
+	testCases := []struct {
+		name          string
+		forkBlock     uint64
+		expectedBlock uint64
+		isReorg       bool
+		maxRPCCalls   int
+	}{
+		{name: "no reorg", forkBlock: genBlockNumber + storedBlocks, maxRPCCalls: 1},
+		{name: "reorg of the latest block", forkBlock: genBlockNumber + storedBlocks - 2, expectedBlock: genBlockNumber + storedBlocks - 2, isReorg: true, maxRPCCalls: 3},
+		{name: "deep reorg", forkBlock: genBlockNumber + 357, expectedBlock: genBlockNumber + 357, isReorg: true, maxRPCCalls: 22},
+		{name: "reorg until genesis block", forkBlock: genBlockNumber, expectedBlock: genBlockNumber, isReorg: true, maxRPCCalls: 22},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := mocks{
+				Etherman: newEthermanMock(t),
+				Storage:  newStorageMock(t),
+				DbTx:     newDbTxMock(t),
+			}
+			s := &ClientSynchronizer{
+				etherMan:       m.Etherman,
+				storage:        m.Storage,
+				ctx:            context.Background(),
+				genBlockNumber: genBlockNumber,
+				networkID:      1,
+			}
+			canonical := newChain(tc.forkBlock, 1)
+			stored := newChain(tc.forkBlock, 2)
+			var rpcCalls int
+			ctx := mock.MatchedBy(func(ctx context.Context) bool { return ctx != nil })
+			m.Etherman.On("EthBlockByNumber", ctx, mock.Anything).Return(func(_ context.Context, blockNumber uint64) (*types.Block, error) {
+				rpcCalls++
+				return types.NewBlockWithHeader(canonical[blockNumber-genBlockNumber]), nil
+			})
+			if tc.isReorg {
+				m.Storage.On("BeginDBTransaction", ctx).Return(m.DbTx, nil)
+				m.Storage.On("Commit", ctx, m.DbTx).Return(nil)
+				m.Storage.On("GetPreviousBlock", ctx, uint(1), mock.Anything, m.DbTx).Return(func(_ context.Context, _ uint, offset uint64, _ pgx.Tx) (*etherman.Block, error) {
+					if offset >= storedBlocks {
+						return nil, gerror.ErrStorageNotFound
+					}
+					header := stored[storedBlocks-1-offset]
+					return &etherman.Block{BlockNumber: header.Number.Uint64(), BlockHash: header.Hash(), ParentHash: header.ParentHash, NetworkID: 1}, nil
+				})
+			}
+
+			latest := stored[storedBlocks-1]
+			latestBlock := &etherman.Block{BlockNumber: latest.Number.Uint64(), BlockHash: latest.Hash(), ParentHash: latest.ParentHash, NetworkID: 1}
+			block, reorg, err := s.checkReorg(latestBlock)
+			require.NoError(t, err)
+			require.LessOrEqual(t, rpcCalls, tc.maxRPCCalls)
+			if !tc.isReorg {
+				require.Nil(t, block)
+				require.Nil(t, reorg)
+				return
+			}
+			require.NotNil(t, block)
+			require.Equal(t, tc.expectedBlock, block.BlockNumber)
+			require.Equal(t, tc.expectedBlock, reorg.ForkBlockNumber)
+			require.Equal(t, latestBlock.BlockNumber-tc.expectedBlock, reorg.Depth)
+			require.Equal(t, latest.Hash(), reorg.OldBlockHash)
+			require.Equal(t, canonical[storedBlocks-1].Hash(), reorg.NewBlockHash)
+		})
+	}
+}
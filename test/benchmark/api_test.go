@@ -114,15 +114,21 @@ func initServer(b *testing.B, bench benchmark) *bridgectrl.BridgeController {
 			require.True(b, isUpdated)
 		}
 		require.NoError(b, err)
+		// The global index of the claim points to the deposit, so the bridges endpoints return its tx hash
+		var rollupIndex uint64
+		if deposit.NetworkID != 0 {
+			rollupIndex = uint64(deposit.NetworkID) - 1
+		}
 		err = store.AddClaim(context.TODO(), &etherman.Claim{
 			Index:              deposit.DepositCount,
 			OriginalNetwork:    deposit.OriginalNetwork,
 			Amount:             deposit.Amount,
 			NetworkID:          deposit.DestinationNetwork,
 			DestinationAddress: deposit.DestinationAddress,
-			RollupIndex:        1,
-			MainnetFlag:        false,
+			RollupIndex:        rollupIndex,
+			MainnetFlag:        deposit.NetworkID == 0,
 			BlockID:            id,
+			TxHash:             utils.GenerateRandomHash(),
 		}, dbTx)
 		require.NoError(b, err)
 		require.NoError(b, store.Commit(context.TODO(), dbTx))
@@ -175,10 +181,26 @@ func runSuite(b *testing.B, bench benchmark) {
 	b.ResetTimer()
 	b.Run("get bridges endpoint", func(sub *testing.B) {
 		sub.ReportAllocs()
-		deposits, totalCount, err := restClient.GetBridges(addresses[0].Hex(), offset, limit)
+		for i := 0; i < sub.N; i++ {
+			deposits, totalCount, err := restClient.GetBridges(addresses[0].Hex(), offset, limit)
+			require.NoError(sub, err)
+			require.Greater(sub, len(deposits), 0)
+			require.Greater(sub, totalCount, uint64(0))
+			require.NotEmpty(sub, deposits[len(deposits)-1].ClaimTxHash)
+		}
+	})
+
+	b.Run("get bridge endpoint", func(sub *testing.B) {
+		sub.ReportAllocs()
+		sub.StopTimer()
+		deposits, _, err := restClient.GetBridges(addresses[2].Hex(), offset, limit)
 		require.NoError(sub, err)
-		require.Greater(sub, len(deposits), 0)
-		require.Greater(sub, totalCount, uint64(0))
+		sub.StartTimer()
+		for i := 0; i < sub.N; i++ {
+			deposit, err := restClient.GetBridge(deposits[0].NetworkId, deposits[0].DepositCnt)
+			require.NoError(sub, err)
+			require.Equal(sub, deposits[0].ClaimTxHash, deposit.ClaimTxHash)
+		}
 	})
 
 	b.Run("get merkle proof endpoint", func(sub *testing.B) {
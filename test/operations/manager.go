@@ -12,8 +12,11 @@ import (
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/monitor"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/synchronizer"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/0xPolygonHermez/zkevm-node/encoding"
 	erc20 "github.com/0xPolygonHermez/zkevm-node/etherman/smartcontracts/pol"
@@ -55,7 +58,6 @@ const (
 	cmdDir  = "../.."
 
 	mtHeight = 32
-	rollupID = 1
 )
 
 var (
@@ -119,7 +121,7 @@ func NewManager(ctx context.Context, cfg *Config) (*Manager, error) {
 	if err != nil {
 		return nil, err
 	}
-	bService := server.NewBridgeService(cfg.BS, cfg.BT.Height, []uint{0, 1}, pgst, rollupID)
+	bService := server.NewBridgeService(cfg.BS, cfg.BT.Height, []server.Network{{NetworkID: 0}, {NetworkID: 1, RollupID: 1}}, pgst, eventbus.New[*synchronizer.Status](1), eventbus.New[*monitor.SolvencyReport](1), eventbus.New[*monitor.ClaimCheckReport](1), nil)
 	opsman.storage = st.(StorageInterface)
 	opsman.bridgetree = bt
 	opsman.bridgeService = bService
@@ -129,10 +131,10 @@ func NewManager(ctx context.Context, cfg *Config) (*Manager, error) {
 	return opsman, err
 }
 
-// CheckL2Claim checks if the claim is already in the L2 network.
-func (m *Manager) CheckL2Claim(ctx context.Context, networkID, depositCnt uint) error {
+// CheckL2Claim checks if the claim of the deposit from the origin network is already in the network.
+func (m *Manager) CheckL2Claim(ctx context.Context, origNetworkID, networkID, depositCnt uint) error {
 	return operations.Poll(defaultInterval, defaultDeadline, func() (bool, error) {
-		_, err := m.storage.GetClaim(ctx, depositCnt, networkID, nil)
+		_, err := m.storage.GetClaim(ctx, depositCnt, origNetworkID, networkID, nil)
 		if err != nil {
 			if err == gerror.ErrStorageNotFound {
 				return false, nil
@@ -6,7 +6,11 @@ import (
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/monitor"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/synchronizer"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/eventbus"
+	"github.com/0xPolygonHermez/zkevm-node/log"
 )
 
 // RunMockServer runs mock server
@@ -44,6 +48,16 @@ func RunMockServer(dbType string, height uint8, networks []uint) (*bridgectrl.Br
 		MaxPageLimit:     100,    //nolint:gomnd
 		BridgeVersion:    "v1",
 	}
-	bridgeService := server.NewBridgeService(cfg, btCfg.Height, networks, store, rollupID)
-	return bt, store, server.RunServer(cfg, bridgeService)
+	bridgeNetworks := make([]server.Network, 0, len(networks))
+	for _, networkID := range networks {
+		bridgeNetworks = append(bridgeNetworks, server.Network{NetworkID: networkID, RollupID: networkID})
+	}
+	bridgeService := server.NewBridgeService(cfg, btCfg.Height, bridgeNetworks, store, eventbus.New[*synchronizer.Status](1), eventbus.New[*monitor.SolvencyReport](1), eventbus.New[*monitor.ClaimCheckReport](1), nil)
+	go func() {
+		err := server.RunServer(ctx, cfg, bridgeService)
+		if err != nil {
+			log.Error("mock server stopped. Error: ", err)
+		}
+	}()
+	return bt, store, nil
 }
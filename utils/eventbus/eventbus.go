@@ -0,0 +1,138 @@
+package eventbus
+
+import (
+	"sync"
+
+	"github.com/0xPolygonHermez/zkevm-node/log"
+)
+
+// Bus is an in-process publish/subscribe bus with a topic per network. Every event
+// is delivered to all the subscribers of its topic. Each subscriber has a bounded buffer,
+// so a slow subscriber never blocks the publisher: when its buffer is full, the oldest
+// pending event is discarded. The latest event of each topic is kept and replayed to
+// the subscribers that arrive later.
+type Bus[T any] struct {
+	mu          sync.Mutex
+	bufferSize  int
+	latest      map[uint]T
+	subscribers map[uint][]*Subscription[T]
+	all         []*Subscription[T]
+}
+
+// Subscription receives the events of a topic
+type Subscription[T any] struct {
+	bus   *Bus[T]
+	topic uint
+	all   bool
+	ch    chan T
+}
+
+// New creates a new event bus. bufferSize is the number of events that can be pending for each subscriber.
+func New[T any](bufferSize int) *Bus[T] {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	return &Bus[T]{
+		bufferSize:  bufferSize,
+		latest:      make(map[uint]T),
+		subscribers: make(map[uint][]*Subscription[T]),
+	}
+}
+
+// Publish sends the event to all the subscribers of the topic. It never blocks.
+func (b *Bus[T]) Publish(topic uint, event T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.latest[topic] = event
+	for _, s := range b.subscribers[topic] {
+		s.send(event)
+	}
+	for _, s := range b.all {
+		s.send(event)
+	}
+}
+
+// Subscribe returns a new subscription to the topic. If an event was already
+// published in the topic, the latest one is delivered first.
+func (b *Bus[T]) Subscribe(topic uint) *Subscription[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s := &Subscription[T]{
+		bus:   b,
+		topic: topic,
+		ch:    make(chan T, b.bufferSize),
+	}
+	if event, ok := b.latest[topic]; ok {
+		s.ch <- event
+	}
+	b.subscribers[topic] = append(b.subscribers[topic], s)
+	return s
+}
+
+// SubscribeAll returns a new subscription to the events of all the topics.
+// The latest events of the topics are not replayed.
+func (b *Bus[T]) SubscribeAll() *Subscription[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s := &Subscription[T]{
+		bus: b,
+		all: true,
+		ch:  make(chan T, b.bufferSize),
+	}
+	b.all = append(b.all, s)
+	return s
+}
+
+// Latest returns the latest event published in the topic
+func (b *Bus[T]) Latest(topic uint) (T, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	event, ok := b.latest[topic]
+	return event, ok
+}
+
+// send must be called holding the bus lock, so there is a single writer for the channel.
+func (s *Subscription[T]) send(event T) {
+	select {
+	case s.ch <- event:
+		return
+	default:
+	}
+	// The buffer is full. Discard the oldest event to make room for the new one
+	select {
+	case <-s.ch:
+		log.Warnf("event bus: subscriber of topic %d is too slow, discarding the oldest pending event", s.topic)
+	default:
+	}
+	select {
+	case s.ch <- event:
+	default:
+	}
+}
+
+// Events returns the channel where the events are delivered
+func (s *Subscription[T]) Events() <-chan T {
+	return s.ch
+}
+
+// Unsubscribe stops the delivery of events and closes the events channel
+func (s *Subscription[T]) Unsubscribe() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	if s.all {
+		s.bus.all = remove(s.bus.all, s)
+	} else {
+		s.bus.subscribers[s.topic] = remove(s.bus.subscribers[s.topic], s)
+	}
+}
+
+// remove closes the events channel of the subscription, if it's in the list, and returns the list without it
+func remove[T any](subs []*Subscription[T], s *Subscription[T]) []*Subscription[T] {
+	for i := range subs {
+		if subs[i] == s {
+			close(s.ch)
+			return append(subs[:i], subs[i+1:]...)
+		}
+	}
+	return subs
+}
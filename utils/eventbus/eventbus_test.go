@@ -0,0 +1,99 @@
+package eventbus
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFanOut(t *testing.T) {
+	bus := New[string](10)
+	sub1 := bus.Subscribe(1)
+	sub2 := bus.Subscribe(1)
+	other := bus.Subscribe(2)
+
+	bus.Publish(1, "a")
+	bus.Publish(1, "b")
+
+	for _, s := range []*Subscription[string]{sub1, sub2} {
+		require.Equal(t, "a", <-s.Events())
+		require.Equal(t, "b", <-s.Events())
+	}
+	require.Len(t, other.Events(), 0)
+}
+
+func TestBoundedBuffer(t *testing.T) {
+	bus := New[int](2)
+	sub := bus.Subscribe(0)
+	// The publisher is never blocked by a slow subscriber
+	for i := 0; i < 5; i++ {
+		bus.Publish(0, i)
+	}
+	require.Len(t, sub.Events(), 2)
+	require.Equal(t, 3, <-sub.Events())
+	require.Equal(t, 4, <-sub.Events())
+}
+
+func TestReplayLatest(t *testing.T) {
+	bus := New[int](10)
+	_, ok := bus.Latest(3)
+	require.False(t, ok)
+	bus.Publish(3, 1)
+	bus.Publish(3, 2)
+
+	late := bus.Subscribe(3)
+	require.Equal(t, 2, <-late.Events())
+	latest, ok := bus.Latest(3)
+	require.True(t, ok)
+	require.Equal(t, 2, latest)
+
+	bus.Publish(3, 4)
+	require.Equal(t, 4, <-late.Events())
+
+	// Nothing is replayed for topics without events
+	empty := bus.Subscribe(4)
+	require.Len(t, empty.Events(), 0)
+}
+
+func TestUnsubscribe(t *testing.T) {
+	bus := New[int](10)
+	sub := bus.Subscribe(0)
+	sub.Unsubscribe()
+	bus.Publish(0, 1)
+	_, ok := <-sub.Events()
+	require.False(t, ok)
+	// Unsubscribing twice is a no-op
+	sub.Unsubscribe()
+}
+
+func TestSubscribeAll(t *testing.T) {
+	bus := New[int](10)
+	bus.Publish(0, 1)
+	sub := bus.SubscribeAll()
+	bus.Publish(0, 2)
+	bus.Publish(1, 3)
+	require.Equal(t, 2, <-sub.Events())
+	require.Equal(t, 3, <-sub.Events())
+	sub.Unsubscribe()
+	_, ok := <-sub.Events()
+	require.False(t, ok)
+}
+
+func TestConcurrentPublish(t *testing.T) {
+	const publishers, events = 4, 100
+	bus := New[int](publishers * events)
+	sub := bus.Subscribe(0)
+	var wg sync.WaitGroup
+	for p := 0; p < publishers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < events; i++ {
+				bus.Publish(0, i)
+			}
+		}()
+	}
+	wg.Wait()
+	require.Len(t, sub.Events(), publishers*events)
+}
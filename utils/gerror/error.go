@@ -15,4 +15,6 @@ var (
 	ErrDepositNotSynced = errors.New("not synchronized deposit")
 	// ErrNetworkNotRegister is used when the networkID is not registered in the bridge
 	ErrNetworkNotRegister = errors.New("not registered network")
+	// ErrRootMismatch is used when a merkle root rebuilt from the deposits doesn't match the stored one
+	ErrRootMismatch = errors.New("merkle root mismatch")
 )
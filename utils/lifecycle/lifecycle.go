@@ -0,0 +1,119 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/log"
+)
+
+// Manager coordinates the long running subsystems of the service. All of them share
+// a single context that is cancelled when a signal is received, Stop is called or a
+// subsystem fails. Then, the subsystems are expected to finish their current work and return.
+type Manager struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	shutdownTimeout time.Duration
+	wg              sync.WaitGroup
+	closers         []closer
+	failureMu       sync.Mutex
+	failure         error
+}
+
+type closer struct {
+	name string
+	fn   func()
+}
+
+// New creates a new lifecycle manager. The shutdown starts when any of the signals is received.
+func New(ctx context.Context, shutdownTimeout time.Duration, signals ...os.Signal) *Manager {
+	stopNotify := func() {}
+	if len(signals) > 0 {
+		ctx, stopNotify = signal.NotifyContext(ctx, signals...)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Manager{
+		ctx: ctx,
+		cancel: func() {
+			cancel()
+			stopNotify()
+		},
+		shutdownTimeout: shutdownTimeout,
+	}
+}
+
+// Context returns the context that is cancelled when the shutdown starts.
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// Go runs a subsystem in a new goroutine. The subsystem must return once the context is done.
+// If the subsystem returns an error before the shutdown, the shutdown is started and the
+// error is returned by Wait.
+func (m *Manager) Go(name string, run func(ctx context.Context) error) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		err := run(m.ctx)
+		if err != nil && m.ctx.Err() == nil {
+			log.Errorf("%s stopped unexpectedly, shutting down. Error: %v", name, err)
+			m.failureMu.Lock()
+			if m.failure == nil {
+				m.failure = fmt.Errorf("%s: %w", name, err)
+			}
+			m.failureMu.Unlock()
+			m.Stop()
+			return
+		}
+		log.Infof("%s stopped", name)
+	}()
+}
+
+// OnShutdown registers a function that is called once all the subsystems are stopped.
+// The functions are called in reverse order of registration.
+func (m *Manager) OnShutdown(name string, fn func()) {
+	m.closers = append(m.closers, closer{name: name, fn: fn})
+}
+
+// Stop starts the shutdown.
+func (m *Manager) Stop() {
+	m.cancel()
+}
+
+// Wait blocks until the shutdown starts and then waits for the subsystems to stop, at most
+// the shutdown timeout. Finally, the registered shutdown functions are called. It returns the
+// error of the first subsystem that failed, if any, or an error if the subsystems didn't stop in time.
+func (m *Manager) Wait() error {
+	<-m.ctx.Done()
+	log.Info("shutting down...")
+	m.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+	var err error
+	select {
+	case <-done:
+	case <-time.After(m.shutdownTimeout):
+		err = fmt.Errorf("subsystems didn't stop in %s", m.shutdownTimeout)
+		log.Warn(err)
+	}
+
+	for i := len(m.closers) - 1; i >= 0; i-- {
+		log.Infof("closing %s", m.closers[i].name)
+		m.closers[i].fn()
+	}
+	log.Info("shutdown completed")
+	m.failureMu.Lock()
+	defer m.failureMu.Unlock()
+	if m.failure != nil {
+		return m.failure
+	}
+	return err
+}
@@ -0,0 +1,70 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShutdownOrder(t *testing.T) {
+	m := New(context.Background(), time.Second)
+	var calls []string
+	m.Go("worker", func(ctx context.Context) error {
+		<-ctx.Done()
+		// Simulate the end of the current work
+		time.Sleep(10 * time.Millisecond)
+		calls = append(calls, "worker")
+		return nil
+	})
+	m.OnShutdown("first", func() { calls = append(calls, "first") })
+	m.OnShutdown("second", func() { calls = append(calls, "second") })
+
+	m.Stop()
+	require.NoError(t, m.Wait())
+	require.Equal(t, []string{"worker", "second", "first"}, calls)
+}
+
+func TestShutdownOnSignal(t *testing.T) {
+	m := New(context.Background(), time.Second, syscall.SIGTERM)
+	stopped := make(chan struct{})
+	m.Go("worker", func(ctx context.Context) error {
+		<-ctx.Done()
+		close(stopped)
+		return nil
+	})
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+	require.NoError(t, m.Wait())
+	<-stopped
+}
+
+func TestShutdownOnFailure(t *testing.T) {
+	m := New(context.Background(), time.Second)
+	errFailure := errors.New("failure")
+	m.Go("failing", func(ctx context.Context) error {
+		return errFailure
+	})
+	m.Go("worker", func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+	require.ErrorIs(t, m.Wait(), errFailure)
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	m := New(context.Background(), 10*time.Millisecond)
+	block := make(chan struct{})
+	defer close(block)
+	m.Go("stuck", func(ctx context.Context) error {
+		<-block
+		return nil
+	})
+	var closed bool
+	m.OnShutdown("db", func() { closed = true })
+	m.Stop()
+	require.Error(t, m.Wait())
+	require.True(t, closed)
+}
@@ -0,0 +1,25 @@
+package webhook
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+)
+
+// Config represents the configuration of the webhook notifications
+type Config struct {
+	// Enabled whether to record the events in the outbox and deliver them
+	Enabled bool `mapstructure:"Enabled"`
+	// URL is the endpoint that receives the events
+	URL string `mapstructure:"URL"`
+	// Secret is the key used to sign the payloads with HMAC-SHA256
+	Secret string `mapstructure:"Secret"`
+	// PollInterval is the delay interval between the reads of the pending events of the outbox
+	PollInterval types.Duration `mapstructure:"PollInterval"`
+	// BatchSize is the maximum number of events delivered on each poll
+	BatchSize uint `mapstructure:"BatchSize"`
+	// RequestTimeout is the timeout of each delivery
+	RequestTimeout types.Duration `mapstructure:"RequestTimeout"`
+	// InitialBackoff is the delay before retrying a failed delivery. It is doubled on each attempt
+	InitialBackoff types.Duration `mapstructure:"InitialBackoff"`
+	// MaxBackoff is the maximum delay between the retries of a delivery
+	MaxBackoff types.Duration `mapstructure:"MaxBackoff"`
+}
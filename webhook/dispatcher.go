@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+)
+
+const (
+	// EventHeader is the header with the type of the event
+	EventHeader = "X-Bridge-Event"
+	// DeliveryHeader is the header with the id of the event. The same event may be delivered more than once
+	DeliveryHeader = "X-Bridge-Delivery"
+	// TimestampHeader is the header with the unix time of the delivery
+	TimestampHeader = "X-Bridge-Timestamp"
+	// SignatureHeader is the header with the signature of the delivery
+	SignatureHeader = "X-Bridge-Signature"
+
+	maxResponseSize = 1024
+)
+
+// Message is the body posted to the webhook
+type Message struct {
+	ID        uint64                    `json:"id"`
+	Type      etherman.WebhookEventType `json:"type"`
+	CreatedAt time.Time                 `json:"created_at"`
+	Data      json.RawMessage           `json:"data"`
+}
+
+// Sign returns the signature of a delivery, that is the hex encoded HMAC-SHA256 of the timestamp
+// and the body joined by a dot. The timestamp is signed so the receivers can reject replayed deliveries.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + ".")) //nolint:gomnd
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Dispatcher delivers the events of the outbox to the webhook. The events are removed from the outbox once the
+// webhook responds with a 2xx status code, and the failed deliveries are retried with an exponential backoff,
+// so each event is delivered at least once.
+type Dispatcher struct {
+	cfg     Config
+	storage storageInterface
+	client  *http.Client
+}
+
+// NewDispatcher creates a new dispatcher
+func NewDispatcher(cfg Config, storage interface{}) *Dispatcher {
+	return &Dispatcher{
+		cfg:     cfg,
+		storage: storage.(storageInterface),
+		client:  &http.Client{Timeout: cfg.RequestTimeout.Duration},
+	}
+}
+
+// Start delivers the pending events every interval. It returns once the context is done.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(d.cfg.PollInterval.Duration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			err := d.dispatch(ctx)
+			if err != nil {
+				log.Errorf("error dispatching the webhook events. Error: %v", err)
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context) error {
+	now := time.Now().UTC()
+	events, err := d.storage.GetPendingWebhookEvents(ctx, now, d.cfg.BatchSize, nil)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		err = d.deliver(ctx, event, now)
+		if err == nil {
+			log.Debugf("webhook event %d delivered. Type: %s, attempts: %d", event.ID, event.Type, event.Attempts+1)
+			err = d.storage.DeleteWebhookEvent(ctx, event.ID, nil)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		event.Attempts++
+		event.NextAttemptAt = now.Add(d.backoff(event.Attempts))
+		event.LastError = err.Error()
+		log.Warnf("error delivering the webhook event %d. Type: %s, attempts: %d, next attempt at: %s, error: %v",
+			event.ID, event.Type, event.Attempts, event.NextAttemptAt, err)
+		err = d.storage.UpdateWebhookEvent(ctx, event, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backoff returns the delay before the next attempt after the given number of failed attempts
+func (d *Dispatcher) backoff(attempts uint) time.Duration {
+	backoff := d.cfg.InitialBackoff.Duration
+	for i := uint(1); i < attempts && backoff < d.cfg.MaxBackoff.Duration; i++ {
+		backoff *= 2
+	}
+	if backoff > d.cfg.MaxBackoff.Duration {
+		return d.cfg.MaxBackoff.Duration
+	}
+	return backoff
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, event *etherman.WebhookEvent, now time.Time) error {
+	body, err := json.Marshal(Message{
+		ID:        event.ID,
+		Type:      event.Type,
+		CreatedAt: event.CreatedAt,
+		Data:      event.Payload,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	timestamp := now.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(event.Type))
+	req.Header.Set(DeliveryHeader, strconv.FormatUint(event.ID, 10))  //nolint:gomnd
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10)) //nolint:gomnd
+	req.Header.Set(SignatureHeader, Sign(d.cfg.Secret, timestamp, body))
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+		return fmt.Errorf("unexpected status code %d: %s", res.StatusCode, msg)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type delivery struct {
+	header http.Header
+	body   []byte
+	err    error
+}
+
+func TestDispatch(t *testing.T) {
+	ctx := context.Background()
+	const secret = "secret"
+	// The deliveries are checked in the test goroutine, the handler only records them
+	deliveries := make(chan delivery, 2) //nolint:gomnd
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		deliveries <- delivery{header: r.Header.Clone(), body: body, err: err}
+		// The first delivery fails
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	receive := func() Message {
+		d := <-deliveries
+		require.NoError(t, d.err)
+		timestamp, err := strconv.ParseInt(d.header.Get(TimestampHeader), 10, 64)
+		require.NoError(t, err)
+		require.Equal(t, Sign(secret, timestamp, d.body), d.header.Get(SignatureHeader))
+		require.Equal(t, string(DepositIndexedEvent), d.header.Get(EventHeader))
+		require.Equal(t, "1", d.header.Get(DeliveryHeader))
+		var msg Message
+		require.NoError(t, json.Unmarshal(d.body, &msg))
+		return msg
+	}
+
+	event, err := NewDepositEvent(DepositIndexedEvent, &etherman.Deposit{
+		NetworkID:          1,
+		OriginalAddress:    common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+		Amount:             big.NewInt(100),
+		DestinationNetwork: 0,
+		DepositCount:       7,
+	})
+	require.NoError(t, err)
+	event.ID = 1
+	event.CreatedAt = time.Now().UTC()
+
+	storage := newStorageMock(t)
+	storage.On("GetPendingWebhookEvents", ctx, mock.Anything, uint(10), nil).Return([]*etherman.WebhookEvent{event}, nil)
+	storage.On("UpdateWebhookEvent", ctx, event, nil).Return(nil).Once()
+	storage.On("DeleteWebhookEvent", ctx, uint64(1), nil).Return(nil).Once()
+	dispatcher := NewDispatcher(Config{
+		URL:            server.URL,
+		Secret:         secret,
+		BatchSize:      10,
+		RequestTimeout: types.NewDuration(time.Second),
+		InitialBackoff: types.NewDuration(time.Second),
+		MaxBackoff:     types.NewDuration(time.Minute),
+	}, storage)
+
+	// The failed delivery is retried after the backoff
+	require.NoError(t, dispatcher.dispatch(ctx))
+	first := receive()
+	require.Equal(t, uint(1), event.Attempts)
+	require.Contains(t, event.LastError, "500")
+	require.WithinDuration(t, time.Now().Add(time.Second), event.NextAttemptAt, time.Second)
+
+	// The delivered event is removed from the outbox
+	require.NoError(t, dispatcher.dispatch(ctx))
+	second := receive()
+	require.Equal(t, first, second)
+	require.Empty(t, deliveries)
+	var payload DepositPayload
+	require.NoError(t, json.Unmarshal(second.Data, &payload))
+	require.Equal(t, uint(7), payload.DepositCnt)
+	require.Equal(t, "100", payload.Amount)
+	require.Equal(t, etherman.GenerateGlobalIndex(false, 0, 7).String(), payload.GlobalIndex)
+}
+
+func TestBackoff(t *testing.T) {
+	dispatcher := NewDispatcher(Config{
+		InitialBackoff: types.NewDuration(5 * time.Second),
+		MaxBackoff:     types.NewDuration(time.Minute),
+	}, newStorageMock(t))
+	require.Equal(t, 5*time.Second, dispatcher.backoff(1))
+	require.Equal(t, 10*time.Second, dispatcher.backoff(2))
+	require.Equal(t, 40*time.Second, dispatcher.backoff(4))
+	require.Equal(t, time.Minute, dispatcher.backoff(5))
+	require.Equal(t, time.Minute, dispatcher.backoff(100))
+}
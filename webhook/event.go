@@ -0,0 +1,145 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	ctmtypes "github.com/0xPolygonHermez/zkevm-bridge-service/claimtxman/types"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/monitor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// DepositIndexedEvent is sent when a deposit is synced
+	DepositIndexedEvent etherman.WebhookEventType = "deposit.indexed"
+	// DepositReadyForClaimEvent is sent when the exit root that includes a deposit reaches the destination network.
+	// The deposits are marked as ready for claim by the claim tx managers, so it requires them to be enabled
+	DepositReadyForClaimEvent etherman.WebhookEventType = "deposit.ready_for_claim"
+	// DepositReorgedEvent is sent when a deposit is removed by a reorg
+	DepositReorgedEvent etherman.WebhookEventType = "deposit.reorged"
+	// ClaimTxSentEvent is sent when the claim tx manager sends a claim tx to the destination network
+	ClaimTxSentEvent etherman.WebhookEventType = "claim_tx.sent"
+	// ClaimTxConfirmedEvent is sent when a claim tx of the claim tx manager is mined successfully
+	ClaimTxConfirmedEvent etherman.WebhookEventType = "claim_tx.confirmed"
+	// ClaimTxFailedEvent is sent when the claim tx manager gives up claiming a deposit
+	ClaimTxFailedEvent etherman.WebhookEventType = "claim_tx.failed"
+	// ClaimFindingEvent is sent when the claim checker finds a new claim that doesn't match the synced deposits
+	ClaimFindingEvent etherman.WebhookEventType = "claim.finding"
+)
+
+// DepositPayload is the data of the deposit events
+type DepositPayload struct {
+	LeafType    uint8          `json:"leaf_type"`
+	OrigNet     uint           `json:"orig_net"`
+	OrigAddr    common.Address `json:"orig_addr"`
+	Amount      string         `json:"amount"`
+	DestNet     uint           `json:"dest_net"`
+	DestAddr    common.Address `json:"dest_addr"`
+	BlockNum    uint64         `json:"block_num"`
+	DepositCnt  uint           `json:"deposit_cnt"`
+	NetworkID   uint           `json:"network_id"`
+	TxHash      common.Hash    `json:"tx_hash"`
+	Metadata    hexutil.Bytes  `json:"metadata"`
+	GlobalIndex string         `json:"global_index"`
+}
+
+// ClaimTxPayload is the data of the claim tx events. DepositCnt is the deposit count of the mainnet deposit
+// claimed in the network, and TxHash the hash of the tx sent or mined. It is empty when the claim fails.
+type ClaimTxPayload struct {
+	NetworkID  uint           `json:"network_id"`
+	DepositCnt uint           `json:"deposit_cnt"`
+	From       common.Address `json:"from"`
+	Nonce      uint64         `json:"nonce"`
+	TxHash     *common.Hash   `json:"tx_hash,omitempty"`
+	Status     string         `json:"status"`
+}
+
+// ClaimFindingPayload is the data of the claim finding events. Deposit is empty for the claims of unknown deposits.
+type ClaimFindingPayload struct {
+	Kind             string          `json:"kind"`
+	NetworkID        uint            `json:"network_id"`
+	Index            uint            `json:"index"`
+	MainnetFlag      bool            `json:"mainnet_flag"`
+	RollupIndex      uint64          `json:"rollup_index"`
+	OrigNet          uint            `json:"orig_net"`
+	OrigAddr         common.Address  `json:"orig_addr"`
+	Amount           string          `json:"amount"`
+	DestAddr         common.Address  `json:"dest_addr"`
+	BlockNum         uint64          `json:"block_num"`
+	TxHash           common.Hash     `json:"tx_hash"`
+	ClaimCnt         uint64          `json:"claim_cnt"`
+	MismatchedFields []string        `json:"mismatched_fields,omitempty"`
+	Deposit          *DepositPayload `json:"deposit,omitempty"`
+}
+
+// NewDepositEvent creates a deposit event
+func NewDepositEvent(eventType etherman.WebhookEventType, deposit *etherman.Deposit) (*etherman.WebhookEvent, error) {
+	return newEvent(eventType, newDepositPayload(deposit))
+}
+
+func newDepositPayload(deposit *etherman.Deposit) DepositPayload {
+	mainnetFlag := deposit.NetworkID == 0
+	rollupIndex := uint(0)
+	if !mainnetFlag {
+		rollupIndex = deposit.NetworkID - 1
+	}
+	return DepositPayload{
+		LeafType:    deposit.LeafType,
+		OrigNet:     deposit.OriginalNetwork,
+		OrigAddr:    deposit.OriginalAddress,
+		Amount:      deposit.Amount.String(),
+		DestNet:     deposit.DestinationNetwork,
+		DestAddr:    deposit.DestinationAddress,
+		BlockNum:    deposit.BlockNumber,
+		DepositCnt:  deposit.DepositCount,
+		NetworkID:   deposit.NetworkID,
+		TxHash:      deposit.TxHash,
+		Metadata:    deposit.Metadata,
+		GlobalIndex: etherman.GenerateGlobalIndex(mainnetFlag, rollupIndex, deposit.DepositCount).String(),
+	}
+}
+
+// NewClaimTxEvent creates a claim tx event of a monitored tx of the claim tx manager of the network
+func NewClaimTxEvent(eventType etherman.WebhookEventType, networkID uint, mTx ctmtypes.MonitoredTx, txHash *common.Hash) (*etherman.WebhookEvent, error) {
+	return newEvent(eventType, ClaimTxPayload{
+		NetworkID:  networkID,
+		DepositCnt: mTx.DepositID,
+		From:       mTx.From,
+		Nonce:      mTx.Nonce,
+		TxHash:     txHash,
+		Status:     string(mTx.Status),
+	})
+}
+
+// NewClaimFindingEvent creates a claim finding event
+func NewClaimFindingEvent(finding monitor.ClaimFinding) (*etherman.WebhookEvent, error) {
+	payload := ClaimFindingPayload{
+		Kind:             string(finding.Kind),
+		NetworkID:        finding.Claim.NetworkID,
+		Index:            finding.Claim.Index,
+		MainnetFlag:      finding.Claim.MainnetFlag,
+		RollupIndex:      finding.Claim.RollupIndex,
+		OrigNet:          finding.Claim.OriginalNetwork,
+		OrigAddr:         finding.Claim.OriginalAddress,
+		Amount:           finding.Claim.Amount.String(),
+		DestAddr:         finding.Claim.DestinationAddress,
+		BlockNum:         finding.Claim.BlockNumber,
+		TxHash:           finding.Claim.TxHash,
+		ClaimCnt:         finding.ClaimCount,
+		MismatchedFields: finding.MismatchedFields,
+	}
+	if finding.Deposit != nil {
+		deposit := newDepositPayload(finding.Deposit)
+		payload.Deposit = &deposit
+	}
+	return newEvent(ClaimFindingEvent, payload)
+}
+
+func newEvent(eventType etherman.WebhookEventType, data interface{}) (*etherman.WebhookEvent, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &etherman.WebhookEvent{Type: eventType, Payload: payload}, nil
+}
@@ -0,0 +1,16 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/jackc/pgx/v4"
+)
+
+type storageInterface interface {
+	AddWebhookEvents(ctx context.Context, events []*etherman.WebhookEvent, dbTx pgx.Tx) error
+	GetPendingWebhookEvents(ctx context.Context, now time.Time, limit uint, dbTx pgx.Tx) ([]*etherman.WebhookEvent, error)
+	UpdateWebhookEvent(ctx context.Context, event *etherman.WebhookEvent, dbTx pgx.Tx) error
+	DeleteWebhookEvent(ctx context.Context, id uint64, dbTx pgx.Tx) error
+}
@@ -0,0 +1,117 @@
+// Code generated by mockery v2.39.0. DO NOT EDIT.
+
+package webhook
+
+import (
+	context "context"
+
+	etherman "github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	mock "github.com/stretchr/testify/mock"
+
+	pgx "github.com/jackc/pgx/v4"
+
+	time "time"
+)
+
+// storageMock is an autogenerated mock type for the storageInterface type
+type storageMock struct {
+	mock.Mock
+}
+
+// AddWebhookEvents provides a mock function with given fields: ctx, events, dbTx
+func (_m *storageMock) AddWebhookEvents(ctx context.Context, events []*etherman.WebhookEvent, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, events, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddWebhookEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*etherman.WebhookEvent, pgx.Tx) error); ok {
+		r0 = rf(ctx, events, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWebhookEvent provides a mock function with given fields: ctx, id, dbTx
+func (_m *storageMock) DeleteWebhookEvent(ctx context.Context, id uint64, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, id, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r0 = rf(ctx, id, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetPendingWebhookEvents provides a mock function with given fields: ctx, now, limit, dbTx
+func (_m *storageMock) GetPendingWebhookEvents(ctx context.Context, now time.Time, limit uint, dbTx pgx.Tx) ([]*etherman.WebhookEvent, error) {
+	ret := _m.Called(ctx, now, limit, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingWebhookEvents")
+	}
+
+	var r0 []*etherman.WebhookEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint, pgx.Tx) ([]*etherman.WebhookEvent, error)); ok {
+		return rf(ctx, now, limit, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint, pgx.Tx) []*etherman.WebhookEvent); ok {
+		r0 = rf(ctx, now, limit, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*etherman.WebhookEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint, pgx.Tx) error); ok {
+		r1 = rf(ctx, now, limit, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWebhookEvent provides a mock function with given fields: ctx, event, dbTx
+func (_m *storageMock) UpdateWebhookEvent(ctx context.Context, event *etherman.WebhookEvent, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, event, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhookEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.WebhookEvent, pgx.Tx) error); ok {
+		r0 = rf(ctx, event, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// newStorageMock creates a new instance of storageMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newStorageMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *storageMock {
+	mock := &storageMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
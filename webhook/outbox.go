@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/jackc/pgx/v4"
+)
+
+// Outbox records the webhook events in the same db transaction as the state changes that cause them,
+// so the events are not lost if the service restarts before delivering them.
+type Outbox struct {
+	storage storageInterface
+}
+
+// NewOutbox creates a new outbox
+func NewOutbox(storage interface{}) *Outbox {
+	return &Outbox{
+		storage: storage.(storageInterface),
+	}
+}
+
+// Add records the events. The events are delivered once the db transaction is committed.
+func (o *Outbox) Add(ctx context.Context, events []*etherman.WebhookEvent, dbTx pgx.Tx) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return o.storage.AddWebhookEvents(ctx, events, dbTx)
+}